@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/wiring"
+)
+
+// runBalance prints the balance of successful transactions
+func runBalance(a *app, args []string) error {
+	fs := newFlagSet("balance", "")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return usageError{err: fmt.Errorf("unexpected arguments %v", fs.Args())}
+	}
+
+	balance, err := wiring.Transactions(a.deps()).GetBalance(a.ctx)
+	if err != nil {
+		return err
+	}
+	return a.printJSON(balance)
+}
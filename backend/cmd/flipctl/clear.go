@@ -0,0 +1,29 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/wiring"
+)
+
+// runClear soft-deletes all transactions, which can be restored within the retention window like a clear over HTTP
+func runClear(a *app, args []string) error {
+	fs := newFlagSet("clear", "")
+	confirm := fs.Bool("confirm", false, "confirm that all transactions should be cleared")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return usageError{err: fmt.Errorf("unexpected arguments %v", fs.Args())}
+	}
+	if !*confirm {
+		return usageError{err: errors.New("refusing to clear all transactions without --confirm")}
+	}
+
+	response, err := wiring.Uploads(a.deps()).Clear(a.ctx)
+	if err != nil {
+		return err
+	}
+	return a.printJSON(response)
+}
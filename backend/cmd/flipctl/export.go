@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	transactionHandler "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/handler"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/wiring"
+)
+
+// runExport writes the transactions matching the filters to a file, or to stdout
+func runExport(a *app, args []string) error {
+	fs := newFlagSet("export", "")
+	filters := filterFlags{}
+	fs.Var(filters, "filter", filterUsage)
+	format := fs.String("format", schemas.ExportFormatCSV, "export format: csv or xlsx")
+	sortBy := fs.String("sort-by", "", "field to sort by, as sort_by of GET /api/transactions")
+	sortOrder := fs.String("sort-order", "", "sort order: asc or desc")
+	output := fs.String("output", "-", "file to write, or - for stdout")
+	escape := fs.Bool("escape", false, "quote CSV text a spreadsheet would run as a formula; the quote stays in the data if it is imported again")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return usageError{err: fmt.Errorf("unexpected arguments %v", fs.Args())}
+	}
+
+	*format = strings.ToLower(*format)
+	if !schemas.ValidExportFormat(*format) {
+		return usageError{err: fmt.Errorf("unknown format %q, expected %s or %s", *format, schemas.ExportFormatCSV, schemas.ExportFormatXLSX)}
+	}
+
+	d := a.deps()
+	transactionFilters, errResp := transactionHandler.ParseFilters(filters.query, d.FieldValidator)
+	if errResp != nil {
+		return usageError{err: fmt.Errorf("%s: %s", errResp.Message, errResp.Error)}
+	}
+	transactionSort, errResp := transactionHandler.ParseSort(*sortBy, *sortOrder, d.FieldValidator.ValidateSortField, d.FieldValidator)
+	if errResp != nil {
+		return usageError{err: fmt.Errorf("%s: %s", errResp.Message, errResp.Error)}
+	}
+
+	transactions := wiring.Transactions(d)
+	if err := transactions.CheckExport(a.ctx, *format, transactionFilters); err != nil {
+		return err
+	}
+	return a.writeOutput(*output, func(w io.Writer) error {
+		if *format == schemas.ExportFormatXLSX {
+			return transactions.ExportXLSX(a.ctx, transactionFilters, transactionSort, w)
+		}
+		return transactions.ExportCSV(a.ctx, transactionFilters, transactionSort, *escape, w)
+	})
+}
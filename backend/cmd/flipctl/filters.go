@@ -0,0 +1,52 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// filterKeys are the filters accepted by --filter, named after the query parameters of GET /api/transactions
+var filterKeys = []string{"status", "type", "search", "start_date", "end_date", "category", "counterparty", "batch", "tag", "reason", "amount"}
+
+// filterFlags collects repeated --filter key=value flags
+type filterFlags map[string]string
+
+func (f filterFlags) String() string {
+	pairs := make([]string, 0, len(f))
+	for key, value := range f {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func (f filterFlags) Set(value string) error {
+	key, filter, ok := strings.Cut(value, "=")
+	key = strings.ToLower(strings.TrimSpace(key))
+	if !ok || key == "" {
+		return errors.New("filter must be key=value")
+	}
+	for _, known := range filterKeys {
+		if key == known {
+			f[key] = filter
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown filter %q (expected one of %s)", key, strings.Join(filterKeys, ", "))
+}
+
+// query returns the value of a filter, in the form the transaction handler reads query parameters
+func (f filterFlags) query(key string, defaultValue ...string) string {
+	if value, ok := f[key]; ok {
+		return value
+	}
+	if len(defaultValue) > 0 {
+		return defaultValue[0]
+	}
+	return ""
+}
+
+// filterUsage describes the --filter flag
+var filterUsage = "filter as key=value, repeatable; keys: " + strings.Join(filterKeys, ", ")
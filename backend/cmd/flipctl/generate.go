@@ -0,0 +1,100 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	generatorUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/generator/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/wiring"
+)
+
+// defaultGenerateDays is the time span generated when --start is not given
+const defaultGenerateDays = 90
+
+// defaultGenerateEnd is the day after the time span generated when --end is not given
+// It is fixed rather than the current date, so the same flags give the same rows on any day
+const defaultGenerateEnd = "2025-01-01"
+
+// runGenerate writes a synthetic dataset in the upload CSV format, or inserts it as one upload batch with --insert
+// The same flags, --seed included, always give the same rows
+func runGenerate(a *app, args []string) error {
+	fs := newFlagSet("generate", "")
+	cfg := schemas.GeneratorConfig{}
+	fs.Int64Var(&cfg.Seed, "seed", 1, "seed of the random source; the same seed and flags give the same rows")
+	fs.IntVar(&cfg.Rows, "rows", 1000, "rows to generate, duplicate and invalid rows included")
+	fs.IntVar(&cfg.Counterparties, "counterparties", 50, "distinct counterparty names, a few of which get most transactions")
+	start := fs.String("start", "", fmt.Sprintf("first day of the time span, as YYYY-MM-DD (default %d days before --end)", defaultGenerateDays))
+	end := fs.String("end", defaultGenerateEnd, "day after the time span, as YYYY-MM-DD")
+	fs.Float64Var(&cfg.CreditRatio, "credit-ratio", 0.3, "share of credits among the transactions")
+	creditAmount := fs.String("credit-amount", "lognormal:5000000:0.6", "credit amounts in whole units: fixed:N, uniform:MIN:MAX, normal:MEAN:STDDEV or lognormal:MEDIAN:SIGMA")
+	debitAmount := fs.String("debit-amount", "lognormal:150000:1", "debit amounts, in the form of --credit-amount")
+	statuses := fs.String("status", "success=90,failed=5,pending=5", "relative weights of the statuses")
+	fs.Float64Var(&cfg.DuplicateRate, "duplicates", 0, "share of rows repeating a recent row exactly")
+	fs.Float64Var(&cfg.InvalidRate, "invalid", 0, "share of rows that fail upload validation; CSV only, since uploads reject the whole file")
+	output := fs.String("output", "-", "file to write, or - for stdout")
+	insert := fs.Bool("insert", false, "insert into the database as one upload batch instead of writing CSV")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return usageError{err: fmt.Errorf("unexpected arguments %v", fs.Args())}
+	}
+
+	var err error
+	if cfg.End, err = time.Parse(time.DateOnly, *end); err != nil {
+		return usageError{err: fmt.Errorf("invalid --end %q, expected YYYY-MM-DD", *end)}
+	}
+	cfg.Start = cfg.End.AddDate(0, 0, -defaultGenerateDays)
+	if *start != "" {
+		if cfg.Start, err = time.Parse(time.DateOnly, *start); err != nil {
+			return usageError{err: fmt.Errorf("invalid --start %q, expected YYYY-MM-DD", *start)}
+		}
+	}
+	if cfg.CreditAmount, err = schemas.ParseAmountDistribution(*creditAmount); err != nil {
+		return usageError{err: err}
+	}
+	if cfg.DebitAmount, err = schemas.ParseAmountDistribution(*debitAmount); err != nil {
+		return usageError{err: err}
+	}
+	if cfg.Statuses, err = schemas.ParseStatusWeights(*statuses); err != nil {
+		return usageError{err: err}
+	}
+	if err := cfg.Validate(); err != nil {
+		return usageError{err: err}
+	}
+	if *insert && cfg.InvalidRate > 0 {
+		return usageError{err: errors.New("--invalid cannot be used with --insert")}
+	}
+	if *insert && *output != "-" {
+		return usageError{err: errors.New("--output cannot be used with --insert")}
+	}
+
+	if *insert {
+		summary, err := wiring.Generator(a.deps()).Insert(a.ctx, cfg)
+		if err != nil {
+			return err
+		}
+		return a.printJSON(summary)
+	}
+
+	// Writing CSV needs no database
+	generator := generatorUseCase.NewUseCase(nil, nil)
+	var summary *schemas.GeneratorSummary
+	err = a.writeOutput(*output, func(w io.Writer) error {
+		summary, err = generator.WriteCSV(a.ctx, cfg, w)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// The summary goes to stderr when stdout carries the CSV
+	if *output == "-" {
+		a.stdout = os.Stderr
+	}
+	return a.printJSON(summary)
+}
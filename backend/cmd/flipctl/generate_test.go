@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+)
+
+// generateFile runs generate with args and returns the CSV it wrote
+func generateFile(t *testing.T, args ...string) []byte {
+	path := filepath.Join(t.TempDir(), "generated.csv")
+	a := &app{ctx: context.Background(), stdout: io.Discard}
+	if err := runGenerate(a, append(args, "--output", path)); err != nil {
+		t.Fatalf("runGenerate failed: %v", err)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
+	}
+	return content
+}
+
+// TestRunGenerateReproducible tests that the same flags give the same rows, within the default time span
+func TestRunGenerateReproducible(t *testing.T) {
+	first := generateFile(t, "--seed", "7", "--rows", "200")
+	second := generateFile(t, "--seed", "7", "--rows", "200")
+	if !bytes.Equal(first, second) {
+		t.Error("Expected the same seed and flags to give the same rows")
+	}
+	if other := generateFile(t, "--seed", "8", "--rows", "200"); bytes.Equal(first, other) {
+		t.Error("Expected another seed to give other rows")
+	}
+
+	records, err := csv.NewReader(bytes.NewReader(first)).ReadAll()
+	if err != nil {
+		t.Fatalf("failed to read generated CSV: %v", err)
+	}
+	if len(records) != 201 {
+		t.Fatalf("Expected a header and 200 rows, got %d records", len(records))
+	}
+
+	end, _ := time.Parse(time.DateOnly, defaultGenerateEnd)
+	start := end.AddDate(0, 0, -defaultGenerateDays)
+	for _, record := range records[1:] {
+		timestamp, err := strconv.ParseInt(record[0], 10, 64)
+		if err != nil {
+			t.Fatalf("Expected an integer timestamp, got %q", record[0])
+		}
+		if timestamp < start.Unix() || timestamp >= end.Unix() {
+			t.Fatalf("Expected timestamps from %s up to %s, got %s", start, end, time.Unix(timestamp, 0).UTC())
+		}
+	}
+}
+
+// TestRunGenerateUsage tests that invalid flag combinations are usage errors
+func TestRunGenerateUsage(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "invalid end", args: []string{"--end", "2025-13-01"}},
+		{name: "invalid start", args: []string{"--start", "yesterday"}},
+		{name: "invalid amount distribution", args: []string{"--debit-amount", "pareto:1:2"}},
+		{name: "invalid status weights", args: []string{"--status", "cancelled=1"}},
+		{name: "invalid rows with insert", args: []string{"--invalid", "0.1", "--insert"}},
+		{name: "output with insert", args: []string{"--output", "rows.csv", "--insert"}},
+		{name: "unexpected arguments", args: []string{"rows.csv"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &app{ctx: context.Background(), stdout: io.Discard}
+			var usageErr usageError
+			if err := runGenerate(a, tt.args); !errors.As(err, &usageErr) {
+				t.Errorf("Expected a usage error, got %v", err)
+			}
+			if a.db != nil {
+				t.Error("Expected usage errors not to open the database")
+			}
+		})
+	}
+}
+
+// TestRunGenerateInsert tests that --insert stores the rows as one upload batch
+func TestRunGenerateInsert(t *testing.T) {
+	var stdout bytes.Buffer
+	a := setupTestApp(t, &stdout)
+
+	if err := runGenerate(a, []string{"--rows", "150", "--insert"}); err != nil {
+		t.Fatalf("runGenerate failed: %v", err)
+	}
+
+	var batches, rows int64
+	conn := a.deps().DB.GetDB()
+	conn.Model(&schemas.UploadBatch{}).Count(&batches)
+	conn.Model(&schemas.Transaction{}).Count(&rows)
+	if batches != 1 || rows != 150 {
+		t.Errorf("Expected 1 batch of 150 transactions, got %d batches and %d transactions", batches, rows)
+	}
+	if stdout.Len() == 0 {
+		t.Error("Expected the generator summary on stdout")
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	uploadHandler "github.com/fadlytanjung/flip-fullstack-test/backend/domain/upload/handler"
+)
+
+// importResult is the outcome of importing one file
+type importResult struct {
+	File   string      `json:"file"`
+	Result interface{} `json:"result,omitempty"`
+	Error  string      `json:"error,omitempty"`
+}
+
+// runImport stores each file given like an upload and prints a result per file
+// It fails when any file fails, after trying all of them
+func runImport(a *app, args []string) error {
+	fs := newFlagSet("import", "<file>...")
+	var opts schemas.UploadOptions
+	fs.StringVar(&opts.Source, "source", "", "source label of the batches, e.g. ledger or bank")
+	fs.StringVar(&opts.Profile, "profile", "", "import profile to apply, by name or ID")
+	fs.StringVar(&opts.Encoding, "encoding", "", "text encoding, e.g. utf-8 or windows-1252 (default detected)")
+	fs.StringVar(&opts.Delimiter, "delimiter", "", "CSV delimiter: comma, semicolon, tab or pipe (default detected)")
+	fs.StringVar(&opts.DecimalSeparator, "decimal-separator", "", "decimal separator of amounts: point or comma (default detected)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return usageError{err: fmt.Errorf("no files given")}
+	}
+
+	handler := uploadHandler.NewHandler(a.deps())
+	results := make([]importResult, 0, fs.NArg())
+	failed := 0
+	for _, path := range fs.Args() {
+		result, err := importFile(a, handler, path, opts)
+		entry := importResult{File: path, Result: result}
+		if err != nil {
+			entry.Error = err.Error()
+			failed++
+		}
+		results = append(results, entry)
+	}
+
+	if err := a.printJSON(results); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d files failed", failed, len(results))
+	}
+	return nil
+}
+
+// importFile opens a local file and stores it under its base name
+func importFile(a *app, handler *uploadHandler.Handler, path string, opts schemas.UploadOptions) (interface{}, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("%s is a directory", path)
+	}
+	return handler.ImportFile(a.ctx, filepath.Base(path), file, info.Size(), opts)
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+
+	transactionHandler "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/handler"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/wiring"
+)
+
+// runIssues prints a page of failed and pending transactions matching the filters
+func runIssues(a *app, args []string) error {
+	fs := newFlagSet("issues", "")
+	filters := filterFlags{}
+	fs.Var(filters, "filter", filterUsage)
+	page := fs.Int("page", 1, "page to print")
+	pageSize := fs.Int("page-size", 100, "transactions per page")
+	sortBy := fs.String("sort-by", "", "field to sort by, as sort_by of GET /api/issues")
+	sortOrder := fs.String("sort-order", "", "sort order: asc or desc")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return usageError{err: fmt.Errorf("unexpected arguments %v", fs.Args())}
+	}
+
+	d := a.deps()
+	if err := d.FieldValidator.ValidatePaginationParams(*page, *pageSize); err != nil {
+		return usageError{err: err}
+	}
+	issueFilters, errResp := transactionHandler.ParseFilters(filters.query, d.FieldValidator)
+	if errResp != nil {
+		return usageError{err: fmt.Errorf("%s: %s", errResp.Message, errResp.Error)}
+	}
+	issueSort, errResp := transactionHandler.ParseSort(*sortBy, *sortOrder, d.FieldValidator.ValidateIssueSortField, d.FieldValidator)
+	if errResp != nil {
+		return usageError{err: fmt.Errorf("%s: %s", errResp.Message, errResp.Error)}
+	}
+
+	issues, err := wiring.Transactions(d).GetIssuesWithFiltersAndSort(a.ctx, *page, *pageSize, issueFilters, issueSort)
+	if err != nil {
+		return err
+	}
+	return a.printJSON(issues)
+}
@@ -0,0 +1,250 @@
+// Command flipctl runs batch jobs against the transactions database without the HTTP server
+//
+// It loads the same configuration as cmd/server and calls the same domain use cases directly:
+//
+//	flipctl [--actor name] <command> [flags] [args]
+//
+// Results are written to stdout, as JSON except for exports, and logs to stderr
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/config"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/deps"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/requestmeta"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+	"github.com/google/uuid"
+	gormLogger "gorm.io/gorm/logger"
+)
+
+// defaultActor is recorded in the audit log when --actor is not given
+const defaultActor = "flipctl"
+
+// Exit codes
+const (
+	exitFailure = 1
+	exitUsage   = 2
+)
+
+// command is a flipctl subcommand
+type command struct {
+	summary string
+	run     func(app *app, args []string) error
+}
+
+// commands lists the subcommands by name
+// It is filled in init, since the subcommands print their summary from it
+var commands map[string]command
+
+func init() {
+	commands = map[string]command{
+		"import":   {"Parse and store transaction files or bank statements, like POST /api/upload", runImport},
+		"export":   {"Export transactions as CSV or XLSX, like GET /api/transactions/export", runExport},
+		"balance":  {"Print the balance of successful transactions, like GET /api/balance", runBalance},
+		"issues":   {"List failed and pending transactions, like GET /api/issues", runIssues},
+		"clear":    {"Clear all transactions, like DELETE /api/clear; requires --confirm", runClear},
+		"migrate":  {"Create or update the database schema", runMigrate},
+		"generate": {"Generate synthetic transactions as upload CSV, or insert them with --insert", runGenerate},
+	}
+}
+
+// app holds what subcommands share
+type app struct {
+	cfg            *config.GlobalConfig
+	logger         *logger.Logger
+	fieldValidator *validator.FieldValidator
+	db             *db.Database
+	ctx            context.Context
+	stdout         io.Writer
+}
+
+// usageError is a mistake in the command line, which exits with exitUsage
+// Flag parsing errors are reported by the flag set itself
+type usageError struct {
+	err      error
+	reported bool
+}
+
+func (e usageError) Error() string {
+	return e.err.Error()
+}
+
+func (e usageError) Unwrap() error {
+	return e.err
+}
+
+func main() {
+	flag.Usage = usage
+	actor := flag.String("actor", defaultActor, "who is recorded in the audit log as performing the command")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(exitUsage)
+	}
+	cmd, ok := commands[flag.Arg(0)]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "flipctl: unknown command %q\n\n", flag.Arg(0))
+		usage()
+		os.Exit(exitUsage)
+	}
+
+	// Load configuration
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flipctl: failed to load config: %v\n", err)
+		os.Exit(exitFailure)
+	}
+
+	fieldValidator, err := deps.NewFieldValidator(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flipctl: invalid timestamp config: %v\n", err)
+		os.Exit(exitFailure)
+	}
+
+	// Logs go to stderr, so stdout carries only the result
+	appLogger := logger.NewLogger(cfg.ServiceName, cfg.LogLevel, logger.WithStderr())
+	defer appLogger.Sync()
+
+	a := &app{
+		cfg:            cfg,
+		logger:         appLogger,
+		fieldValidator: fieldValidator,
+		ctx:            requestmeta.WithMeta(context.Background(), requestmeta.Meta{Actor: *actor, RequestID: uuid.New().String()}),
+		stdout:         os.Stdout,
+	}
+	err = cmd.run(a, flag.Args()[1:])
+	if a.db != nil {
+		a.db.Close()
+	}
+
+	if code, report := exitCode(err); code != 0 {
+		if report {
+			fmt.Fprintf(os.Stderr, "flipctl %s: %v\n", flag.Arg(0), err)
+		}
+		os.Exit(code)
+	}
+}
+
+// exitCode returns the exit code of a command that returned err, and whether err still has to be printed
+func exitCode(err error) (int, bool) {
+	var usageErr usageError
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+		return 0, false
+	case errors.As(err, &usageErr):
+		return exitUsage, !usageErr.reported
+	default:
+		return exitFailure, true
+	}
+}
+
+// usage prints the global flags and the commands
+func usage() {
+	out := flag.CommandLine.Output()
+	fmt.Fprintf(out, "Usage: flipctl [--actor name] <command> [flags] [args]\n\nCommands:\n")
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(out, "  %-8s %s\n", name, commands[name].summary)
+	}
+
+	fmt.Fprintf(out, "\nRun flipctl <command> --help for the flags of a command.\n\nGlobal flags:\n")
+	flag.PrintDefaults()
+}
+
+// newFlagSet creates the flag set of a subcommand, whose errors are returned rather than exiting
+func newFlagSet(name, args string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: flipctl %s [flags]", name)
+		if args != "" {
+			fmt.Fprintf(fs.Output(), " %s", args)
+		}
+		fmt.Fprintf(fs.Output(), "\n\n%s\n\nFlags:\n", commands[name].summary)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parseFlags parses the flags of a subcommand
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		return usageError{err: err, reported: true}
+	}
+	return nil
+}
+
+// deps opens the database on first use and returns the dependencies handlers are built from
+// Commands call it after parsing their flags, so --help and usage errors never touch the database
+func (a *app) deps() *deps.App {
+	if a.db == nil {
+		a.db = db.New(a.cfg.DatabasePath)
+		// GORM logs to stdout by default, which would mix with exports
+		a.db.DB.Logger = gormLogger.New(log.New(os.Stderr, "\r\n", log.LstdFlags), gormLogger.Config{
+			SlowThreshold:             200 * time.Millisecond,
+			LogLevel:                  gormLogger.Warn,
+			IgnoreRecordNotFoundError: true,
+		})
+	}
+	return &deps.App{
+		Logger:         a.logger,
+		DB:             a.db,
+		FieldValidator: a.fieldValidator,
+		Ctx:            a.ctx,
+	}
+}
+
+// printJSON writes v to stdout as indented JSON
+func (a *app) printJSON(v interface{}) error {
+	encoder := json.NewEncoder(a.stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// writeOutput calls write with a buffered writer for path, or for stdout when path is -
+// Files are written next to path and renamed once complete, so a failed write never leaves a partial file behind
+func (a *app) writeOutput(path string, write func(w io.Writer) error) error {
+	if path == "-" {
+		w := bufio.NewWriter(a.stdout)
+		if err := write(w); err != nil {
+			return err
+		}
+		return w.Flush()
+	}
+
+	tmp := path + ".tmp"
+	file, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(file)
+	err = write(w)
+	if err == nil {
+		err = w.Flush()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Join(err, os.Remove(tmp))
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/config"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/deps"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+)
+
+// setupTestApp creates an app backed by a migrated SQLite database in a temporary directory, writing results to stdout
+func setupTestApp(t *testing.T, stdout io.Writer) *app {
+	t.Setenv("DATABASE_PATH", filepath.Join(t.TempDir(), "transactions.db"))
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	fieldValidator, err := deps.NewFieldValidator(cfg)
+	if err != nil {
+		t.Fatalf("failed to build field validator: %v", err)
+	}
+
+	a := &app{
+		cfg:            cfg,
+		logger:         logger.NewLogger(cfg.ServiceName, "error", logger.WithStderr()),
+		fieldValidator: fieldValidator,
+		ctx:            context.Background(),
+		stdout:         io.Discard,
+	}
+	t.Cleanup(func() {
+		if a.db != nil {
+			a.db.Close()
+		}
+	})
+
+	if err := runMigrate(a, nil); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	a.stdout = stdout
+	return a
+}
+
+// TestFilterFlagsSet tests parsing of --filter key=value flags
+func TestFilterFlagsSet(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		key     string
+		want    string
+		wantErr bool
+	}{
+		{name: "known key", value: "status=FAILED", key: "status", want: "FAILED"},
+		{name: "key is trimmed and lowercased", value: " Type =DEBIT", key: "type", want: "DEBIT"},
+		{name: "value keeps its equals signs", value: "search=a=b", key: "search", want: "a=b"},
+		{name: "empty value", value: "tag=", key: "tag", want: ""},
+		{name: "no equals sign", value: "status", wantErr: true},
+		{name: "empty key", value: "=FAILED", wantErr: true},
+		{name: "unknown key", value: "color=red", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filters := filterFlags{}
+			err := filters.Set(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected an error for %q, got filters %v", tt.value, filters)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Set failed: %v", err)
+			}
+			if got, ok := filters[tt.key]; !ok || got != tt.want {
+				t.Errorf("Expected %s=%q, got %v", tt.key, tt.want, filters)
+			}
+		})
+	}
+}
+
+// TestWriteOutput tests that files are only written once complete and a failed write leaves nothing behind
+func TestWriteOutput(t *testing.T) {
+	writeFailed := errors.New("write failed")
+	tests := []struct {
+		name     string
+		existing string
+		write    func(w io.Writer) error
+		want     string
+		wantErr  error
+	}{
+		{
+			name:  "complete write",
+			write: func(w io.Writer) error { _, err := io.WriteString(w, "rows"); return err },
+			want:  "rows",
+		},
+		{
+			name:     "complete write replaces the file",
+			existing: "old",
+			write:    func(w io.Writer) error { _, err := io.WriteString(w, "new"); return err },
+			want:     "new",
+		},
+		{
+			name:    "failed write",
+			write:   func(w io.Writer) error { io.WriteString(w, "partial"); return writeFailed },
+			wantErr: writeFailed,
+		},
+		{
+			name:     "failed write keeps the existing file",
+			existing: "old",
+			write:    func(w io.Writer) error { io.WriteString(w, "partial"); return writeFailed },
+			want:     "old",
+			wantErr:  writeFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "export.csv")
+			if tt.existing != "" {
+				if err := os.WriteFile(path, []byte(tt.existing), 0o600); err != nil {
+					t.Fatalf("failed to write existing file: %v", err)
+				}
+			}
+
+			a := &app{stdout: io.Discard}
+			if err := a.writeOutput(path, tt.write); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+
+			content, err := os.ReadFile(path)
+			if tt.want == "" {
+				if !os.IsNotExist(err) {
+					t.Errorf("Expected no file, got %q (%v)", content, err)
+				}
+			} else if string(content) != tt.want {
+				t.Errorf("Expected %q, got %q (%v)", tt.want, content, err)
+			}
+			if _, err := os.Stat(path + ".tmp"); !os.IsNotExist(err) {
+				t.Errorf("Expected the temporary file to be removed, got %v", err)
+			}
+		})
+	}
+}
+
+// TestWriteOutputStdout tests that - writes to stdout
+func TestWriteOutputStdout(t *testing.T) {
+	var stdout bytes.Buffer
+	a := &app{stdout: &stdout}
+	err := a.writeOutput("-", func(w io.Writer) error {
+		_, err := io.WriteString(w, "rows")
+		return err
+	})
+	if err != nil {
+		t.Fatalf("writeOutput failed: %v", err)
+	}
+	if stdout.String() != "rows" {
+		t.Errorf("Expected rows on stdout, got %q", stdout.String())
+	}
+}
+
+// TestExitCode tests the exit code of each kind of command error and whether it is still printed
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantCode   int
+		wantReport bool
+	}{
+		{name: "success", err: nil, wantCode: 0},
+		{name: "help", err: usageError{err: flag.ErrHelp, reported: true}, wantCode: 0},
+		{name: "flag error reported by the flag set", err: usageError{err: errors.New("bad flag"), reported: true}, wantCode: exitUsage},
+		{name: "usage error", err: usageError{err: errors.New("no files given")}, wantCode: exitUsage, wantReport: true},
+		{name: "wrapped usage error", err: fmt.Errorf("import: %w", usageError{err: errors.New("no files given")}), wantCode: exitUsage, wantReport: true},
+		{name: "failure", err: errors.New("database is locked"), wantCode: exitFailure, wantReport: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, report := exitCode(tt.err)
+			if code != tt.wantCode || report != tt.wantReport {
+				t.Errorf("Expected code %d and report %v, got %d and %v", tt.wantCode, tt.wantReport, code, report)
+			}
+		})
+	}
+}
+
+// TestRunClear tests that clear refuses to run without --confirm and clears all transactions with it
+func TestRunClear(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		wantUsage bool
+		wantRows  int64
+	}{
+		{name: "without confirm", args: nil, wantUsage: true, wantRows: 1},
+		{name: "confirm false", args: []string{"--confirm=false"}, wantUsage: true, wantRows: 1},
+		{name: "unexpected arguments", args: []string{"--confirm", "now"}, wantUsage: true, wantRows: 1},
+		{name: "confirm", args: []string{"--confirm"}, wantRows: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			a := setupTestApp(t, &stdout)
+			conn := a.deps().DB.GetDB()
+			if err := conn.Create(&schemas.Transaction{ID: "1", Timestamp: 1624507883, Name: "JOHN DOE", Type: schemas.TypeDebit, Amount: 25000000, Status: schemas.StatusSuccess}).Error; err != nil {
+				t.Fatalf("failed to create transaction: %v", err)
+			}
+
+			err := runClear(a, tt.args)
+			var usageErr usageError
+			if tt.wantUsage != errors.As(err, &usageErr) {
+				t.Fatalf("Expected usage error %v, got %v", tt.wantUsage, err)
+			}
+			if !tt.wantUsage && err != nil {
+				t.Fatalf("runClear failed: %v", err)
+			}
+
+			var rows int64
+			conn.Model(&schemas.Transaction{}).Count(&rows)
+			if rows != tt.wantRows {
+				t.Errorf("Expected %d transactions left, got %d", tt.wantRows, rows)
+			}
+			if !tt.wantUsage && stdout.Len() == 0 {
+				t.Error("Expected the clear result on stdout")
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/migration"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+)
+
+// runMigrate creates or updates the database schema, as the server does at startup
+func runMigrate(a *app, args []string) error {
+	fs := newFlagSet("migrate", "")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return usageError{err: fmt.Errorf("unexpected arguments %v", fs.Args())}
+	}
+
+	if err := migration.Migrate(a.deps().DB.GetDB()); err != nil {
+		return err
+	}
+	a.logger.Info("Database migrated", logger.String("path", a.cfg.DatabasePath))
+	return a.printJSON(map[string]string{"database": a.cfg.DatabasePath, "status": "migrated"})
+}
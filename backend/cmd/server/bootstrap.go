@@ -1,11 +1,23 @@
 package main
 
 import (
+	auditHandler "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/handler"
+	categoryHandler "github.com/fadlytanjung/flip-fullstack-test/backend/domain/category/handler"
+	counterpartyHandler "github.com/fadlytanjung/flip-fullstack-test/backend/domain/counterparty/handler"
+	forecastHandler "github.com/fadlytanjung/flip-fullstack-test/backend/domain/forecast/handler"
+	linkHandler "github.com/fadlytanjung/flip-fullstack-test/backend/domain/link/handler"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/migration"
+	profileHandler "github.com/fadlytanjung/flip-fullstack-test/backend/domain/profile/handler"
+	reconciliationHandler "github.com/fadlytanjung/flip-fullstack-test/backend/domain/reconciliation/handler"
+	recurringHandler "github.com/fadlytanjung/flip-fullstack-test/backend/domain/recurring/handler"
+	reportHandler "github.com/fadlytanjung/flip-fullstack-test/backend/domain/report/handler"
+	ruleHandler "github.com/fadlytanjung/flip-fullstack-test/backend/domain/rule/handler"
+	splitHandler "github.com/fadlytanjung/flip-fullstack-test/backend/domain/split/handler"
 	transactionHandler "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/handler"
-	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
 	uploadHandler "github.com/fadlytanjung/flip-fullstack-test/backend/domain/upload/handler"
 	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/config"
 	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/deps"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -14,7 +26,9 @@ func BootstrapApp(d *deps.App) *deps.App {
 	cfg := config.GetConfig()
 
 	// Auto-migrate database schema
-	d.DB.GetDB().AutoMigrate(&schemas.Transaction{})
+	if err := migration.Migrate(d.DB.GetDB()); err != nil {
+		d.Logger.Fatal("Failed to migrate database", logger.Error(err))
+	}
 
 	// Health check
 	d.Fiber.Get("/api/health", func(c *fiber.Ctx) error {
@@ -29,6 +43,17 @@ func BootstrapApp(d *deps.App) *deps.App {
 	// Register domain APIs
 	transactionHandler.RegisterApi(d)
 	uploadHandler.RegisterApi(d)
+	profileHandler.RegisterApi(d)
+	categoryHandler.RegisterApi(d)
+	splitHandler.RegisterApi(d)
+	ruleHandler.RegisterApi(d)
+	counterpartyHandler.RegisterApi(d)
+	reconciliationHandler.RegisterApi(d)
+	recurringHandler.RegisterApi(d)
+	forecastHandler.RegisterApi(d)
+	linkHandler.RegisterApi(d)
+	reportHandler.RegisterApi(d)
+	auditHandler.RegisterApi(d)
 
 	return d
 }
@@ -1,12 +1,17 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/config"
 	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
 	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/deps"
 	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/requestmeta"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 )
@@ -16,14 +21,18 @@ func setupMiddleware(app *fiber.App, l *logger.Logger, cfg *config.GlobalConfig)
 	// Panic recovery with structured logging
 	app.Use(logger.RecoveryHandler(l))
 
+	// Request metadata (actor, request ID, client IP) for logs and write paths
+	app.Use(requestmeta.Middleware())
+
 	// HTTP request logging
 	app.Use(logger.HTTPLogger(l))
 
 	// CORS
 	app.Use(cors.New(cors.Config{
-		AllowOrigins: cfg.CorsAllowOrigins,
-		AllowMethods: "GET,POST,PUT,DELETE,OPTIONS",
-		AllowHeaders: "Accept,Authorization,Content-Type,X-CSRF-Token",
+		AllowOrigins:  cfg.CorsAllowOrigins,
+		AllowMethods:  "GET,HEAD,POST,PUT,PATCH,DELETE,OPTIONS",
+		AllowHeaders:  "Accept,Authorization,Content-Type,X-CSRF-Token,X-Actor,X-Request-ID,Upload-Offset,Upload-Checksum",
+		ExposeHeaders: "X-Request-ID,Location,Upload-Offset,Upload-Length",
 	}))
 }
 
@@ -72,19 +81,48 @@ func main() {
 	// Setup middleware
 	setupMiddleware(app, appLogger, cfg)
 
+	// Build the field validator shared by all handlers
+	fieldValidator, err := deps.NewFieldValidator(cfg)
+	if err != nil {
+		appLogger.Fatal("Invalid timestamp config", logger.Error(err))
+	}
+
+	// Cancelled on SIGINT or SIGTERM, which stops the server and the background workers
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	// Create app dependencies
 	instance := &deps.App{
-		Logger: appLogger,
-		DB:     database,
-		Fiber:  app,
+		Logger:         appLogger,
+		DB:             database,
+		Fiber:          app,
+		FieldValidator: fieldValidator,
+		Ctx:            ctx,
 	}
 
-	// Bootstrap application (register routes only)
+	// Bootstrap application (register routes and start background workers)
 	BootstrapApp(instance)
 
 	// Start server
 	appLogger.Info("Server starting", logger.Int("port", cfg.Port))
-	if err := app.Listen(fmt.Sprintf(":%d", cfg.Port)); err != nil {
+	listenErr := make(chan error, 1)
+	go func() {
+		listenErr <- app.Listen(fmt.Sprintf(":%d", cfg.Port))
+	}()
+
+	select {
+	case err := <-listenErr:
+		stop()
+		instance.Wait()
 		appLogger.Fatal("Failed to start server", logger.Error(err))
+	case <-ctx.Done():
+	}
+
+	// Finish in-flight requests and workers before the deferred database close
+	appLogger.Info("Shutting down")
+	if err := app.Shutdown(); err != nil {
+		appLogger.Error("Failed to shut down server", logger.Error(err))
 	}
+	instance.Wait()
+	appLogger.Info("Server stopped")
 }
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+	"gorm.io/gorm/clause"
+)
+
+// CreateFlags stores anomaly flags, skipping any a transaction already has for the same reason
+func (r *Repository) CreateFlags(ctx context.Context, flags []schemas.TransactionFlag) error {
+	if len(flags) == 0 {
+		return nil
+	}
+
+	return db.Conn(ctx, r.DB).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		CreateInBatches(flags, 100).Error
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+	"gorm.io/gorm"
+)
+
+// FindAmountHistory retrieves the amounts of a counterparty's earlier transactions of one type,
+// leaving out the rows of the given batch
+func (r *Repository) FindAmountHistory(ctx context.Context, counterpartyID string, transactionType schemas.TransactionType, excludeBatchID string) ([]int64, error) {
+	var amounts []int64
+	err := excludeBatch(db.Conn(ctx, r.DB).Model(&schemas.Transaction{}), excludeBatchID).
+		Where("counterparty_id = ? AND type = ?", counterpartyID, transactionType).
+		Pluck("amount", &amounts).Error
+	return amounts, err
+}
+
+// CountStatuses counts FAILED and all transactions outside the given batch
+func (r *Repository) CountStatuses(ctx context.Context, excludeBatchID string) (int64, int64, error) {
+	var counts struct {
+		Failed int64
+		Total  int64
+	}
+	err := excludeBatch(db.Conn(ctx, r.DB).Model(&schemas.Transaction{}), excludeBatchID).
+		Select("COALESCE(SUM(CASE WHEN status = ? THEN 1 ELSE 0 END), 0) AS failed, COUNT(*) AS total", schemas.StatusFailed).
+		Scan(&counts).Error
+	return counts.Failed, counts.Total, err
+}
+
+// excludeBatch leaves the rows of a batch out of a query; rows without a batch are kept
+func excludeBatch(query *gorm.DB, batchID string) *gorm.DB {
+	if batchID == "" {
+		return query
+	}
+	return query.Where("(batch_id IS NULL OR batch_id <> ?)", batchID)
+}
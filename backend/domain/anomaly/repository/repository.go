@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/gorm"
+)
+
+// IRepository defines the contract for anomaly repository operations
+type IRepository interface {
+	// Commands
+	CreateFlags(ctx context.Context, flags []schemas.TransactionFlag) error
+
+	// Queries
+	FindAmountHistory(ctx context.Context, counterpartyID string, transactionType schemas.TransactionType, excludeBatchID string) ([]int64, error)
+	CountStatuses(ctx context.Context, excludeBatchID string) (failed int64, total int64, err error)
+}
+
+// Repository implements IRepository
+type Repository struct {
+	DB *gorm.DB
+}
+
+// NewRepository creates a new anomaly repository instance
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
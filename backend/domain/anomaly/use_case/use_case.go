@@ -0,0 +1,206 @@
+package use_case
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/anomaly/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+)
+
+// IUseCase defines the contract for anomaly use case operations
+type IUseCase interface {
+	Detect(ctx context.Context, batchID string, transactions []schemas.Transaction) (int, error)
+}
+
+// UseCase implements IUseCase
+type UseCase struct {
+	Repository repository.IRepository
+	Policy     schemas.AnomalyPolicy
+	Now        func() time.Time
+}
+
+// NewUseCase creates a new anomaly use case instance
+func NewUseCase(repo repository.IRepository, policy schemas.AnomalyPolicy) IUseCase {
+	return &UseCase{
+		Repository: repo,
+		Policy:     policy,
+		Now:        time.Now,
+	}
+}
+
+// historyKey groups batch rows whose amounts are judged against the same history
+type historyKey struct {
+	counterpartyID  string
+	transactionType schemas.TransactionType
+}
+
+// Detect flags suspicious rows of a stored upload batch and returns how many rows were flagged
+// Rows are compared with everything stored before the batch, so the batch never judges itself
+func (uc *UseCase) Detect(ctx context.Context, batchID string, transactions []schemas.Transaction) (int, error) {
+	now := uc.Now().UTC()
+	var flags []schemas.TransactionFlag
+	flag := func(t *schemas.Transaction, reason string, detail string) {
+		flags = append(flags, schemas.TransactionFlag{
+			TransactionID: t.ID,
+			Reason:        reason,
+			Detail:        detail,
+			CreatedAt:     now,
+		})
+	}
+
+	groups := make(map[historyKey][]*schemas.Transaction)
+	for i := range transactions {
+		t := &transactions[i]
+
+		if uc.Policy.LargeDebitAmount > 0 && t.Type == schemas.TypeDebit && t.Amount >= uc.Policy.LargeDebitAmount {
+			flag(t, schemas.FlagLargeDebit, fmt.Sprintf("debit of %s is at or above %s", schemas.FormatAmount(t.Amount), schemas.FormatAmount(uc.Policy.LargeDebitAmount)))
+		}
+
+		local := time.Unix(t.Timestamp, 0).In(uc.Policy.Location)
+		if uc.Policy.IsOddHour(local.Hour()) {
+			flag(t, schemas.FlagOddHours, fmt.Sprintf("made at %s %s", local.Format("15:04"), uc.Policy.Location))
+		}
+
+		if t.CounterpartyID != nil {
+			key := historyKey{counterpartyID: *t.CounterpartyID, transactionType: t.Type}
+			groups[key] = append(groups[key], t)
+		}
+	}
+
+	for key, rows := range groups {
+		history, err := uc.Repository.FindAmountHistory(ctx, key.counterpartyID, key.transactionType, batchID)
+		if err != nil {
+			return 0, err
+		}
+		if len(history) < uc.Policy.MinHistory || len(history) == 0 {
+			continue
+		}
+
+		judge := uc.outlierJudge(history)
+		for _, t := range rows {
+			if detail, ok := judge(t.Amount); ok {
+				flag(t, schemas.FlagAmountOutlier, detail)
+			}
+		}
+	}
+
+	spikeFlags, err := uc.detectFailedRateSpike(ctx, batchID, transactions)
+	if err != nil {
+		return 0, err
+	}
+	for _, t := range spikeFlags.rows {
+		flag(t, schemas.FlagFailedRateSpike, spikeFlags.detail)
+	}
+
+	if err := uc.Repository.CreateFlags(ctx, flags); err != nil {
+		return 0, err
+	}
+
+	flagged := make(map[string]bool)
+	for _, f := range flags {
+		flagged[f.TransactionID] = true
+	}
+	return len(flagged), nil
+}
+
+// outlierJudge returns a check of amounts against a history using the configured method
+// A history without spread (all amounts equal) is not judged
+func (uc *UseCase) outlierJudge(history []int64) func(amount int64) (string, bool) {
+	values := make([]float64, len(history))
+	for i, amount := range history {
+		values[i] = float64(amount)
+	}
+	sort.Float64s(values)
+
+	if uc.Policy.OutlierMethod == schemas.OutlierMethodIQR {
+		q1, q3 := quantile(values, 0.25), quantile(values, 0.75)
+		spread := q3 - q1
+		low, high := q1-uc.Policy.IQRMultiplier*spread, q3+uc.Policy.IQRMultiplier*spread
+		return func(amount int64) (string, bool) {
+			value := float64(amount)
+			if spread == 0 || (value >= low && value <= high) {
+				return "", false
+			}
+			return fmt.Sprintf("amount %s is outside %s to %s (IQR) of %d earlier transactions",
+				schemas.FormatAmount(amount), schemas.FormatAmount(int64(math.Round(low))), schemas.FormatAmount(int64(math.Round(high))), len(values)), true
+		}
+	}
+
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+	var squares float64
+	for _, v := range values {
+		squares += (v - mean) * (v - mean)
+	}
+	stddev := math.Sqrt(squares / float64(len(values)))
+
+	return func(amount int64) (string, bool) {
+		if stddev == 0 {
+			return "", false
+		}
+		z := (float64(amount) - mean) / stddev
+		if math.Abs(z) < uc.Policy.ZScoreThreshold {
+			return "", false
+		}
+		return fmt.Sprintf("amount %s has z-score %.1f against %d earlier transactions",
+			schemas.FormatAmount(amount), z, len(values)), true
+	}
+}
+
+// failedRateSpike holds the FAILED rows of a batch whose failure rate spiked
+type failedRateSpike struct {
+	rows   []*schemas.Transaction
+	detail string
+}
+
+// detectFailedRateSpike flags every FAILED row of a batch whose FAILED rate rose above
+// the historical rate by at least the configured delta
+func (uc *UseCase) detectFailedRateSpike(ctx context.Context, batchID string, transactions []schemas.Transaction) (failedRateSpike, error) {
+	if len(transactions) == 0 || len(transactions) < uc.Policy.MinHistory {
+		return failedRateSpike{}, nil
+	}
+
+	var failed []*schemas.Transaction
+	for i := range transactions {
+		if transactions[i].Status == schemas.StatusFailed {
+			failed = append(failed, &transactions[i])
+		}
+	}
+	if len(failed) == 0 {
+		return failedRateSpike{}, nil
+	}
+
+	historyFailed, historyTotal, err := uc.Repository.CountStatuses(ctx, batchID)
+	if err != nil {
+		return failedRateSpike{}, err
+	}
+	if historyTotal == 0 || historyTotal < int64(uc.Policy.MinHistory) {
+		return failedRateSpike{}, nil
+	}
+
+	batchRate := float64(len(failed)) / float64(len(transactions))
+	historyRate := float64(historyFailed) / float64(historyTotal)
+	if batchRate-historyRate < uc.Policy.FailedRateDelta {
+		return failedRateSpike{}, nil
+	}
+
+	return failedRateSpike{
+		rows:   failed,
+		detail: fmt.Sprintf("batch FAILED rate %.0f%% against %.0f%% before", batchRate*100, historyRate*100),
+	}, nil
+}
+
+// quantile returns the q-th quantile of sorted values, interpolating between neighbours
+func quantile(sorted []float64, q float64) float64 {
+	position := q * float64(len(sorted)-1)
+	lower := int(math.Floor(position))
+	upper := int(math.Ceil(position))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(position-float64(lower))
+}
@@ -0,0 +1,200 @@
+package use_case
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/anomaly/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+const (
+	noon    int64 = 1700049600 // 2023-11-15 12:00 UTC
+	twoAM   int64 = 1700013600 // 2023-11-15 02:00 UTC
+	partyID       = "cp-1"
+)
+
+// setupTestUseCase creates a use case backed by an in-memory SQLite database
+func setupTestUseCase(t *testing.T, method string) (IUseCase, *gorm.DB) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to setup test database: %v", err)
+	}
+
+	if err := db.AutoMigrate(&schemas.Transaction{}, &schemas.TransactionFlag{}); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	policy, err := schemas.NewAnomalyPolicy(method, 3, 1.5, 5, 0.25, 1000000, "0-5", "UTC")
+	if err != nil {
+		t.Fatalf("failed to build policy: %v", err)
+	}
+
+	return NewUseCase(repository.NewRepository(db), policy), db
+}
+
+// storeBatch stores transactions under a batch ID, linked to the test counterparty
+func storeBatch(t *testing.T, db *gorm.DB, batchID string, transactions []schemas.Transaction) []schemas.Transaction {
+	counterpartyID := partyID
+	for i := range transactions {
+		transactions[i].BatchID = &batchID
+		transactions[i].CounterpartyID = &counterpartyID
+		if transactions[i].Timestamp == 0 {
+			transactions[i].Timestamp = noon
+		}
+		if transactions[i].Type == "" {
+			transactions[i].Type = schemas.TypeDebit
+		}
+		if transactions[i].Status == "" {
+			transactions[i].Status = schemas.StatusSuccess
+		}
+	}
+	if err := db.Create(&transactions).Error; err != nil {
+		t.Fatalf("failed to insert test data: %v", err)
+	}
+	return transactions
+}
+
+// storeHistory stores earlier transactions of the test counterparty, one of them FAILED
+func storeHistory(t *testing.T, db *gorm.DB) {
+	storeBatch(t, db, "old", []schemas.Transaction{
+		{ID: "H1", Amount: 10000},
+		{ID: "H2", Amount: 11000},
+		{ID: "H3", Amount: 9000},
+		{ID: "H4", Amount: 10500},
+		{ID: "H5", Amount: 9500},
+		{ID: "H6", Amount: 10200},
+		{ID: "H7", Amount: 9800, Status: schemas.StatusFailed},
+		{ID: "H8", Amount: 10100},
+	})
+}
+
+// findReasons returns the flag reasons stored per transaction
+func findReasons(t *testing.T, db *gorm.DB) map[string][]string {
+	var flags []schemas.TransactionFlag
+	if err := db.Order("transaction_id, reason").Find(&flags).Error; err != nil {
+		t.Fatalf("failed to load flags: %v", err)
+	}
+	reasons := make(map[string][]string)
+	for _, f := range flags {
+		reasons[f.TransactionID] = append(reasons[f.TransactionID], f.Reason)
+	}
+	return reasons
+}
+
+// TestDetectFlagsAnomalies tests each reason against the stored history
+func TestDetectFlagsAnomalies(t *testing.T) {
+	uc, db := setupTestUseCase(t, schemas.OutlierMethodZScore)
+	storeHistory(t, db)
+
+	batch := storeBatch(t, db, "new", []schemas.Transaction{
+		{ID: "N1", Amount: 10300},
+		{ID: "N2", Amount: 500000},
+		{ID: "N3", Amount: 2000000},
+		{ID: "N4", Amount: 9900, Timestamp: twoAM},
+		{ID: "N5", Amount: 2000000, Type: schemas.TypeCredit},
+	})
+
+	flagged, err := uc.Detect(context.Background(), "new", batch)
+	if err != nil {
+		t.Fatalf("Detect failed: %v", err)
+	}
+
+	reasons := findReasons(t, db)
+	expected := map[string][]string{
+		"N2": {schemas.FlagAmountOutlier},
+		"N3": {schemas.FlagAmountOutlier, schemas.FlagLargeDebit},
+		"N4": {schemas.FlagOddHours},
+	}
+	if flagged != len(expected) || len(reasons) != len(expected) {
+		t.Fatalf("Expected %d flagged rows, got %d: %v", len(expected), flagged, reasons)
+	}
+	for id, want := range expected {
+		if len(reasons[id]) != len(want) {
+			t.Errorf("Expected %s to have %v, got %v", id, want, reasons[id])
+			continue
+		}
+		for i := range want {
+			if reasons[id][i] != want[i] {
+				t.Errorf("Expected %s to have %v, got %v", id, want, reasons[id])
+			}
+		}
+	}
+
+	// Running again does not duplicate flags
+	if _, err := uc.Detect(context.Background(), "new", batch); err != nil {
+		t.Fatalf("Detect failed: %v", err)
+	}
+	var count int64
+	db.Model(&schemas.TransactionFlag{}).Count(&count)
+	if count != 4 {
+		t.Errorf("Expected 4 flags after a second run, got %d", count)
+	}
+}
+
+// TestDetectIQROutlier tests the interquartile range method
+func TestDetectIQROutlier(t *testing.T) {
+	uc, db := setupTestUseCase(t, schemas.OutlierMethodIQR)
+	storeHistory(t, db)
+
+	batch := storeBatch(t, db, "new", []schemas.Transaction{
+		{ID: "N1", Amount: 10800},
+		{ID: "N2", Amount: 14000},
+	})
+
+	if _, err := uc.Detect(context.Background(), "new", batch); err != nil {
+		t.Fatalf("Detect failed: %v", err)
+	}
+
+	reasons := findReasons(t, db)
+	if len(reasons) != 1 || len(reasons["N2"]) != 1 || reasons["N2"][0] != schemas.FlagAmountOutlier {
+		t.Errorf("Expected only N2 to be an outlier, got %v", reasons)
+	}
+}
+
+// TestDetectFailedRateSpike tests that a batch failing far more often than before flags its FAILED rows
+func TestDetectFailedRateSpike(t *testing.T) {
+	uc, db := setupTestUseCase(t, schemas.OutlierMethodZScore)
+	storeHistory(t, db)
+
+	batch := storeBatch(t, db, "new", []schemas.Transaction{
+		{ID: "N1", Amount: 10000, Status: schemas.StatusFailed},
+		{ID: "N2", Amount: 10000, Status: schemas.StatusFailed},
+		{ID: "N3", Amount: 10000},
+		{ID: "N4", Amount: 10000},
+		{ID: "N5", Amount: 10000, Status: schemas.StatusPending},
+	})
+
+	flagged, err := uc.Detect(context.Background(), "new", batch)
+	if err != nil {
+		t.Fatalf("Detect failed: %v", err)
+	}
+
+	reasons := findReasons(t, db)
+	if flagged != 2 || len(reasons["N1"]) != 1 || reasons["N1"][0] != schemas.FlagFailedRateSpike || len(reasons["N2"]) != 1 {
+		t.Errorf("Expected N1 and N2 to be flagged as a spike, got %v", reasons)
+	}
+}
+
+// TestDetectWithoutHistory tests that a first upload is only judged by the history-free checks
+func TestDetectWithoutHistory(t *testing.T) {
+	uc, db := setupTestUseCase(t, schemas.OutlierMethodZScore)
+
+	batch := storeBatch(t, db, "new", []schemas.Transaction{
+		{ID: "N1", Amount: 100, Status: schemas.StatusFailed},
+		{ID: "N2", Amount: 500000, Status: schemas.StatusFailed},
+		{ID: "N3", Amount: 100, Status: schemas.StatusFailed},
+		{ID: "N4", Amount: 100, Status: schemas.StatusFailed},
+		{ID: "N5", Amount: 100, Status: schemas.StatusFailed},
+	})
+
+	flagged, err := uc.Detect(context.Background(), "new", batch)
+	if err != nil {
+		t.Fatalf("Detect failed: %v", err)
+	}
+	if flagged != 0 {
+		t.Errorf("Expected no flags without history, got %v", findReasons(t, db))
+	}
+}
@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	transactionHandler "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/handler"
+	transactionSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetAuditEvents returns audit events, newest first, with filtering and pagination
+func (h *Handler) GetAuditEvents(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "GetAuditEvents"),
+	)
+
+	page, pageSize, errResp := transactionHandler.ParsePagination(c.Query, h.FieldValidator)
+	if errResp != nil {
+		l.Warn("Invalid pagination parameters", logger.String("error", errResp.Error))
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	filters := schemas.AuditFilters{
+		Actor:      c.Query("actor"),
+		Action:     c.Query("action"),
+		TargetType: c.Query("target_type"),
+		TargetID:   c.Query("target_id"),
+		RequestID:  c.Query("request_id"),
+		StartDate:  c.Query("start_date"),
+		EndDate:    c.Query("end_date"),
+	}
+
+	if err := h.FieldValidator.ValidateDateRange(filters.StartDate, filters.EndDate); err != nil {
+		l.Warn("Invalid date range", logger.Error(err))
+		return c.Status(http.StatusBadRequest).JSON(transactionSchemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidDateRange,
+			Error:   err.Error(),
+		})
+	}
+
+	response, err := h.UseCase.List(c.Context(), page, pageSize, filters)
+	if err != nil {
+		l.Error("Failed to retrieve audit events", logger.Error(err))
+		return c.Status(http.StatusInternalServerError).JSON(transactionSchemas.ErrorResponse{
+			Status:  http.StatusInternalServerError,
+			Message: constants.MsgFailedToRetrieveAudit,
+			Error:   err.Error(),
+		})
+	}
+
+	return c.Status(http.StatusOK).JSON(transactionSchemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
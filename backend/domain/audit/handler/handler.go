@@ -0,0 +1,45 @@
+package handler
+
+import (
+	auditRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/repository"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/deps"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+)
+
+const ContextName = "Domain.Audit.Handler"
+
+// Handler defines the audit handlers
+type Handler struct {
+	Logger         *logger.Logger
+	UseCase        auditUseCase.IUseCase
+	FieldValidator *validator.FieldValidator
+}
+
+// NewHandler creates a new audit handler instance with all dependencies
+func NewHandler(d *deps.App) *Handler {
+	// Initialize repository
+	repository := auditRepo.NewRepository(d.DB.GetDB())
+
+	// Initialize use case
+	useCase := auditUseCase.NewUseCase(repository)
+
+	return &Handler{
+		Logger:         d.Logger,
+		UseCase:        useCase,
+		FieldValidator: d.FieldValidator,
+	}
+}
+
+// RegisterApi registers audit API routes
+func RegisterApi(d *deps.App) *Handler {
+	handler := NewHandler(d)
+
+	api := d.Fiber.Group("/api")
+
+	api.Get("/audit", handler.GetAuditEvents)
+	api.Get("/audit/verify", handler.VerifyAuditChain)
+
+	return handler
+}
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"net/http"
+
+	transactionSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// VerifyAuditChain recomputes the audit hash chain and reports the first broken event
+func (h *Handler) VerifyAuditChain(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "VerifyAuditChain"),
+	)
+
+	response, err := h.UseCase.Verify(c.Context())
+	if err != nil {
+		l.Error("Failed to verify audit chain", logger.Error(err))
+		return c.Status(http.StatusInternalServerError).JSON(transactionSchemas.ErrorResponse{
+			Status:  http.StatusInternalServerError,
+			Message: constants.MsgFailedToVerifyAudit,
+			Error:   err.Error(),
+		})
+	}
+
+	if !response.Valid {
+		l.Warn("Audit chain is broken", logger.Any("broken_at_id", response.BrokenAtID))
+	}
+
+	return c.Status(http.StatusOK).JSON(transactionSchemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+	"gorm.io/gorm"
+)
+
+// Append links the event to the latest event in the chain and stores it, in the transaction ctx carries if any
+// ID, PrevHash and Hash are set on the event. Transactions take the database write lock when they begin, so no other
+// writer, in this process or another, can append between reading the chain head and storing the event
+func (r *Repository) Append(ctx context.Context, event *schemas.AuditEvent) error {
+	return db.Conn(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		var last schemas.AuditEvent
+		err := tx.Order("id DESC").First(&last).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		event.ID = last.ID + 1
+		event.PrevHash = last.Hash
+		event.Hash = event.ComputeHash()
+
+		return tx.Create(event).Error
+	})
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+)
+
+// FindWithFilters retrieves audit events with filtering and pagination, newest first
+func (r *Repository) FindWithFilters(ctx context.Context, page int, pageSize int, filters schemas.AuditFilters) ([]schemas.AuditEvent, int64, error) {
+	var events []schemas.AuditEvent
+	var total int64
+
+	query := db.Conn(ctx, r.DB).Model(&schemas.AuditEvent{})
+
+	if filters.Actor != "" {
+		query = query.Where("actor = ?", filters.Actor)
+	}
+	if filters.Action != "" {
+		query = query.Where("action = ?", filters.Action)
+	}
+	if filters.TargetType != "" {
+		query = query.Where("target_type = ?", filters.TargetType)
+	}
+	if filters.TargetID != "" {
+		query = query.Where("target_id = ?", filters.TargetID)
+	}
+	if filters.RequestID != "" {
+		query = query.Where("request_id = ?", filters.RequestID)
+	}
+	if filters.StartDate != "" {
+		query = query.Where("DATE(created_at) >= ?", filters.StartDate)
+	}
+	if filters.EndDate != "" {
+		query = query.Where("DATE(created_at) <= ?", filters.EndDate)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.
+		Order("id DESC").
+		Offset(offset).
+		Limit(pageSize).
+		Find(&events).Error
+
+	return events, total, err
+}
+
+// IterateInOrder walks all audit events from oldest to newest in batches
+func (r *Repository) IterateInOrder(ctx context.Context, batchSize int, fn func(events []schemas.AuditEvent) error) error {
+	var lastID uint64
+
+	for {
+		var events []schemas.AuditEvent
+		err := db.Conn(ctx, r.DB).
+			Where("id > ?", lastID).
+			Order("id ASC").
+			Limit(batchSize).
+			Find(&events).Error
+		if err != nil {
+			return err
+		}
+
+		if len(events) == 0 {
+			return nil
+		}
+
+		if err := fn(events); err != nil {
+			return err
+		}
+
+		lastID = events[len(events)-1].ID
+	}
+}
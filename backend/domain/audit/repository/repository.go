@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	"gorm.io/gorm"
+)
+
+// IRepository defines the contract for audit repository operations
+// There are deliberately no update or delete operations
+type IRepository interface {
+	// Commands
+	Append(ctx context.Context, event *schemas.AuditEvent) error
+
+	// Queries
+	FindWithFilters(ctx context.Context, page int, pageSize int, filters schemas.AuditFilters) ([]schemas.AuditEvent, int64, error)
+	IterateInOrder(ctx context.Context, batchSize int, fn func(events []schemas.AuditEvent) error) error
+}
+
+// Repository implements IRepository
+type Repository struct {
+	DB *gorm.DB
+}
+
+// NewRepository creates a new audit repository instance
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+// CreateAppendOnlyTriggers makes the database reject updates and deletes on audit_events
+func CreateAppendOnlyTriggers(db *gorm.DB) error {
+	statements := []string{
+		`CREATE TRIGGER IF NOT EXISTS audit_events_no_update BEFORE UPDATE ON audit_events
+		BEGIN SELECT RAISE(ABORT, 'audit_events is append-only'); END`,
+		`CREATE TRIGGER IF NOT EXISTS audit_events_no_delete BEFORE DELETE ON audit_events
+		BEGIN SELECT RAISE(ABORT, 'audit_events is append-only'); END`,
+	}
+
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package schemas
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	transactionSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+)
+
+// Audit actions recorded by the write paths
+const (
+	ActionUpload             = "upload"
+	ActionGenerate           = "generate"
+	ActionClear              = "clear"
+	ActionRestore            = "restore"
+	ActionPurge              = "purge"
+	ActionTransactionCreate  = "transaction.create"
+	ActionTransactionUpdate  = "transaction.update"
+	ActionTransactionStatus  = "transaction.status_change"
+	ActionTransactionDelete  = "transaction.delete"
+	ActionCategoryCreate     = "category.create"
+	ActionCategoryUpdate     = "category.update"
+	ActionCategoryDelete     = "category.delete"
+	ActionCategoryAssign     = "transaction.categorize"
+	ActionTagsUpdate         = "transaction.tag"
+	ActionRuleCreate         = "rule.create"
+	ActionRuleUpdate         = "rule.update"
+	ActionRuleDelete         = "rule.delete"
+	ActionRuleApply          = "rule.apply"
+	ActionCounterpartyUpdate = "counterparty.update"
+	ActionCounterpartyAlias  = "counterparty.alias"
+	ActionCounterpartyMerge  = "counterparty.merge"
+	ActionCounterpartyRelink = "counterparty.relink"
+	ActionReconcile          = "reconciliation.run"
+	ActionLinkConfirm        = "link.confirm"
+	ActionLinkReject         = "link.reject"
+	ActionLinkCreate         = "link.create"
+	ActionLinkDelete         = "link.delete"
+	ActionSplitUpdate        = "transaction.split"
+	ActionProfileCreate      = "import_profile.create"
+	ActionProfileUpdate      = "import_profile.update"
+	ActionProfileDelete      = "import_profile.delete"
+)
+
+// Audit target types
+const (
+	TargetTransaction    = "transaction"
+	TargetTransactions   = "transactions"
+	TargetClearOperation = "clear_operation"
+	TargetCategory       = "category"
+	TargetRule           = "rule"
+	TargetCounterparty   = "counterparty"
+	TargetReconciliation = "reconciliation"
+	TargetImportProfile  = "import_profile"
+)
+
+// AuditEvent is an append-only record of a write operation
+// Each event hashes its own fields together with the previous event's hash,
+// so changing or removing any event breaks the chain from that point on
+type AuditEvent struct {
+	ID         uint64    `gorm:"primaryKey" json:"id"`
+	Actor      string    `gorm:"type:text;index" json:"actor"`
+	Action     string    `gorm:"type:text;index" json:"action"`
+	TargetType string    `gorm:"type:text;index" json:"target_type"`
+	TargetID   string    `gorm:"type:text;index" json:"target_id"`
+	RequestID  string    `gorm:"type:text;index" json:"request_id"`
+	ClientIP   string    `gorm:"type:text" json:"client_ip"`
+	BeforeHash string    `gorm:"type:text" json:"before_hash,omitempty"`
+	AfterHash  string    `gorm:"type:text" json:"after_hash,omitempty"`
+	Detail     string    `gorm:"type:text" json:"detail,omitempty"`
+	PrevHash   string    `gorm:"type:text" json:"prev_hash"`
+	Hash       string    `gorm:"type:text;uniqueIndex" json:"hash"`
+	CreatedAt  time.Time `gorm:"index" json:"created_at"`
+}
+
+// TableName specifies the table name for AuditEvent
+func (AuditEvent) TableName() string {
+	return "audit_events"
+}
+
+// ComputeHash returns the chain hash of the event from its fields and PrevHash
+func (e AuditEvent) ComputeHash() string {
+	payload := strings.Join([]string{
+		e.PrevHash,
+		fmt.Sprintf("%d", e.ID),
+		fmt.Sprintf("%d", e.CreatedAt.UnixMicro()),
+		e.Actor,
+		e.Action,
+		e.TargetType,
+		e.TargetID,
+		e.RequestID,
+		e.ClientIP,
+		e.BeforeHash,
+		e.AfterHash,
+		e.Detail,
+	}, "\x1f")
+
+	sum := sha256.Sum256([]byte(payload))
+	return hex.EncodeToString(sum[:])
+}
+
+// HashSnapshot returns the SHA-256 of the JSON form of a snapshot, or "" for nil
+func HashSnapshot(snapshot interface{}) (string, error) {
+	if snapshot == nil {
+		return "", nil
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// AuditEntry describes a write operation to record
+type AuditEntry struct {
+	Action     string
+	TargetType string
+	TargetID   string
+	Before     interface{}
+	After      interface{}
+	Detail     map[string]interface{}
+}
+
+// AuditFilters represents filtering options for audit events
+type AuditFilters struct {
+	Actor      string
+	Action     string
+	TargetType string
+	TargetID   string
+	RequestID  string
+	StartDate  string
+	EndDate    string
+}
+
+// Meta returns the applied filters as response metadata
+func (f AuditFilters) Meta() map[string]interface{} {
+	meta := make(map[string]interface{})
+	if f.Actor != "" {
+		meta["actor"] = f.Actor
+	}
+	if f.Action != "" {
+		meta["action"] = f.Action
+	}
+	if f.TargetType != "" {
+		meta["target_type"] = f.TargetType
+	}
+	if f.TargetID != "" {
+		meta["target_id"] = f.TargetID
+	}
+	if f.RequestID != "" {
+		meta["request_id"] = f.RequestID
+	}
+	if f.StartDate != "" {
+		meta["start_date"] = f.StartDate
+	}
+	if f.EndDate != "" {
+		meta["end_date"] = f.EndDate
+	}
+	return meta
+}
+
+// AuditEventsResponse represents the audit log list response
+type AuditEventsResponse struct {
+	Message string                          `json:"message"`
+	Data    []AuditEvent                    `json:"data"`
+	Meta    transactionSchemas.ResponseMeta `json:"meta"`
+}
+
+// VerifyResponse reports whether the audit hash chain is intact
+type VerifyResponse struct {
+	Message       string `json:"message"`
+	Valid         bool   `json:"valid"`
+	CheckedEvents int    `json:"checked_events"`
+	BrokenAtID    uint64 `json:"broken_at_id,omitempty"`
+	Reason        string `json:"reason,omitempty"`
+}
@@ -0,0 +1,144 @@
+package use_case
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	transactionSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/requestmeta"
+)
+
+// verifyBatchSize is how many events are loaded at a time while verifying the chain
+const verifyBatchSize = 500
+
+// IUseCase defines the contract for audit use case operations
+type IUseCase interface {
+	Record(ctx context.Context, entry schemas.AuditEntry) error
+	List(ctx context.Context, page int, pageSize int, filters schemas.AuditFilters) (*schemas.AuditEventsResponse, error)
+	Verify(ctx context.Context) (*schemas.VerifyResponse, error)
+}
+
+// UseCase implements IUseCase
+type UseCase struct {
+	Repository repository.IRepository
+	Now        func() time.Time
+}
+
+// NewUseCase creates a new audit use case instance
+func NewUseCase(repo repository.IRepository) IUseCase {
+	return &UseCase{
+		Repository: repo,
+		Now:        time.Now,
+	}
+}
+
+// Record appends an audit event for a write operation
+// Actor, request ID and client IP are taken from the request metadata on ctx
+func (uc *UseCase) Record(ctx context.Context, entry schemas.AuditEntry) error {
+	meta := requestmeta.FromContext(ctx)
+
+	beforeHash, err := schemas.HashSnapshot(entry.Before)
+	if err != nil {
+		return err
+	}
+
+	afterHash, err := schemas.HashSnapshot(entry.After)
+	if err != nil {
+		return err
+	}
+
+	var detail string
+	if len(entry.Detail) > 0 {
+		data, err := json.Marshal(entry.Detail)
+		if err != nil {
+			return err
+		}
+		detail = string(data)
+	}
+
+	event := &schemas.AuditEvent{
+		Actor:      meta.Actor,
+		Action:     entry.Action,
+		TargetType: entry.TargetType,
+		TargetID:   entry.TargetID,
+		RequestID:  meta.RequestID,
+		ClientIP:   meta.ClientIP,
+		BeforeHash: beforeHash,
+		AfterHash:  afterHash,
+		Detail:     detail,
+		// Stored with microsecond precision so the hash survives a round trip through the database
+		CreatedAt: uc.Now().UTC().Truncate(time.Microsecond),
+	}
+
+	return uc.Repository.Append(ctx, event)
+}
+
+// List retrieves audit events with filtering and pagination
+func (uc *UseCase) List(ctx context.Context, page int, pageSize int, filters schemas.AuditFilters) (*schemas.AuditEventsResponse, error) {
+	events, total, err := uc.Repository.FindWithFilters(ctx, page, pageSize, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	if events == nil {
+		events = []schemas.AuditEvent{}
+	}
+
+	return &schemas.AuditEventsResponse{
+		Message: constants.MsgAuditEventsRetrieved,
+		Data:    events,
+		Meta: transactionSchemas.ResponseMeta{
+			Pagination: transactionSchemas.NewPaginationMeta(total, len(events), page, pageSize),
+			Filters:    filters.Meta(),
+		},
+	}, nil
+}
+
+// Verify walks the whole chain and reports the first event whose links or hash do not match
+func (uc *UseCase) Verify(ctx context.Context) (*schemas.VerifyResponse, error) {
+	response := &schemas.VerifyResponse{Valid: true}
+
+	var prevHash string
+	var prevID uint64
+	errBroken := fmt.Errorf("chain broken")
+
+	err := uc.Repository.IterateInOrder(ctx, verifyBatchSize, func(events []schemas.AuditEvent) error {
+		for _, event := range events {
+			switch {
+			case event.ID != prevID+1:
+				response.Reason = fmt.Sprintf("expected event %d, found %d", prevID+1, event.ID)
+			case event.PrevHash != prevHash:
+				response.Reason = "previous hash does not match"
+			case event.ComputeHash() != event.Hash:
+				response.Reason = "event hash does not match its contents"
+			}
+
+			if response.Reason != "" {
+				response.Valid = false
+				response.BrokenAtID = event.ID
+				return errBroken
+			}
+
+			response.CheckedEvents++
+			prevHash = event.Hash
+			prevID = event.ID
+		}
+		return nil
+	})
+	if err != nil && err != errBroken {
+		return nil, err
+	}
+
+	if response.Valid {
+		response.Message = constants.MsgAuditChainValid
+	} else {
+		response.Message = constants.MsgAuditChainBroken
+	}
+
+	return response, nil
+}
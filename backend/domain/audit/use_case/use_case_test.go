@@ -0,0 +1,182 @@
+package use_case
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/requestmeta"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupTestUseCase creates a use case backed by an in-memory SQLite database
+func setupTestUseCase(t *testing.T) (IUseCase, *gorm.DB) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to setup test database: %v", err)
+	}
+
+	if err := db.AutoMigrate(&schemas.AuditEvent{}); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	return NewUseCase(repository.NewRepository(db)), db
+}
+
+// recordEvents appends n events as the given actor
+func recordEvents(t *testing.T, uc IUseCase, actor string, n int) {
+	ctx := requestmeta.WithMeta(context.Background(), requestmeta.Meta{Actor: actor, RequestID: "req-1", ClientIP: "127.0.0.1"})
+	for i := 0; i < n; i++ {
+		err := uc.Record(ctx, schemas.AuditEntry{
+			Action:     schemas.ActionUpload,
+			TargetType: schemas.TargetTransactions,
+			After:      map[string]int{"row": i},
+			Detail:     map[string]interface{}{"total_records": i},
+		})
+		if err != nil {
+			t.Fatalf("Record failed: %v", err)
+		}
+	}
+}
+
+// TestRecordBuildsChain tests that each event links to the previous one and carries request metadata
+func TestRecordBuildsChain(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	recordEvents(t, uc, "alice", 3)
+
+	var events []schemas.AuditEvent
+	db.Order("id ASC").Find(&events)
+	if len(events) != 3 {
+		t.Fatalf("Expected 3 events, got %d", len(events))
+	}
+
+	if events[0].PrevHash != "" || events[1].PrevHash != events[0].Hash || events[2].PrevHash != events[1].Hash {
+		t.Error("Expected events to be linked by hash")
+	}
+
+	if events[0].Actor != "alice" || events[0].RequestID != "req-1" || events[0].ClientIP != "127.0.0.1" {
+		t.Errorf("Expected request metadata on event, got %+v", events[0])
+	}
+
+	result, err := uc.Verify(context.Background())
+	if err != nil {
+		t.Fatalf("Verify failed: %v", err)
+	}
+	if !result.Valid || result.CheckedEvents != 3 {
+		t.Errorf("Expected valid chain of 3 events, got %+v", result)
+	}
+}
+
+// TestRecordConcurrentConnections tests that writers with their own connections, as the server and flipctl have,
+// extend one chain rather than forking it
+func TestRecordConcurrentConnections(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.db")
+	databases := []*db.Database{db.New(path), db.New(path)}
+	for _, database := range databases {
+		defer database.Close()
+	}
+	if err := databases[0].DB.AutoMigrate(&schemas.AuditEvent{}); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 80)
+	for _, database := range databases {
+		uc := NewUseCase(repository.NewRepository(database.DB))
+		for i := 0; i < 2; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 20; j++ {
+					errs <- uc.Record(context.Background(), schemas.AuditEntry{Action: schemas.ActionUpload, TargetType: schemas.TargetTransactions})
+				}
+			}()
+		}
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("Record failed: %v", err)
+		}
+	}
+
+	result, err := NewUseCase(repository.NewRepository(databases[0].DB)).Verify(context.Background())
+	if err != nil {
+		t.Fatalf("Verify failed: %v", err)
+	}
+	if !result.Valid || result.CheckedEvents != 80 {
+		t.Errorf("Expected valid chain of 80 events, got %+v", result)
+	}
+}
+
+// TestVerifyDetectsTampering tests that a modified or removed event breaks the chain
+func TestVerifyDetectsTampering(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	recordEvents(t, uc, "alice", 3)
+
+	db.Model(&schemas.AuditEvent{}).Where("id = ?", 2).Update("actor", "mallory")
+
+	result, err := uc.Verify(context.Background())
+	if err != nil {
+		t.Fatalf("Verify failed: %v", err)
+	}
+	if result.Valid || result.BrokenAtID != 2 {
+		t.Errorf("Expected chain broken at event 2, got %+v", result)
+	}
+
+	uc, db = setupTestUseCase(t)
+	recordEvents(t, uc, "alice", 3)
+	db.Delete(&schemas.AuditEvent{}, 2)
+
+	result, err = uc.Verify(context.Background())
+	if err != nil {
+		t.Fatalf("Verify failed: %v", err)
+	}
+	if result.Valid || result.BrokenAtID != 3 {
+		t.Errorf("Expected chain broken at event 3, got %+v", result)
+	}
+}
+
+// TestAppendOnlyTriggers tests that the database rejects updates and deletes of audit events
+func TestAppendOnlyTriggers(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	if err := repository.CreateAppendOnlyTriggers(db); err != nil {
+		t.Fatalf("CreateAppendOnlyTriggers failed: %v", err)
+	}
+	recordEvents(t, uc, "alice", 1)
+
+	if err := db.Model(&schemas.AuditEvent{}).Where("id = ?", 1).Update("actor", "mallory").Error; err == nil {
+		t.Error("Expected update to be rejected")
+	}
+
+	if err := db.Delete(&schemas.AuditEvent{}, 1).Error; err == nil {
+		t.Error("Expected delete to be rejected")
+	}
+}
+
+// TestListFiltersAndPaginates tests filtering by actor and pagination metadata
+func TestListFiltersAndPaginates(t *testing.T) {
+	uc, _ := setupTestUseCase(t)
+	recordEvents(t, uc, "alice", 3)
+	recordEvents(t, uc, "bob", 2)
+
+	response, err := uc.List(context.Background(), 1, 2, schemas.AuditFilters{Actor: "alice"})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+
+	if response.Meta.Pagination.Total != 3 || len(response.Data) != 2 || response.Meta.Pagination.TotalPages != 2 {
+		t.Errorf("Unexpected pagination: %+v", response.Meta.Pagination)
+	}
+
+	// Newest first
+	if response.Data[0].ID != 3 {
+		t.Errorf("Expected newest alice event first, got %d", response.Data[0].ID)
+	}
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// AssignCategory sets or removes the category of transactions
+// With an :id route parameter it applies to that transaction, otherwise to transaction_ids
+func (h *Handler) AssignCategory(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "AssignCategory"),
+	)
+
+	var req schemas.CategoryAssignmentRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.Warn("Invalid request body", logger.Error(err))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidRequestBody,
+			Error:   err.Error(),
+		})
+	}
+
+	if id := c.Params("id"); id != "" {
+		req.TransactionIDs = []string{id}
+	}
+
+	response, err := h.UseCase.AssignCategory(c.Context(), req)
+	if err != nil {
+		l.Warn("Failed to assign category", logger.Error(err))
+		errResp := errorResponse(err, constants.MsgFailedToAssignCategory)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Category assigned", logger.Int("updated", response.Updated))
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
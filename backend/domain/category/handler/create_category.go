@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// CreateCategory adds a category to the taxonomy
+func (h *Handler) CreateCategory(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "CreateCategory"),
+	)
+
+	var req schemas.CategoryRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.Warn("Invalid request body", logger.Error(err))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidCategoryBody,
+			Error:   err.Error(),
+		})
+	}
+
+	category, err := h.UseCase.CreateCategory(c.Context(), req)
+	if err != nil {
+		l.Warn("Failed to create category", logger.Error(err))
+		errResp := errorResponse(err, constants.MsgFailedToSaveCategory)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Category created", logger.String("id", category.ID))
+
+	return c.Status(http.StatusCreated).JSON(schemas.SuccessResponse{
+		Status: http.StatusCreated,
+		Data:   category,
+	})
+}
@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetCategory returns a single category by ID
+func (h *Handler) GetCategory(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "GetCategory"),
+	)
+
+	id := c.Params("id")
+	category, err := h.UseCase.GetCategory(c.Context(), id)
+	if err != nil {
+		l.Warn("Failed to retrieve category", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToRetrieveCategories)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   category,
+	})
+}
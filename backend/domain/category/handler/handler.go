@@ -0,0 +1,53 @@
+package handler
+
+import (
+	auditRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/repository"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	categoryRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/category/repository"
+	categoryUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/category/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/deps"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+)
+
+const ContextName = "Domain.Category.Handler"
+
+// Handler defines the category and tagging handlers
+type Handler struct {
+	Logger  *logger.Logger
+	UseCase categoryUseCase.IUseCase
+}
+
+// NewHandler creates a new category handler instance with all dependencies
+func NewHandler(d *deps.App) *Handler {
+	// Initialize repository
+	repository := categoryRepo.NewRepository(d.DB.GetDB())
+
+	// Initialize use case
+	audit := auditUseCase.NewUseCase(auditRepo.NewRepository(d.DB.GetDB()))
+	useCase := categoryUseCase.NewUseCase(repository, audit)
+
+	return &Handler{
+		Logger:  d.Logger,
+		UseCase: useCase,
+	}
+}
+
+// RegisterApi registers category and tagging API routes
+func RegisterApi(d *deps.App) *Handler {
+	handler := NewHandler(d)
+
+	api := d.Fiber.Group("/api")
+
+	api.Get("/categories", handler.ListCategories)
+	api.Post("/categories", handler.CreateCategory)
+	api.Get("/categories/:id", handler.GetCategory)
+	api.Put("/categories/:id", handler.UpdateCategory)
+	api.Delete("/categories/:id", handler.DeleteCategory)
+
+	api.Post("/transactions/categorize", handler.AssignCategory)
+	api.Post("/transactions/tags", handler.UpdateTags)
+	api.Put("/transactions/:id/category", handler.AssignCategory)
+	api.Post("/transactions/:id/tags", handler.UpdateTags)
+
+	return handler
+}
@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ListCategories returns the whole category taxonomy
+func (h *Handler) ListCategories(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "ListCategories"),
+	)
+
+	categories, err := h.UseCase.ListCategories(c.Context())
+	if err != nil {
+		l.Error("Failed to retrieve categories", logger.Error(err))
+		errResp := errorResponse(err, constants.MsgFailedToRetrieveCategories)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   categories,
+	})
+}
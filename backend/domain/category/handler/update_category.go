@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// UpdateCategory changes the name, parent or description of a category
+// Fields left out of the request keep their current values
+func (h *Handler) UpdateCategory(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "UpdateCategory"),
+	)
+
+	id := c.Params("id")
+
+	var req schemas.CategoryRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.Warn("Invalid request body", logger.Error(err))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidCategoryBody,
+			Error:   err.Error(),
+		})
+	}
+
+	category, err := h.UseCase.UpdateCategory(c.Context(), id, req)
+	if err != nil {
+		l.Warn("Failed to update category", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToSaveCategory)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Category updated", logger.String("id", id))
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   category,
+	})
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Create creates a category record
+func (r *Repository) Create(ctx context.Context, category *schemas.Category) error {
+	return db.Conn(ctx, r.DB).Create(category).Error
+}
+
+// Update saves all fields of an existing category record
+func (r *Repository) Update(ctx context.Context, category *schemas.Category) error {
+	return db.Conn(ctx, r.DB).Save(category).Error
+}
+
+// Delete removes a category and unassigns it from its transactions and splits
+// A category that categorisation rules assign cannot be deleted, since the rules would keep assigning it to uploads
+// Returns the number of transactions that lost their category
+func (r *Repository) Delete(ctx context.Context, id string) (int64, error) {
+	var unassigned int64
+
+	err := db.Conn(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		var rules int64
+		if err := tx.Model(&schemas.Rule{}).Where("category_id = ?", id).Count(&rules).Error; err != nil {
+			return err
+		}
+		if rules > 0 {
+			return fmt.Errorf("%w: %d rules assign it", schemas.ErrCategoryInUse, rules)
+		}
+
+		result := tx.Unscoped().
+			Model(&schemas.Transaction{}).
+			Where("category_id = ?", id).
+			UpdateColumn("category_id", nil)
+		if result.Error != nil {
+			return result.Error
+		}
+		unassigned = result.RowsAffected
+
+		err := tx.Model(&schemas.TransactionSplit{}).
+			Where("category_id = ?", id).
+			UpdateColumn("category_id", nil).Error
+		if err != nil {
+			return err
+		}
+
+		result = tx.Delete(&schemas.Category{}, "id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return schemas.ErrCategoryNotFound
+		}
+		return nil
+	})
+
+	return unassigned, err
+}
+
+// AssignCategory sets the category of the given transactions; nil removes it
+func (r *Repository) AssignCategory(ctx context.Context, transactionIDs []string, categoryID *string) (int64, error) {
+	result := db.Conn(ctx, r.DB).
+		Model(&schemas.Transaction{}).
+		Where("id IN ?", transactionIDs).
+		UpdateColumn("category_id", categoryID)
+	return result.RowsAffected, result.Error
+}
+
+// UpdateTags adds and removes tags on the given transactions in one database transaction
+func (r *Repository) UpdateTags(ctx context.Context, transactionIDs []string, add []string, remove []string) error {
+	return db.Conn(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		if len(remove) > 0 {
+			err := tx.Where("transaction_id IN ? AND tag IN ?", transactionIDs, remove).
+				Delete(&schemas.TransactionTag{}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(add) == 0 {
+			return nil
+		}
+
+		now := time.Now()
+		rows := make([]schemas.TransactionTag, 0, len(transactionIDs)*len(add))
+		for _, id := range transactionIDs {
+			for _, tag := range add {
+				rows = append(rows, schemas.TransactionTag{TransactionID: id, Tag: tag, CreatedAt: now})
+			}
+		}
+
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(rows, 100).Error
+	})
+}
+
+// Transaction runs fn in a database transaction, which the repositories called with its context join
+func (r *Repository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return db.Transaction(ctx, r.DB, fn)
+}
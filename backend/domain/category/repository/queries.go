@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+)
+
+// FindAll retrieves all categories ordered by name
+func (r *Repository) FindAll(ctx context.Context) ([]schemas.Category, error) {
+	var categories []schemas.Category
+	err := db.Conn(ctx, r.DB).Order("name ASC").Find(&categories).Error
+	return categories, err
+}
+
+// FindByID retrieves a category by ID
+func (r *Repository) FindByID(ctx context.Context, id string) (*schemas.Category, error) {
+	var category schemas.Category
+	err := db.Conn(ctx, r.DB).Where("id = ?", id).First(&category).Error
+	if err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+// FindByName retrieves a category by name, ignoring case
+func (r *Repository) FindByName(ctx context.Context, name string) (*schemas.Category, error) {
+	var category schemas.Category
+	err := db.Conn(ctx, r.DB).Where("LOWER(name) = LOWER(?)", name).First(&category).Error
+	if err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+// CountChildren counts the categories directly under a category
+func (r *Repository) CountChildren(ctx context.Context, id string) (int64, error) {
+	var count int64
+	err := db.Conn(ctx, r.DB).Model(&schemas.Category{}).Where("parent_id = ?", id).Count(&count).Error
+	return count, err
+}
+
+// FindExistingTransactionIDs returns which of the given transaction IDs exist
+func (r *Repository) FindExistingTransactionIDs(ctx context.Context, transactionIDs []string) ([]string, error) {
+	var ids []string
+	err := db.Conn(ctx, r.DB).
+		Model(&schemas.Transaction{}).
+		Where("id IN ?", transactionIDs).
+		Pluck("id", &ids).Error
+	return ids, err
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/gorm"
+)
+
+// IRepository defines the contract for category repository operations
+type IRepository interface {
+	// Commands
+	Create(ctx context.Context, category *schemas.Category) error
+	Update(ctx context.Context, category *schemas.Category) error
+	Delete(ctx context.Context, id string) (int64, error)
+	AssignCategory(ctx context.Context, transactionIDs []string, categoryID *string) (int64, error)
+	UpdateTags(ctx context.Context, transactionIDs []string, add []string, remove []string) error
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+
+	// Queries
+	FindAll(ctx context.Context) ([]schemas.Category, error)
+	FindByID(ctx context.Context, id string) (*schemas.Category, error)
+	FindByName(ctx context.Context, name string) (*schemas.Category, error)
+	CountChildren(ctx context.Context, id string) (int64, error)
+	FindExistingTransactionIDs(ctx context.Context, transactionIDs []string) ([]string, error)
+}
+
+// Repository implements IRepository
+type Repository struct {
+	DB *gorm.DB
+}
+
+// NewRepository creates a new category repository instance
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
@@ -0,0 +1,352 @@
+package use_case
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	auditSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/category/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// maxBulkTransactions limits how many transactions one bulk request may change
+const maxBulkTransactions = 1000
+
+// IUseCase defines the contract for category use case operations
+type IUseCase interface {
+	ListCategories(ctx context.Context) ([]schemas.Category, error)
+	GetCategory(ctx context.Context, id string) (*schemas.Category, error)
+	CreateCategory(ctx context.Context, req schemas.CategoryRequest) (*schemas.Category, error)
+	UpdateCategory(ctx context.Context, id string, req schemas.CategoryRequest) (*schemas.Category, error)
+	DeleteCategory(ctx context.Context, id string) error
+	AssignCategory(ctx context.Context, req schemas.CategoryAssignmentRequest) (*schemas.AssignmentResponse, error)
+	UpdateTags(ctx context.Context, req schemas.TagsRequest) (*schemas.AssignmentResponse, error)
+}
+
+// UseCase implements IUseCase
+type UseCase struct {
+	Repository repository.IRepository
+	Audit      auditUseCase.IUseCase
+}
+
+// NewUseCase creates a new category use case instance
+func NewUseCase(repo repository.IRepository, audit auditUseCase.IUseCase) IUseCase {
+	return &UseCase{
+		Repository: repo,
+		Audit:      audit,
+	}
+}
+
+// ListCategories retrieves all categories
+func (uc *UseCase) ListCategories(ctx context.Context) ([]schemas.Category, error) {
+	categories, err := uc.Repository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if categories == nil {
+		categories = []schemas.Category{}
+	}
+	return categories, nil
+}
+
+// GetCategory retrieves a single category by ID
+func (uc *UseCase) GetCategory(ctx context.Context, id string) (*schemas.Category, error) {
+	category, err := uc.Repository.FindByID(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, schemas.ErrCategoryNotFound
+	}
+	return category, err
+}
+
+// CreateCategory validates and stores a new category
+// The name check, the insert and the audit event run in one database transaction
+func (uc *UseCase) CreateCategory(ctx context.Context, req schemas.CategoryRequest) (*schemas.Category, error) {
+	if req.Name == nil {
+		return nil, fmt.Errorf("%w: name is required", schemas.ErrInvalidCategory)
+	}
+
+	category := &schemas.Category{ID: uuid.New().String()}
+	err := uc.Repository.Transaction(ctx, func(ctx context.Context) error {
+		if err := uc.applyRequest(ctx, category, req); err != nil {
+			return err
+		}
+
+		if err := uc.Repository.Create(ctx, category); err != nil {
+			return err
+		}
+
+		return uc.Audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionCategoryCreate,
+			TargetType: auditSchemas.TargetCategory,
+			TargetID:   category.ID,
+			After:      category,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// UpdateCategory changes the fields present in the request
+// The lookup, the name and parent checks, the update and the audit event run in one database transaction
+func (uc *UseCase) UpdateCategory(ctx context.Context, id string, req schemas.CategoryRequest) (*schemas.Category, error) {
+	var category *schemas.Category
+	err := uc.Repository.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		category, err = uc.GetCategory(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		before := *category
+		if err := uc.applyRequest(ctx, category, req); err != nil {
+			return err
+		}
+
+		if err := uc.Repository.Update(ctx, category); err != nil {
+			return err
+		}
+
+		return uc.Audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionCategoryUpdate,
+			TargetType: auditSchemas.TargetCategory,
+			TargetID:   category.ID,
+			Before:     &before,
+			After:      category,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// DeleteCategory removes a category without children and unassigns it from its transactions
+// The children and rule checks, the delete and the audit event run in one database transaction, so a child or rule
+// added meanwhile cannot be left pointing at a deleted category
+func (uc *UseCase) DeleteCategory(ctx context.Context, id string) error {
+	return uc.Repository.Transaction(ctx, func(ctx context.Context) error {
+		category, err := uc.GetCategory(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		children, err := uc.Repository.CountChildren(ctx, id)
+		if err != nil {
+			return err
+		}
+		if children > 0 {
+			return fmt.Errorf("%w: %d child categories", schemas.ErrCategoryInUse, children)
+		}
+
+		unassigned, err := uc.Repository.Delete(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return uc.Audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionCategoryDelete,
+			TargetType: auditSchemas.TargetCategory,
+			TargetID:   id,
+			Before:     category,
+			Detail:     map[string]interface{}{"unassigned_transactions": unassigned},
+		})
+	})
+}
+
+// AssignCategory sets or removes the category of one or more transactions
+// The checks, the assignment and the audit event run in one database transaction
+func (uc *UseCase) AssignCategory(ctx context.Context, req schemas.CategoryAssignmentRequest) (*schemas.AssignmentResponse, error) {
+	var updated int64
+	err := uc.Repository.Transaction(ctx, func(ctx context.Context) error {
+		ids, err := uc.checkTransactions(ctx, req.TransactionIDs)
+		if err != nil {
+			return err
+		}
+
+		if req.CategoryID != nil {
+			if _, err := uc.GetCategory(ctx, *req.CategoryID); err != nil {
+				return err
+			}
+		}
+
+		updated, err = uc.Repository.AssignCategory(ctx, ids, req.CategoryID)
+		if err != nil {
+			return err
+		}
+
+		return uc.Audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionCategoryAssign,
+			TargetType: auditSchemas.TargetTransactions,
+			TargetID:   strings.Join(ids, ","),
+			Detail:     map[string]interface{}{"category_id": req.CategoryID},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &schemas.AssignmentResponse{
+		Message: constants.MsgCategoryAssigned,
+		Updated: int(updated),
+	}, nil
+}
+
+// UpdateTags adds and removes tags on one or more transactions
+// The checks, the tag changes and the audit event run in one database transaction
+func (uc *UseCase) UpdateTags(ctx context.Context, req schemas.TagsRequest) (*schemas.AssignmentResponse, error) {
+	var ids []string
+	err := uc.Repository.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		ids, err = uc.checkTransactions(ctx, req.TransactionIDs)
+		if err != nil {
+			return err
+		}
+
+		add, err := schemas.NormalizeTags(req.Add)
+		if err != nil {
+			return err
+		}
+
+		remove, err := schemas.NormalizeTags(req.Remove)
+		if err != nil {
+			return err
+		}
+
+		if len(add) == 0 && len(remove) == 0 {
+			return fmt.Errorf("%w: add or remove at least one tag", schemas.ErrInvalidTag)
+		}
+
+		if err := uc.Repository.UpdateTags(ctx, ids, add, remove); err != nil {
+			return err
+		}
+
+		return uc.Audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionTagsUpdate,
+			TargetType: auditSchemas.TargetTransactions,
+			TargetID:   strings.Join(ids, ","),
+			Detail:     map[string]interface{}{"add": add, "remove": remove},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &schemas.AssignmentResponse{
+		Message: constants.MsgTagsUpdated,
+		Updated: len(ids),
+	}, nil
+}
+
+// checkTransactions de-duplicates the transaction IDs and makes sure they all exist
+func (uc *UseCase) checkTransactions(ctx context.Context, transactionIDs []string) ([]string, error) {
+	seen := make(map[string]bool)
+	ids := make([]string, 0, len(transactionIDs))
+	for _, id := range transactionIDs {
+		id = strings.TrimSpace(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("%w: transaction_ids cannot be empty", schemas.ErrInvalidTransaction)
+	}
+	if len(ids) > maxBulkTransactions {
+		return nil, fmt.Errorf("%w: at most %d transactions per request", schemas.ErrInvalidTransaction, maxBulkTransactions)
+	}
+
+	existing, err := uc.Repository.FindExistingTransactionIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(existing) != len(ids) {
+		found := make(map[string]bool, len(existing))
+		for _, id := range existing {
+			found[id] = true
+		}
+
+		var missing []string
+		for _, id := range ids {
+			if !found[id] {
+				missing = append(missing, id)
+			}
+		}
+		return nil, fmt.Errorf("%w: %s", schemas.ErrTransactionNotFound, strings.Join(missing, ", "))
+	}
+
+	return ids, nil
+}
+
+// applyRequest validates the request fields and copies them onto the category
+func (uc *UseCase) applyRequest(ctx context.Context, category *schemas.Category, req schemas.CategoryRequest) error {
+	if req.Name != nil {
+		name, err := schemas.NormalizeCategoryName(*req.Name)
+		if err != nil {
+			return err
+		}
+
+		existing, err := uc.Repository.FindByName(ctx, name)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if existing != nil && existing.ID != category.ID {
+			return fmt.Errorf("%w: category %q already exists", schemas.ErrInvalidCategory, name)
+		}
+		category.Name = name
+	}
+
+	if req.ParentID != nil {
+		parentID := strings.TrimSpace(*req.ParentID)
+		if parentID == "" {
+			category.ParentID = nil
+		} else {
+			if err := uc.checkParent(ctx, category.ID, parentID); err != nil {
+				return err
+			}
+			category.ParentID = &parentID
+		}
+	}
+
+	if req.Description != nil {
+		category.Description = strings.TrimSpace(*req.Description)
+	}
+
+	return nil
+}
+
+// checkParent makes sure the parent exists and that using it would not create a cycle
+func (uc *UseCase) checkParent(ctx context.Context, categoryID string, parentID string) error {
+	for id := parentID; id != ""; {
+		if id == categoryID {
+			return fmt.Errorf("%w: a category cannot be its own ancestor", schemas.ErrInvalidCategory)
+		}
+
+		parent, err := uc.Repository.FindByID(ctx, id)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("%w: parent category %s not found", schemas.ErrInvalidCategory, id)
+		}
+		if err != nil {
+			return err
+		}
+
+		if parent.ParentID == nil {
+			break
+		}
+		id = *parent.ParentID
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package use_case
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	auditRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/repository"
+	auditSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/category/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupTestUseCase creates a use case backed by an in-memory SQLite database
+func setupTestUseCase(t *testing.T) (IUseCase, *gorm.DB) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to setup test database: %v", err)
+	}
+
+	if err := db.AutoMigrate(&schemas.Transaction{}, &schemas.Category{}, &schemas.TransactionTag{}, &schemas.TransactionSplit{}, &schemas.Rule{}, &auditSchemas.AuditEvent{}); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	audit := auditUseCase.NewUseCase(auditRepo.NewRepository(db))
+	return NewUseCase(repository.NewRepository(db), audit), db
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+// TestCreateCategoryValidation tests required names, unique names, parent cycles and deleting categories in use
+func TestCreateCategoryValidation(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	ctx := context.Background()
+
+	food, err := uc.CreateCategory(ctx, schemas.CategoryRequest{Name: stringPtr(" Food ")})
+	if err != nil {
+		t.Fatalf("CreateCategory failed: %v", err)
+	}
+	if food.Name != "Food" {
+		t.Errorf("Expected trimmed name, got %q", food.Name)
+	}
+
+	if _, err := uc.CreateCategory(ctx, schemas.CategoryRequest{}); !errors.Is(err, schemas.ErrInvalidCategory) {
+		t.Errorf("Expected invalid category for missing name, got %v", err)
+	}
+
+	if _, err := uc.CreateCategory(ctx, schemas.CategoryRequest{Name: stringPtr("food")}); !errors.Is(err, schemas.ErrInvalidCategory) {
+		t.Errorf("Expected invalid category for duplicate name, got %v", err)
+	}
+
+	restaurant, err := uc.CreateCategory(ctx, schemas.CategoryRequest{Name: stringPtr("Restaurant"), ParentID: &food.ID})
+	if err != nil {
+		t.Fatalf("CreateCategory with parent failed: %v", err)
+	}
+
+	// Food cannot move under its own child
+	if _, err := uc.UpdateCategory(ctx, food.ID, schemas.CategoryRequest{ParentID: &restaurant.ID}); !errors.Is(err, schemas.ErrInvalidCategory) {
+		t.Errorf("Expected invalid category for cycle, got %v", err)
+	}
+
+	if err := uc.DeleteCategory(ctx, food.ID); !errors.Is(err, schemas.ErrCategoryInUse) {
+		t.Errorf("Expected category in use, got %v", err)
+	}
+
+	// A category that a rule assigns cannot be deleted either
+	if err := db.Create(&schemas.Rule{ID: "rule-1", Name: "Dining", CategoryID: &restaurant.ID}).Error; err != nil {
+		t.Fatalf("failed to insert rule: %v", err)
+	}
+	if err := uc.DeleteCategory(ctx, restaurant.ID); !errors.Is(err, schemas.ErrCategoryInUse) {
+		t.Errorf("Expected category in use by a rule, got %v", err)
+	}
+	if err := db.Delete(&schemas.Rule{}, "id = ?", "rule-1").Error; err != nil {
+		t.Fatalf("failed to delete rule: %v", err)
+	}
+	if err := uc.DeleteCategory(ctx, restaurant.ID); err != nil {
+		t.Errorf("DeleteCategory failed once the rule was removed: %v", err)
+	}
+}
+
+// TestAssignCategoryAndTags tests bulk assignment, tag normalization and unassigning on delete
+func TestAssignCategoryAndTags(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	ctx := context.Background()
+
+	for _, id := range []string{"1", "2"} {
+		if err := db.Create(&schemas.Transaction{ID: id, Status: schemas.StatusSuccess}).Error; err != nil {
+			t.Fatalf("failed to insert test data: %v", err)
+		}
+	}
+
+	category, err := uc.CreateCategory(ctx, schemas.CategoryRequest{Name: stringPtr("Salary")})
+	if err != nil {
+		t.Fatalf("CreateCategory failed: %v", err)
+	}
+
+	response, err := uc.AssignCategory(ctx, schemas.CategoryAssignmentRequest{TransactionIDs: []string{"1", "2", "1"}, CategoryID: &category.ID})
+	if err != nil {
+		t.Fatalf("AssignCategory failed: %v", err)
+	}
+	if response.Updated != 2 {
+		t.Errorf("Expected 2 updated, got %d", response.Updated)
+	}
+
+	if _, err := uc.AssignCategory(ctx, schemas.CategoryAssignmentRequest{TransactionIDs: []string{"1", "missing"}, CategoryID: &category.ID}); !errors.Is(err, schemas.ErrTransactionNotFound) {
+		t.Errorf("Expected transaction not found, got %v", err)
+	}
+
+	if _, err := uc.UpdateTags(ctx, schemas.TagsRequest{TransactionIDs: []string{"1"}, Add: []string{" Monthly ", "monthly", "work"}}); err != nil {
+		t.Fatalf("UpdateTags failed: %v", err)
+	}
+	if _, err := uc.UpdateTags(ctx, schemas.TagsRequest{TransactionIDs: []string{"1"}, Remove: []string{"work"}}); err != nil {
+		t.Fatalf("UpdateTags remove failed: %v", err)
+	}
+
+	var tags []string
+	db.Model(&schemas.TransactionTag{}).Where("transaction_id = ?", "1").Pluck("tag", &tags)
+	if len(tags) != 1 || tags[0] != "monthly" {
+		t.Errorf("Expected tags [monthly], got %v", tags)
+	}
+
+	if _, err := uc.UpdateTags(ctx, schemas.TagsRequest{TransactionIDs: []string{"1"}, Add: []string{"bad/tag"}}); !errors.Is(err, schemas.ErrInvalidTag) {
+		t.Errorf("Expected invalid tag, got %v", err)
+	}
+
+	if err := uc.DeleteCategory(ctx, category.ID); err != nil {
+		t.Fatalf("DeleteCategory failed: %v", err)
+	}
+
+	var assigned int64
+	db.Model(&schemas.Transaction{}).Where("category_id IS NOT NULL").Count(&assigned)
+	if assigned != 0 {
+		t.Errorf("Expected transactions to be unassigned, got %d", assigned)
+	}
+}
+
+// TestDeleteCategoryRollsBack tests that a delete whose audit event cannot be written keeps the category and its assignments
+func TestDeleteCategoryRollsBack(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	ctx := context.Background()
+
+	category, err := uc.CreateCategory(ctx, schemas.CategoryRequest{Name: stringPtr("Salary")})
+	if err != nil {
+		t.Fatalf("CreateCategory failed: %v", err)
+	}
+	if err := db.Create(&schemas.Transaction{ID: "1", Status: schemas.StatusSuccess, CategoryID: &category.ID}).Error; err != nil {
+		t.Fatalf("failed to insert test data: %v", err)
+	}
+	if err := db.Migrator().DropTable(&auditSchemas.AuditEvent{}); err != nil {
+		t.Fatalf("failed to drop audit table: %v", err)
+	}
+
+	if err := uc.DeleteCategory(ctx, category.ID); err == nil {
+		t.Fatal("Expected the delete to fail without an audit log")
+	}
+
+	if _, err := uc.GetCategory(ctx, category.ID); err != nil {
+		t.Errorf("Expected the category to remain, got %v", err)
+	}
+	var assigned int64
+	db.Model(&schemas.Transaction{}).Where("category_id = ?", category.ID).Count(&assigned)
+	if assigned != 1 {
+		t.Errorf("Expected the transaction to keep its category, got %d assigned", assigned)
+	}
+}
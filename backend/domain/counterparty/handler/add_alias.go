@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// AddAlias maps another spelling of a name to a counterparty
+func (h *Handler) AddAlias(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "AddAlias"),
+	)
+
+	id := c.Params("id")
+
+	var req schemas.CounterpartyAliasRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.Warn("Invalid request body", logger.Error(err))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidCounterpartyBody,
+			Error:   err.Error(),
+		})
+	}
+
+	counterparty, err := h.UseCase.AddAlias(c.Context(), id, req)
+	if err != nil {
+		l.Warn("Failed to add counterparty alias", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToSaveCounterparty)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Counterparty alias added", logger.String("id", id))
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   counterparty,
+	})
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"net/http"
+
+	transactionHandler "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/handler"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ListCounterparties returns counterparties with their transaction counts, busiest first
+func (h *Handler) ListCounterparties(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "ListCounterparties"),
+	)
+
+	page, pageSize, errResp := transactionHandler.ParsePagination(c.Query, h.FieldValidator)
+	if errResp != nil {
+		l.Warn("Invalid pagination parameters", logger.String("error", errResp.Error))
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	search := c.Query("search")
+	if search != "" {
+		if err := h.FieldValidator.ValidateSearchQuery(search); err != nil {
+			l.Warn("Invalid search query", logger.Error(err))
+			return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+				Status:  http.StatusBadRequest,
+				Message: constants.MsgInvalidSearchQuery,
+				Error:   err.Error(),
+			})
+		}
+	}
+
+	response, err := h.UseCase.ListCounterparties(c.Context(), page, pageSize, search)
+	if err != nil {
+		l.Error("Failed to retrieve counterparties", logger.Error(err))
+		errResp := errorResponse(err, constants.MsgFailedToRetrieveCounterparties)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
+
+// GetCounterparty returns a single counterparty with its aliases
+func (h *Handler) GetCounterparty(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "GetCounterparty"),
+	)
+
+	id := c.Params("id")
+	counterparty, err := h.UseCase.GetCounterparty(c.Context(), id)
+	if err != nil {
+		l.Warn("Failed to retrieve counterparty", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToRetrieveCounterparties)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   counterparty,
+	})
+}
@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetSummary returns a counterparty's totals, failure rate and last activity
+func (h *Handler) GetSummary(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "GetSummary"),
+	)
+
+	id := c.Params("id")
+	summary, err := h.UseCase.GetSummary(c.Context(), id)
+	if err != nil {
+		l.Warn("Failed to summarise counterparty", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToRetrieveCounterparties)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   summary,
+	})
+}
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"net/http"
+
+	transactionHandler "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/handler"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetTransactions returns a counterparty's transactions, newest first, with pagination
+func (h *Handler) GetTransactions(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "GetTransactions"),
+	)
+
+	page, pageSize, errResp := transactionHandler.ParsePagination(c.Query, h.FieldValidator)
+	if errResp != nil {
+		l.Warn("Invalid pagination parameters", logger.String("error", errResp.Error))
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	id := c.Params("id")
+	response, err := h.UseCase.GetTransactions(c.Context(), id, page, pageSize)
+	if err != nil {
+		l.Warn("Failed to retrieve counterparty transactions", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToRetrieveTransactions)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
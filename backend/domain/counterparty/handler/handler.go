@@ -0,0 +1,58 @@
+package handler
+
+import (
+	auditRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/repository"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	counterpartyRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/counterparty/repository"
+	counterpartyUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/counterparty/use_case"
+	transactionRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/config"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/deps"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+)
+
+const ContextName = "Domain.Counterparty.Handler"
+
+// Handler defines the counterparty directory handlers
+type Handler struct {
+	Logger         *logger.Logger
+	UseCase        counterpartyUseCase.IUseCase
+	FieldValidator *validator.FieldValidator
+}
+
+// NewHandler creates a new counterparty handler instance with all dependencies
+func NewHandler(d *deps.App) *Handler {
+	// Initialize repositories
+	repository := counterpartyRepo.NewRepository(d.DB.GetDB())
+	transactionRepository := transactionRepo.NewRepository(d.DB.GetDB())
+
+	// Initialize use case
+	cfg := config.GetConfig()
+	audit := auditUseCase.NewUseCase(auditRepo.NewRepository(d.DB.GetDB()))
+	useCase := counterpartyUseCase.NewUseCase(repository, transactionRepository, audit, cfg.CounterpartyMatchThreshold)
+
+	return &Handler{
+		Logger:         d.Logger,
+		UseCase:        useCase,
+		FieldValidator: d.FieldValidator,
+	}
+}
+
+// RegisterApi registers counterparty API routes
+func RegisterApi(d *deps.App) *Handler {
+	handler := NewHandler(d)
+
+	api := d.Fiber.Group("/api")
+
+	api.Get("/counterparties", handler.ListCounterparties)
+	api.Post("/counterparties/relink", handler.Relink)
+	api.Get("/counterparties/:id", handler.GetCounterparty)
+	api.Put("/counterparties/:id", handler.RenameCounterparty)
+	api.Post("/counterparties/:id/aliases", handler.AddAlias)
+	api.Post("/counterparties/:id/merge", handler.MergeCounterparty)
+	api.Get("/counterparties/:id/transactions", handler.GetTransactions)
+	api.Get("/counterparties/:id/summary", handler.GetSummary)
+
+	return handler
+}
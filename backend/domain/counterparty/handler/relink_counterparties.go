@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Relink links stored transactions that have no counterparty yet
+func (h *Handler) Relink(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "Relink"),
+	)
+
+	response, err := h.UseCase.Relink(c.Context())
+	if err != nil {
+		l.Error("Failed to link counterparties", logger.Error(err))
+		errResp := errorResponse(err, constants.MsgFailedToLinkCounterparties)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Counterparties relinked",
+		logger.Int("linked", response.Linked),
+		logger.Int("created", response.Created),
+	)
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
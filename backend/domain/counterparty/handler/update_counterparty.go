@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// RenameCounterparty changes the canonical name of a counterparty
+func (h *Handler) RenameCounterparty(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "RenameCounterparty"),
+	)
+
+	id := c.Params("id")
+
+	var req schemas.CounterpartyRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.Warn("Invalid request body", logger.Error(err))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidCounterpartyBody,
+			Error:   err.Error(),
+		})
+	}
+
+	counterparty, err := h.UseCase.RenameCounterparty(c.Context(), id, req)
+	if err != nil {
+		l.Warn("Failed to rename counterparty", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToSaveCounterparty)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Counterparty renamed", logger.String("id", id))
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   counterparty,
+	})
+}
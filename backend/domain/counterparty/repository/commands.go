@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreateLinks stores new counterparties and aliases in one transaction
+// Aliases that already exist are left pointing at their current counterparty
+func (r *Repository) CreateLinks(ctx context.Context, counterparties []schemas.Counterparty, aliases []schemas.CounterpartyAlias) error {
+	if len(counterparties) == 0 && len(aliases) == 0 {
+		return nil
+	}
+
+	return db.Conn(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		if len(counterparties) > 0 {
+			if err := tx.Omit("Aliases").CreateInBatches(counterparties, 100).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(aliases) > 0 {
+			return tx.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(aliases, 100).Error
+		}
+		return nil
+	})
+}
+
+// Rename changes the canonical name of a counterparty
+func (r *Repository) Rename(ctx context.Context, id string, name string) error {
+	result := db.Conn(ctx, r.DB).
+		Model(&schemas.Counterparty{}).
+		Where("id = ?", id).
+		Update("name", name)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return schemas.ErrCounterpartyNotFound
+	}
+	return nil
+}
+
+// AssignAlias stores an alias, moving it over if another counterparty already has it
+func (r *Repository) AssignAlias(ctx context.Context, alias schemas.CounterpartyAlias) error {
+	return db.Conn(ctx, r.DB).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "normalized_name"}},
+			DoUpdates: clause.AssignmentColumns([]string{"counterparty_id"}),
+		}).
+		Create(&alias).Error
+}
+
+// Merge moves the aliases and transactions of the source counterparty to the target and deletes the source
+// Soft-deleted transactions move too, so a restore does not bring back a link to a missing counterparty
+func (r *Repository) Merge(ctx context.Context, targetID string, sourceID string) (int64, error) {
+	var moved int64
+	err := db.Conn(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&schemas.CounterpartyAlias{}).
+			Where("counterparty_id = ?", sourceID).
+			Update("counterparty_id", targetID).Error
+		if err != nil {
+			return err
+		}
+
+		result := tx.Unscoped().
+			Model(&schemas.Transaction{}).
+			Where("counterparty_id = ?", sourceID).
+			UpdateColumn("counterparty_id", targetID)
+		if result.Error != nil {
+			return result.Error
+		}
+		moved = result.RowsAffected
+
+		return tx.Delete(&schemas.Counterparty{}, "id = ?", sourceID).Error
+	})
+	return moved, err
+}
+
+// LinkTransactions sets the counterparty of the given transactions
+func (r *Repository) LinkTransactions(ctx context.Context, counterpartyID string, transactionIDs []string) (int64, error) {
+	if len(transactionIDs) == 0 {
+		return 0, nil
+	}
+
+	result := db.Conn(ctx, r.DB).
+		Model(&schemas.Transaction{}).
+		Where("id IN ?", transactionIDs).
+		UpdateColumn("counterparty_id", counterpartyID)
+	return result.RowsAffected, result.Error
+}
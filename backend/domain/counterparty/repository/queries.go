@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+	"gorm.io/gorm"
+)
+
+// FindByID retrieves a counterparty with its aliases
+func (r *Repository) FindByID(ctx context.Context, id string) (*schemas.Counterparty, error) {
+	var counterparty schemas.Counterparty
+	err := db.Conn(ctx, r.DB).
+		Preload("Aliases", func(db *gorm.DB) *gorm.DB {
+			return db.Order("normalized_name ASC")
+		}).
+		Where("id = ?", id).
+		First(&counterparty).Error
+	if err != nil {
+		return nil, err
+	}
+	return &counterparty, nil
+}
+
+// FindWithFilters retrieves counterparties by name with their live transaction counts, busiest first
+func (r *Repository) FindWithFilters(ctx context.Context, page int, pageSize int, search string) ([]schemas.CounterpartyListItem, int64, error) {
+	query := db.Conn(ctx, r.DB).Model(&schemas.Counterparty{})
+	if search != "" {
+		query = query.Where("name LIKE ?", "%"+search+"%")
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var items []schemas.CounterpartyListItem
+	err := query.
+		Select("counterparties.id, counterparties.name, COUNT(transactions.id) AS transaction_count").
+		Joins("LEFT JOIN transactions ON transactions.counterparty_id = counterparties.id AND transactions.deleted_at IS NULL").
+		Group("counterparties.id, counterparties.name").
+		Order("transaction_count DESC, counterparties.name ASC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Scan(&items).Error
+	return items, total, err
+}
+
+// FindAliases retrieves every alias in a stable order
+func (r *Repository) FindAliases(ctx context.Context) ([]schemas.CounterpartyAlias, error) {
+	var aliases []schemas.CounterpartyAlias
+	err := db.Conn(ctx, r.DB).Order("normalized_name ASC").Find(&aliases).Error
+	return aliases, err
+}
+
+// IterateUnlinked walks live transactions without a counterparty in batches
+func (r *Repository) IterateUnlinked(ctx context.Context, batchSize int, fn func(transactions []schemas.Transaction) error) error {
+	var batch []schemas.Transaction
+	return db.Conn(ctx, r.DB).
+		Where("counterparty_id IS NULL").
+		FindInBatches(&batch, batchSize, func(tx *gorm.DB, _ int) error {
+			return fn(batch)
+		}).Error
+}
+
+// Summarize aggregates the live transactions of a counterparty
+// Credit and debit totals only count SUCCESS transactions, like the balance
+func (r *Repository) Summarize(ctx context.Context, id string) (*schemas.CounterpartySummary, error) {
+	var row struct {
+		TotalCount     int64
+		SuccessCount   int64
+		FailedCount    int64
+		PendingCount   int64
+		CreditTotal    int64
+		DebitTotal     int64
+		FirstTimestamp int64
+		LastTimestamp  int64
+	}
+
+	err := db.Conn(ctx, r.DB).
+		Model(&schemas.Transaction{}).
+		Select(`COUNT(*) AS total_count,
+			COALESCE(SUM(CASE WHEN status = ? THEN 1 ELSE 0 END), 0) AS success_count,
+			COALESCE(SUM(CASE WHEN status = ? THEN 1 ELSE 0 END), 0) AS failed_count,
+			COALESCE(SUM(CASE WHEN status = ? THEN 1 ELSE 0 END), 0) AS pending_count,
+			COALESCE(SUM(CASE WHEN status = ? AND type = ? THEN amount ELSE 0 END), 0) AS credit_total,
+			COALESCE(SUM(CASE WHEN status = ? AND type = ? THEN amount ELSE 0 END), 0) AS debit_total,
+			COALESCE(MIN(timestamp), 0) AS first_timestamp,
+			COALESCE(MAX(timestamp), 0) AS last_timestamp`,
+			schemas.StatusSuccess, schemas.StatusFailed, schemas.StatusPending,
+			schemas.StatusSuccess, schemas.TypeCredit,
+			schemas.StatusSuccess, schemas.TypeDebit,
+		).
+		Where("counterparty_id = ?", id).
+		Scan(&row).Error
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &schemas.CounterpartySummary{
+		TotalCount:   row.TotalCount,
+		SuccessCount: row.SuccessCount,
+		FailedCount:  row.FailedCount,
+		PendingCount: row.PendingCount,
+		CreditTotal:  row.CreditTotal,
+		DebitTotal:   row.DebitTotal,
+		Net:          row.CreditTotal - row.DebitTotal,
+	}
+	if row.TotalCount > 0 {
+		summary.FirstActivity = time.Unix(row.FirstTimestamp, 0).UTC().Format(time.RFC3339)
+		summary.LastActivity = time.Unix(row.LastTimestamp, 0).UTC().Format(time.RFC3339)
+	}
+	return summary, nil
+}
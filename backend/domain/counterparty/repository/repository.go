@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/gorm"
+)
+
+// IRepository defines the contract for counterparty repository operations
+type IRepository interface {
+	// Commands
+	CreateLinks(ctx context.Context, counterparties []schemas.Counterparty, aliases []schemas.CounterpartyAlias) error
+	Rename(ctx context.Context, id string, name string) error
+	AssignAlias(ctx context.Context, alias schemas.CounterpartyAlias) error
+	Merge(ctx context.Context, targetID string, sourceID string) (int64, error)
+	LinkTransactions(ctx context.Context, counterpartyID string, transactionIDs []string) (int64, error)
+
+	// Queries
+	FindByID(ctx context.Context, id string) (*schemas.Counterparty, error)
+	FindWithFilters(ctx context.Context, page int, pageSize int, search string) ([]schemas.CounterpartyListItem, int64, error)
+	FindAliases(ctx context.Context) ([]schemas.CounterpartyAlias, error)
+	IterateUnlinked(ctx context.Context, batchSize int, fn func(transactions []schemas.Transaction) error) error
+	Summarize(ctx context.Context, id string) (*schemas.CounterpartySummary, error)
+}
+
+// Repository implements IRepository
+type Repository struct {
+	DB *gorm.DB
+}
+
+// NewRepository creates a new counterparty repository instance
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
@@ -0,0 +1,394 @@
+package use_case
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	auditSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/counterparty/repository"
+	transactionRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/fuzzy"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// relinkBatchSize is how many unlinked transactions are resolved at a time by a relink
+const relinkBatchSize = 500
+
+// IUseCase defines the contract for counterparty use case operations
+type IUseCase interface {
+	ListCounterparties(ctx context.Context, page int, pageSize int, search string) (*schemas.CounterpartiesResponse, error)
+	GetCounterparty(ctx context.Context, id string) (*schemas.Counterparty, error)
+	RenameCounterparty(ctx context.Context, id string, req schemas.CounterpartyRequest) (*schemas.Counterparty, error)
+	AddAlias(ctx context.Context, id string, req schemas.CounterpartyAliasRequest) (*schemas.Counterparty, error)
+	MergeCounterparty(ctx context.Context, id string, req schemas.CounterpartyMergeRequest) (*schemas.Counterparty, error)
+	GetTransactions(ctx context.Context, id string, page int, pageSize int) (*schemas.IssuesResponse, error)
+	GetSummary(ctx context.Context, id string) (*schemas.CounterpartySummary, error)
+	Link(ctx context.Context, transactions []schemas.Transaction) (int, error)
+	Relink(ctx context.Context) (*schemas.CounterpartyLinkResponse, error)
+}
+
+// UseCase implements IUseCase
+type UseCase struct {
+	Repository      repository.IRepository
+	TransactionRepo transactionRepo.IRepository
+	Audit           auditUseCase.IUseCase
+	Threshold       float64
+	Now             func() time.Time
+}
+
+// NewUseCase creates a new counterparty use case instance
+// threshold is the per-word similarity a misspelt name needs to match an existing alias
+func NewUseCase(repo repository.IRepository, transactionRepo transactionRepo.IRepository, audit auditUseCase.IUseCase, threshold float64) IUseCase {
+	return &UseCase{
+		Repository:      repo,
+		TransactionRepo: transactionRepo,
+		Audit:           audit,
+		Threshold:       threshold,
+		Now:             time.Now,
+	}
+}
+
+// ListCounterparties retrieves counterparties with their transaction counts and pagination
+func (uc *UseCase) ListCounterparties(ctx context.Context, page int, pageSize int, search string) (*schemas.CounterpartiesResponse, error) {
+	items, total, err := uc.Repository.FindWithFilters(ctx, page, pageSize, strings.TrimSpace(search))
+	if err != nil {
+		return nil, err
+	}
+
+	if items == nil {
+		items = []schemas.CounterpartyListItem{}
+	}
+
+	filtersMeta := make(map[string]interface{})
+	if search != "" {
+		filtersMeta["search"] = search
+	}
+
+	return &schemas.CounterpartiesResponse{
+		Message: constants.MsgCounterpartiesRetrieved,
+		Data:    items,
+		Meta: schemas.ResponseMeta{
+			Pagination: schemas.NewPaginationMeta(total, len(items), page, pageSize),
+			Filters:    filtersMeta,
+		},
+	}, nil
+}
+
+// GetCounterparty retrieves a single counterparty with its aliases
+func (uc *UseCase) GetCounterparty(ctx context.Context, id string) (*schemas.Counterparty, error) {
+	counterparty, err := uc.Repository.FindByID(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, schemas.ErrCounterpartyNotFound
+	}
+	return counterparty, err
+}
+
+// RenameCounterparty changes the canonical name of a counterparty
+// The new name is also added as an alias, so uploads using it link here
+func (uc *UseCase) RenameCounterparty(ctx context.Context, id string, req schemas.CounterpartyRequest) (*schemas.Counterparty, error) {
+	name := strings.TrimSpace(req.Name)
+	if fuzzy.Normalize(name) == "" {
+		return nil, fmt.Errorf("%w: name is required", schemas.ErrInvalidCounterparty)
+	}
+
+	var after *schemas.Counterparty
+	err := uc.TransactionRepo.Transaction(ctx, func(ctx context.Context) error {
+		before, err := uc.GetCounterparty(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.Repository.Rename(ctx, id, name); err != nil {
+			return err
+		}
+
+		alias := schemas.CounterpartyAlias{NormalizedName: fuzzy.Normalize(name), CounterpartyID: id, Name: name}
+		if err := uc.Repository.CreateLinks(ctx, nil, []schemas.CounterpartyAlias{alias}); err != nil {
+			return err
+		}
+
+		after, err = uc.GetCounterparty(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return uc.Audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionCounterpartyUpdate,
+			TargetType: auditSchemas.TargetCounterparty,
+			TargetID:   id,
+			Before:     before,
+			After:      after,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return after, nil
+}
+
+// AddAlias maps another spelling to a counterparty
+// An alias that belonged to a different counterparty is moved; transactions already linked stay where they are
+func (uc *UseCase) AddAlias(ctx context.Context, id string, req schemas.CounterpartyAliasRequest) (*schemas.Counterparty, error) {
+	name := strings.TrimSpace(req.Alias)
+	normalized := fuzzy.Normalize(name)
+	if normalized == "" {
+		return nil, fmt.Errorf("%w: alias is required", schemas.ErrInvalidCounterparty)
+	}
+
+	err := uc.TransactionRepo.Transaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.GetCounterparty(ctx, id); err != nil {
+			return err
+		}
+
+		alias := schemas.CounterpartyAlias{NormalizedName: normalized, CounterpartyID: id, Name: name}
+		if err := uc.Repository.AssignAlias(ctx, alias); err != nil {
+			return err
+		}
+
+		return uc.Audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionCounterpartyAlias,
+			TargetType: auditSchemas.TargetCounterparty,
+			TargetID:   id,
+			After:      alias,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.GetCounterparty(ctx, id)
+}
+
+// MergeCounterparty moves the aliases and transactions of another counterparty into this one
+func (uc *UseCase) MergeCounterparty(ctx context.Context, id string, req schemas.CounterpartyMergeRequest) (*schemas.Counterparty, error) {
+	sourceID := strings.TrimSpace(req.SourceID)
+	if sourceID == "" || sourceID == id {
+		return nil, fmt.Errorf("%w: source_id must name another counterparty", schemas.ErrInvalidCounterparty)
+	}
+
+	err := uc.TransactionRepo.Transaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.GetCounterparty(ctx, id); err != nil {
+			return err
+		}
+		source, err := uc.GetCounterparty(ctx, sourceID)
+		if err != nil {
+			return err
+		}
+
+		moved, err := uc.Repository.Merge(ctx, id, sourceID)
+		if err != nil {
+			return err
+		}
+
+		return uc.Audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionCounterpartyMerge,
+			TargetType: auditSchemas.TargetCounterparty,
+			TargetID:   id,
+			Before:     source,
+			Detail:     map[string]interface{}{"source_id": sourceID, "moved_transactions": moved},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.GetCounterparty(ctx, id)
+}
+
+// GetTransactions lists a counterparty's transactions, newest first
+func (uc *UseCase) GetTransactions(ctx context.Context, id string, page int, pageSize int) (*schemas.IssuesResponse, error) {
+	if _, err := uc.GetCounterparty(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return uc.TransactionRepo.GetAllWithFiltersAndSort(
+		ctx,
+		page,
+		pageSize,
+		schemas.TransactionFilters{Counterparty: id},
+		schemas.TransactionSort{By: "timestamp", Order: "DESC"},
+	)
+}
+
+// GetSummary returns the totals, failure rate and activity range of a counterparty
+func (uc *UseCase) GetSummary(ctx context.Context, id string) (*schemas.CounterpartySummary, error) {
+	counterparty, err := uc.GetCounterparty(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := uc.Repository.Summarize(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	summary.Counterparty = counterparty
+	if summary.TotalCount > 0 {
+		summary.FailureRate = float64(summary.FailedCount) / float64(summary.TotalCount)
+	}
+	return summary, nil
+}
+
+// Link sets the counterparty of each transaction from its name, creating counterparties for new names
+// Transactions are changed in place and not saved, so uploads can link before inserting. The directory is read and
+// extended in one database transaction, so two uploads naming the same new party do not create it twice
+// It returns how many counterparties were created
+func (uc *UseCase) Link(ctx context.Context, transactions []schemas.Transaction) (int, error) {
+	created := 0
+	err := uc.TransactionRepo.Transaction(ctx, func(ctx context.Context) error {
+		resolver, err := uc.newResolver(ctx)
+		if err != nil {
+			return err
+		}
+
+		for i := range transactions {
+			if id := resolver.resolve(transactions[i].Name); id != "" {
+				transactions[i].CounterpartyID = &id
+			}
+		}
+
+		created = len(resolver.counterparties)
+		return uc.Repository.CreateLinks(ctx, resolver.counterparties, resolver.aliases)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return created, nil
+}
+
+// Relink links stored transactions that have no counterparty yet, such as ones uploaded before the directory existed
+func (uc *UseCase) Relink(ctx context.Context) (*schemas.CounterpartyLinkResponse, error) {
+	var linked int64
+	created := 0
+	err := uc.TransactionRepo.Transaction(ctx, func(ctx context.Context) error {
+		resolver, err := uc.newResolver(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = uc.Repository.IterateUnlinked(ctx, relinkBatchSize, func(transactions []schemas.Transaction) error {
+			groups := make(map[string][]string)
+			for _, t := range transactions {
+				if id := resolver.resolve(t.Name); id != "" {
+					groups[id] = append(groups[id], t.ID)
+				}
+			}
+
+			// Store new counterparties before pointing transactions at them
+			if err := uc.Repository.CreateLinks(ctx, resolver.counterparties, resolver.aliases); err != nil {
+				return err
+			}
+			created += len(resolver.counterparties)
+			resolver.counterparties, resolver.aliases = nil, nil
+
+			for id, transactionIDs := range groups {
+				count, err := uc.Repository.LinkTransactions(ctx, id, transactionIDs)
+				if err != nil {
+					return err
+				}
+				linked += count
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return uc.Audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionCounterpartyRelink,
+			TargetType: auditSchemas.TargetTransactions,
+			Detail:     map[string]interface{}{"linked": linked, "created": created},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &schemas.CounterpartyLinkResponse{
+		Message: constants.MsgCounterpartiesLinked,
+		Linked:  int(linked),
+		Created: created,
+	}, nil
+}
+
+// newResolver loads the alias directory for a round of linking
+func (uc *UseCase) newResolver(ctx context.Context) (*resolver, error) {
+	aliases, err := uc.Repository.FindAliases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &resolver{
+		byName:    make(map[string]string, len(aliases)),
+		threshold: uc.Threshold,
+		now:       uc.Now().UTC(),
+	}
+	for _, alias := range aliases {
+		r.byName[alias.NormalizedName] = alias.CounterpartyID
+		r.names = append(r.names, alias.NormalizedName)
+	}
+	return r, nil
+}
+
+// resolver matches names against the alias directory and collects the counterparties and aliases it had to add
+type resolver struct {
+	byName         map[string]string
+	names          []string
+	threshold      float64
+	now            time.Time
+	counterparties []schemas.Counterparty
+	aliases        []schemas.CounterpartyAlias
+}
+
+// resolve returns the counterparty ID for a transaction name
+// Names are matched on their normalized form first, then up to typos against every known alias;
+// a fuzzy match adds the spelling as a new alias and an unknown name creates a counterparty
+func (r *resolver) resolve(name string) string {
+	normalized := fuzzy.Normalize(name)
+	if normalized == "" {
+		return ""
+	}
+
+	if id, ok := r.byName[normalized]; ok {
+		return id
+	}
+
+	id := ""
+	best := 0.0
+	for _, known := range r.names {
+		if !fuzzy.Match(normalized, known, r.threshold) {
+			continue
+		}
+		if score := fuzzy.Similarity(normalized, known); score > best {
+			id, best = r.byName[known], score
+		}
+	}
+
+	if id == "" {
+		id = uuid.New().String()
+		r.counterparties = append(r.counterparties, schemas.Counterparty{
+			ID:        id,
+			Name:      strings.Join(strings.Fields(name), " "),
+			CreatedAt: r.now,
+			UpdatedAt: r.now,
+		})
+	}
+
+	r.aliases = append(r.aliases, schemas.CounterpartyAlias{
+		NormalizedName: normalized,
+		CounterpartyID: id,
+		Name:           strings.TrimSpace(name),
+		CreatedAt:      r.now,
+	})
+	r.byName[normalized] = id
+	r.names = append(r.names, normalized)
+	return id
+}
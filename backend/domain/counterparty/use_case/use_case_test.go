@@ -0,0 +1,189 @@
+package use_case
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	auditRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/repository"
+	auditSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/counterparty/repository"
+	transactionRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupTestUseCase creates a use case backed by an in-memory SQLite database
+func setupTestUseCase(t *testing.T) (IUseCase, *gorm.DB) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to setup test database: %v", err)
+	}
+
+	err = db.AutoMigrate(&schemas.Transaction{}, &schemas.TransactionTag{}, &schemas.TransactionFlag{}, &schemas.Counterparty{}, &schemas.CounterpartyAlias{}, &auditSchemas.AuditEvent{})
+	if err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	audit := auditUseCase.NewUseCase(auditRepo.NewRepository(db))
+	return NewUseCase(repository.NewRepository(db), transactionRepo.NewRepository(db), audit, 0.8), db
+}
+
+// linkAndStore links transactions the way an upload does and inserts them
+func linkAndStore(t *testing.T, uc IUseCase, db *gorm.DB, transactions []schemas.Transaction) int {
+	created, err := uc.Link(context.Background(), transactions)
+	if err != nil {
+		t.Fatalf("Link failed: %v", err)
+	}
+	if err := db.Create(&transactions).Error; err != nil {
+		t.Fatalf("failed to insert test data: %v", err)
+	}
+	return created
+}
+
+// TestLinkMatchesAliases tests that case, punctuation and typo variants link to one counterparty
+func TestLinkMatchesAliases(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+
+	transactions := []schemas.Transaction{
+		{ID: "1", Timestamp: 1000, Name: "JOHN DOE", Type: schemas.TypeCredit, Amount: 100, Status: schemas.StatusSuccess},
+		{ID: "2", Timestamp: 2000, Name: "john doe", Type: schemas.TypeCredit, Amount: 100, Status: schemas.StatusSuccess},
+		{ID: "3", Timestamp: 3000, Name: "John Doe.", Type: schemas.TypeDebit, Amount: 100, Status: schemas.StatusSuccess},
+		{ID: "4", Timestamp: 4000, Name: "JONATHAN SMITH", Type: schemas.TypeDebit, Amount: 100, Status: schemas.StatusFailed},
+		{ID: "5", Timestamp: 5000, Name: "Jonathan Smyth", Type: schemas.TypeDebit, Amount: 100, Status: schemas.StatusSuccess},
+		{ID: "6", Timestamp: 6000, Name: "COMPANY A", Type: schemas.TypeCredit, Amount: 100, Status: schemas.StatusSuccess},
+		{ID: "7", Timestamp: 7000, Name: "COMPANY B", Type: schemas.TypeCredit, Amount: 100, Status: schemas.StatusSuccess},
+	}
+
+	created := linkAndStore(t, uc, db, transactions)
+	if created != 4 {
+		t.Errorf("Expected 4 counterparties to be created, got %d", created)
+	}
+
+	john := *transactions[0].CounterpartyID
+	for _, transaction := range transactions[1:3] {
+		if transaction.CounterpartyID == nil || *transaction.CounterpartyID != john {
+			t.Errorf("Expected %q to link to the JOHN DOE counterparty", transaction.Name)
+		}
+	}
+	if *transactions[3].CounterpartyID != *transactions[4].CounterpartyID {
+		t.Error("Expected the misspelt SMYTH to link to JONATHAN SMITH")
+	}
+	if *transactions[5].CounterpartyID == *transactions[6].CounterpartyID {
+		t.Error("Expected COMPANY A and COMPANY B to stay separate")
+	}
+
+	// A later upload reuses the stored aliases
+	later := []schemas.Transaction{{ID: "8", Timestamp: 7000, Name: "John-Doe", Type: schemas.TypeCredit, Amount: 100, Status: schemas.StatusPending}}
+	if created := linkAndStore(t, uc, db, later); created != 0 {
+		t.Errorf("Expected no new counterparties, got %d", created)
+	}
+	if *later[0].CounterpartyID != john {
+		t.Errorf("Expected later upload to link to the JOHN DOE counterparty")
+	}
+
+	counterparty, err := uc.GetCounterparty(context.Background(), *transactions[3].CounterpartyID)
+	if err != nil {
+		t.Fatalf("GetCounterparty failed: %v", err)
+	}
+	if counterparty.Name != "JONATHAN SMITH" || len(counterparty.Aliases) != 2 {
+		t.Errorf("Expected JONATHAN SMITH with 2 aliases, got %+v", counterparty)
+	}
+}
+
+// TestGetSummaryAndTransactions tests the per-counterparty totals and transaction list
+func TestGetSummaryAndTransactions(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	ctx := context.Background()
+
+	transactions := []schemas.Transaction{
+		{ID: "1", Timestamp: 1700000000, Name: "JOHN DOE", Type: schemas.TypeCredit, Amount: 5000, Status: schemas.StatusSuccess},
+		{ID: "2", Timestamp: 1700000100, Name: "JOHN DOE", Type: schemas.TypeDebit, Amount: 2000, Status: schemas.StatusSuccess},
+		{ID: "3", Timestamp: 1700000200, Name: "JOHN DOE", Type: schemas.TypeDebit, Amount: 9000, Status: schemas.StatusFailed},
+		{ID: "4", Timestamp: 1700000300, Name: "JOHN DOE", Type: schemas.TypeCredit, Amount: 100, Status: schemas.StatusPending},
+		{ID: "5", Timestamp: 1700000400, Name: "JANE DOE", Type: schemas.TypeCredit, Amount: 100, Status: schemas.StatusSuccess},
+	}
+	linkAndStore(t, uc, db, transactions)
+	john := *transactions[0].CounterpartyID
+
+	summary, err := uc.GetSummary(ctx, john)
+	if err != nil {
+		t.Fatalf("GetSummary failed: %v", err)
+	}
+
+	if summary.TotalCount != 4 || summary.FailedCount != 1 || summary.PendingCount != 1 {
+		t.Errorf("Unexpected counts: %+v", summary)
+	}
+	if summary.CreditTotal != 5000 || summary.DebitTotal != 2000 || summary.Net != 3000 {
+		t.Errorf("Unexpected totals: %+v", summary)
+	}
+	if summary.FailureRate != 0.25 {
+		t.Errorf("Expected failure rate 0.25, got %v", summary.FailureRate)
+	}
+	if summary.LastActivity != "2023-11-14T22:18:20Z" {
+		t.Errorf("Unexpected last activity: %s", summary.LastActivity)
+	}
+
+	response, err := uc.GetTransactions(ctx, john, 1, 10)
+	if err != nil {
+		t.Fatalf("GetTransactions failed: %v", err)
+	}
+	if response.Meta.Pagination.Total != 4 || response.Data[0].ID != "4" {
+		t.Errorf("Expected 4 transactions newest first, got %+v", response.Data)
+	}
+
+	if _, err := uc.GetSummary(ctx, "missing"); !errors.Is(err, schemas.ErrCounterpartyNotFound) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
+
+// TestMergeAndRelink tests merging counterparties and linking transactions stored without one
+func TestMergeAndRelink(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	ctx := context.Background()
+
+	transactions := []schemas.Transaction{
+		{ID: "1", Timestamp: 1000, Name: "ACME CORP", Type: schemas.TypeCredit, Amount: 100, Status: schemas.StatusSuccess},
+		{ID: "2", Timestamp: 2000, Name: "ACME CORPORATION", Type: schemas.TypeCredit, Amount: 100, Status: schemas.StatusSuccess},
+	}
+	linkAndStore(t, uc, db, transactions)
+	target, source := *transactions[0].CounterpartyID, *transactions[1].CounterpartyID
+
+	if _, err := uc.MergeCounterparty(ctx, target, schemas.CounterpartyMergeRequest{SourceID: target}); !errors.Is(err, schemas.ErrInvalidCounterparty) {
+		t.Errorf("Expected invalid counterparty error for self merge, got %v", err)
+	}
+
+	merged, err := uc.MergeCounterparty(ctx, target, schemas.CounterpartyMergeRequest{SourceID: source})
+	if err != nil {
+		t.Fatalf("MergeCounterparty failed: %v", err)
+	}
+	if len(merged.Aliases) != 2 {
+		t.Errorf("Expected merged counterparty to have 2 aliases, got %d", len(merged.Aliases))
+	}
+	if _, err := uc.GetCounterparty(ctx, source); !errors.Is(err, schemas.ErrCounterpartyNotFound) {
+		t.Errorf("Expected source to be deleted, got %v", err)
+	}
+
+	// Transactions stored before linking existed
+	if err := db.Create(&schemas.Transaction{ID: "3", Timestamp: 3000, Name: "Acme Corporation", Status: schemas.StatusSuccess}).Error; err != nil {
+		t.Fatalf("failed to insert test data: %v", err)
+	}
+
+	response, err := uc.Relink(ctx)
+	if err != nil {
+		t.Fatalf("Relink failed: %v", err)
+	}
+	if response.Linked != 1 || response.Created != 0 {
+		t.Errorf("Expected 1 linked and 0 created, got %+v", response)
+	}
+
+	summary, err := uc.GetSummary(ctx, target)
+	if err != nil {
+		t.Fatalf("GetSummary failed: %v", err)
+	}
+	if summary.TotalCount != 3 {
+		t.Errorf("Expected 3 transactions after merge and relink, got %d", summary.TotalCount)
+	}
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetForecast returns the projected daily balance for the next days (default 30)
+func (h *Handler) GetForecast(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "GetForecast"),
+	)
+
+	days := schemas.ForecastDefaultDays
+	if value := c.Query("days"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			l.Warn("Invalid forecast days", logger.Error(err))
+			return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+				Status:  http.StatusBadRequest,
+				Message: constants.MsgInvalidForecastDays,
+				Error:   "days must be a whole number",
+			})
+		}
+		days = parsed
+	}
+
+	response, err := h.UseCase.GetForecast(c.Context(), days)
+	if errors.Is(err, schemas.ErrInvalidForecastDays) {
+		l.Warn("Invalid forecast days", logger.Error(err))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidForecastDays,
+			Error:   err.Error(),
+		})
+	}
+	if err != nil {
+		l.Error("Failed to build forecast", logger.Error(err))
+		return c.Status(http.StatusInternalServerError).JSON(schemas.ErrorResponse{
+			Status:  http.StatusInternalServerError,
+			Message: constants.MsgFailedToForecast,
+			Error:   err.Error(),
+		})
+	}
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
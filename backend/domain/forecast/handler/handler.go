@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"time"
+
+	forecastRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/forecast/repository"
+	forecastUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/forecast/use_case"
+	recurringRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/recurring/repository"
+	recurringUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/recurring/use_case"
+	transactionRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/config"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/deps"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+)
+
+const ContextName = "Domain.Forecast.Handler"
+
+// Handler defines the forecast handlers
+type Handler struct {
+	Logger  *logger.Logger
+	UseCase forecastUseCase.IUseCase
+}
+
+// NewHandler creates a new forecast handler instance with all dependencies
+func NewHandler(d *deps.App) *Handler {
+	// Initialize repositories
+	repository := forecastRepo.NewRepository(d.DB.GetDB())
+	transactionRepository := transactionRepo.NewRepository(d.DB.GetDB())
+	recurringRepository := recurringRepo.NewRepository(d.DB.GetDB())
+
+	// Initialize use case
+	cfg := config.GetConfig()
+	grace := time.Duration(cfg.RecurringGraceDays) * 24 * time.Hour
+	lookback := time.Duration(cfg.RecurringLookbackDays) * 24 * time.Hour
+	recurring := recurringUseCase.NewUseCase(recurringRepository, cfg.RecurringAmountTolerance, cfg.RecurringMinOccurrences, grace, lookback)
+	useCase := forecastUseCase.NewUseCase(repository, transactionRepository, recurring, cfg.ForecastMinHistory)
+
+	return &Handler{
+		Logger:  d.Logger,
+		UseCase: useCase,
+	}
+}
+
+// RegisterApi registers forecast API routes
+func RegisterApi(d *deps.App) *Handler {
+	handler := NewHandler(d)
+
+	api := d.Fiber.Group("/api")
+
+	api.Get("/forecast", handler.GetForecast)
+
+	return handler
+}
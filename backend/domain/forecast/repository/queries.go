@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+)
+
+// CountOutcomes counts SUCCESS and FAILED transactions per counterparty and type
+// PENDING transactions have no outcome yet and are left out
+func (r *Repository) CountOutcomes(ctx context.Context) ([]Outcome, error) {
+	var rows []Outcome
+
+	err := db.Conn(ctx, r.DB).
+		Model(&schemas.Transaction{}).
+		Select(`COALESCE(counterparty_id, '') AS counterparty_id, type,
+			COALESCE(SUM(CASE WHEN status = ? THEN 1 ELSE 0 END), 0) AS success,
+			COALESCE(SUM(CASE WHEN status = ? THEN 1 ELSE 0 END), 0) AS failed`,
+			schemas.StatusSuccess, schemas.StatusFailed).
+		Where("status IN (?, ?)", schemas.StatusSuccess, schemas.StatusFailed).
+		Group("COALESCE(counterparty_id, ''), type").
+		Scan(&rows).Error
+
+	return rows, err
+}
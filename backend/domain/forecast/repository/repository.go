@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/gorm"
+)
+
+// IRepository defines the contract for forecast repository operations
+type IRepository interface {
+	// Queries
+	CountOutcomes(ctx context.Context) ([]Outcome, error)
+}
+
+// Outcome counts the resolved transactions of a counterparty and type
+// CounterpartyID is empty for transactions that are not linked to a counterparty
+type Outcome struct {
+	CounterpartyID string
+	Type           schemas.TransactionType
+	Success        int64
+	Failed         int64
+}
+
+// Repository implements IRepository
+type Repository struct {
+	DB *gorm.DB
+}
+
+// NewRepository creates a new forecast repository instance
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
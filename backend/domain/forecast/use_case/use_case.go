@@ -0,0 +1,242 @@
+package use_case
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/forecast/repository"
+	recurringUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/recurring/use_case"
+	transactionRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+)
+
+// bandZ is the number of standard deviations between the expected balance and its low and high
+// band, giving a 90% interval
+const bandZ = 1.645
+
+// IUseCase defines the contract for forecast use case operations
+type IUseCase interface {
+	GetForecast(ctx context.Context, days int) (*schemas.ForecastResponse, error)
+}
+
+// UseCase implements IUseCase
+type UseCase struct {
+	Repository      repository.IRepository
+	TransactionRepo transactionRepo.IRepository
+	Recurring       recurringUseCase.IUseCase
+	MinHistory      int
+	Now             func() time.Time
+}
+
+// NewUseCase creates a new forecast use case instance
+// minHistory is how many resolved transactions a counterparty needs before its own success rate
+// is used instead of the rate of all transactions of the same type
+func NewUseCase(repo repository.IRepository, transactionRepo transactionRepo.IRepository, recurring recurringUseCase.IUseCase, minHistory int) IUseCase {
+	return &UseCase{
+		Repository:      repo,
+		TransactionRepo: transactionRepo,
+		Recurring:       recurring,
+		MinHistory:      minHistory,
+		Now:             time.Now,
+	}
+}
+
+// projectedFlow is a forecast flow placed on a day of the horizon with its variance
+type projectedFlow struct {
+	day      int
+	flow     schemas.ForecastFlow
+	expected float64
+	variance float64
+}
+
+// GetForecast projects the daily balance over the next days from the current balance,
+// the PENDING transactions and the upcoming payments of recurring series
+// Each flow is weighted by its historical success probability; PENDING transactions are
+// expected to settle on the first day and overdue recurring payments are moved to it
+func (uc *UseCase) GetForecast(ctx context.Context, days int) (*schemas.ForecastResponse, error) {
+	if days < 1 || days > schemas.ForecastMaxDays {
+		return nil, fmt.Errorf("%w: days must be between 1 and %d", schemas.ErrInvalidForecastDays, schemas.ForecastMaxDays)
+	}
+
+	now := uc.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	end := today.AddDate(0, 0, days)
+
+	balance, _, err := uc.TransactionRepo.GetBalance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	outcomes, err := uc.Repository.CountOutcomes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rates := newSuccessRates(outcomes, uc.MinHistory)
+
+	var flows []projectedFlow
+
+	pending, err := uc.TransactionRepo.FindByStatus(ctx, schemas.StatusPending)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range pending {
+		counterpartyID := ""
+		if t.CounterpartyID != nil {
+			counterpartyID = *t.CounterpartyID
+		}
+		flow := schemas.ForecastFlow{
+			Source:         schemas.FlowSourcePending,
+			TransactionID:  t.ID,
+			CounterpartyID: counterpartyID,
+			Name:           t.Name,
+			Type:           string(t.Type),
+			Amount:         t.Amount,
+		}
+		flows = append(flows, project(flow, 0, today, rates.probability(counterpartyID, t.Type), 0))
+	}
+
+	recurring, err := uc.Recurring.GetRecurring(ctx, now, schemas.RecurringFilters{})
+	if err != nil {
+		return nil, err
+	}
+	for _, series := range recurring.Series {
+		probability := rates.probability(series.CounterpartyID, schemas.TransactionType(series.Type))
+		spread := float64(series.MaxAmount-series.MinAmount) / 4
+		first := time.Unix(series.ExpectedNextAt, 0).UTC()
+
+		for n := 0; ; n++ {
+			next := schemas.AddRecurringPeriods(first, series.Period, n)
+			if !next.Before(end) {
+				break
+			}
+			day := 0
+			if next.After(today) {
+				day = int(next.Sub(today) / (24 * time.Hour))
+			}
+			flow := schemas.ForecastFlow{
+				Source:         schemas.FlowSourceRecurring,
+				CounterpartyID: series.CounterpartyID,
+				Name:           series.Name,
+				Type:           series.Type,
+				Amount:         series.ExpectedAmount,
+			}
+			flows = append(flows, project(flow, day, today, probability, spread))
+		}
+	}
+
+	sort.SliceStable(flows, func(i, j int) bool {
+		if flows[i].day != flows[j].day {
+			return flows[i].day < flows[j].day
+		}
+		return flows[i].flow.Source < flows[j].flow.Source
+	})
+
+	response := &schemas.ForecastResponse{
+		Message:         constants.MsgForecastRetrieved,
+		Days:            days,
+		StartingBalance: balance,
+		Daily:           make([]schemas.ForecastDay, days),
+		Flows:           make([]schemas.ForecastFlow, len(flows)),
+		GeneratedAt:     now.Format(time.RFC3339),
+	}
+
+	expected := float64(balance)
+	variance := 0.0
+	next := 0
+	for d := range response.Daily {
+		day := &response.Daily[d]
+		day.Date = today.AddDate(0, 0, d).Format("2006-01-02")
+
+		var inflow, outflow float64
+		for ; next < len(flows) && flows[next].day == d; next++ {
+			f := flows[next]
+			response.Flows[next] = f.flow
+			if f.expected >= 0 {
+				inflow += f.expected
+			} else {
+				outflow -= f.expected
+			}
+			expected += f.expected
+			variance += f.variance
+		}
+
+		band := bandZ * math.Sqrt(variance)
+		day.ExpectedInflow = int64(math.Round(inflow))
+		day.ExpectedOutflow = int64(math.Round(outflow))
+		day.Expected = int64(math.Round(expected))
+		day.Low = int64(math.Round(expected - band))
+		day.High = int64(math.Round(expected + band))
+	}
+
+	return response, nil
+}
+
+// project places a flow on a day and works out its expected value and variance
+// A flow succeeds with the given probability; spread is the standard deviation of its amount
+func project(flow schemas.ForecastFlow, day int, today time.Time, probability float64, spread float64) projectedFlow {
+	amount := float64(flow.Amount)
+	if flow.Type == string(schemas.TypeDebit) {
+		amount = -amount
+	}
+
+	flow.Date = today.AddDate(0, 0, day).Format("2006-01-02")
+	flow.Probability = math.Round(probability*10000) / 10000
+
+	return projectedFlow{
+		day:      day,
+		flow:     flow,
+		expected: probability * amount,
+		variance: probability*(spread*spread+amount*amount) - (probability*amount)*(probability*amount),
+	}
+}
+
+// outcomeKey identifies the resolved transactions of a counterparty and type
+type outcomeKey struct {
+	counterpartyID  string
+	transactionType schemas.TransactionType
+}
+
+// successRates looks up how often transactions of a counterparty or type succeeded
+type successRates struct {
+	byCounterparty map[outcomeKey]repository.Outcome
+	byType         map[schemas.TransactionType]repository.Outcome
+	minHistory     int
+}
+
+// newSuccessRates indexes outcome counts by counterparty and by type
+func newSuccessRates(outcomes []repository.Outcome, minHistory int) successRates {
+	rates := successRates{
+		byCounterparty: make(map[outcomeKey]repository.Outcome),
+		byType:         make(map[schemas.TransactionType]repository.Outcome),
+		minHistory:     minHistory,
+	}
+	for _, o := range outcomes {
+		if o.CounterpartyID != "" {
+			rates.byCounterparty[outcomeKey{counterpartyID: o.CounterpartyID, transactionType: o.Type}] = o
+		}
+		total := rates.byType[o.Type]
+		total.Success += o.Success
+		total.Failed += o.Failed
+		rates.byType[o.Type] = total
+	}
+	return rates
+}
+
+// probability returns the success rate of a counterparty's transactions of a type, falling back
+// to the rate of the type and to certainty when nothing has been resolved yet
+func (r successRates) probability(counterpartyID string, transactionType schemas.TransactionType) float64 {
+	if counterpartyID != "" {
+		o, ok := r.byCounterparty[outcomeKey{counterpartyID: counterpartyID, transactionType: transactionType}]
+		if ok && o.Success+o.Failed > 0 && o.Success+o.Failed >= int64(r.minHistory) {
+			return float64(o.Success) / float64(o.Success+o.Failed)
+		}
+	}
+	if o := r.byType[transactionType]; o.Success+o.Failed > 0 {
+		return float64(o.Success) / float64(o.Success+o.Failed)
+	}
+	return 1
+}
@@ -0,0 +1,99 @@
+package use_case
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/forecast/repository"
+	recurringRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/recurring/repository"
+	recurringUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/recurring/use_case"
+	transactionRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupTestUseCase creates a use case backed by an in-memory SQLite database, fixed at 2024-05-01
+func setupTestUseCase(t *testing.T) (IUseCase, *gorm.DB) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to setup test database: %v", err)
+	}
+
+	if err := db.AutoMigrate(&schemas.Transaction{}, &schemas.Counterparty{}); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	recurring := recurringUseCase.NewUseCase(recurringRepo.NewRepository(db), 0.1, 3, 3*24*time.Hour, 0)
+	uc := NewUseCase(repository.NewRepository(db), transactionRepo.NewRepository(db), recurring, 3).(*UseCase)
+	uc.Now = func() time.Time { return time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC) }
+	return uc, db
+}
+
+// date returns the Unix timestamp of a UTC date at 09:00
+func date(month time.Month, day int) int64 {
+	return time.Date(2024, month, day, 9, 0, 0, 0, time.UTC).Unix()
+}
+
+// TestGetForecastProjectsFlows tests the starting balance, weighted flows and bands
+func TestGetForecastProjectsFlows(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+
+	salary := "cp-salary"
+	transactions := []schemas.Transaction{
+		{ID: "S1", Timestamp: date(2, 25), Name: "COMPANY A", Type: schemas.TypeCredit, Amount: 1000000, Status: schemas.StatusSuccess, CounterpartyID: &salary},
+		{ID: "S2", Timestamp: date(3, 25), Name: "COMPANY A", Type: schemas.TypeCredit, Amount: 1000000, Status: schemas.StatusSuccess, CounterpartyID: &salary},
+		{ID: "S3", Timestamp: date(4, 25), Name: "COMPANY A", Type: schemas.TypeCredit, Amount: 1000000, Status: schemas.StatusSuccess, CounterpartyID: &salary},
+		{ID: "D1", Timestamp: date(3, 2), Name: "SHOP", Type: schemas.TypeDebit, Amount: 20000, Status: schemas.StatusSuccess},
+		{ID: "D2", Timestamp: date(3, 9), Name: "MARKET", Type: schemas.TypeDebit, Amount: 30000, Status: schemas.StatusFailed},
+		{ID: "P1", Timestamp: date(4, 30), Name: "STORE", Type: schemas.TypeDebit, Amount: 100000, Status: schemas.StatusPending},
+	}
+	if err := db.Create(&transactions).Error; err != nil {
+		t.Fatalf("failed to insert test data: %v", err)
+	}
+
+	response, err := uc.GetForecast(context.Background(), 30)
+	if err != nil {
+		t.Fatalf("GetForecast failed: %v", err)
+	}
+
+	if response.StartingBalance != 2980000 || len(response.Daily) != 30 || len(response.Flows) != 2 {
+		t.Fatalf("Unexpected forecast: balance %d, %d days, flows %+v", response.StartingBalance, len(response.Daily), response.Flows)
+	}
+
+	// The pending debit has the 50% success rate of debits
+	pending := response.Flows[0]
+	if pending.Source != schemas.FlowSourcePending || pending.TransactionID != "P1" || pending.Probability != 0.5 || pending.Date != "2024-05-01" {
+		t.Errorf("Unexpected pending flow: %+v", pending)
+	}
+	first := response.Daily[0]
+	if first.Date != "2024-05-01" || first.Expected != 2930000 || first.ExpectedOutflow != 50000 || first.Low != 2847750 || first.High != 3012250 {
+		t.Errorf("Unexpected first day: %+v", first)
+	}
+
+	// The salary always arrived, so it is certain and does not widen the band
+	recurring := response.Flows[1]
+	if recurring.Source != schemas.FlowSourceRecurring || recurring.Date != "2024-05-25" || recurring.Probability != 1 || recurring.Amount != 1000000 {
+		t.Errorf("Unexpected recurring flow: %+v", recurring)
+	}
+	payday := response.Daily[24]
+	if payday.Date != "2024-05-25" || payday.ExpectedInflow != 1000000 || payday.Expected != 3930000 || payday.Low != 3847750 {
+		t.Errorf("Unexpected payday: %+v", payday)
+	}
+	if response.Daily[29].Expected != 3930000 {
+		t.Errorf("Expected the balance to hold after payday, got %+v", response.Daily[29])
+	}
+}
+
+// TestGetForecastInvalidDays tests the horizon limits
+func TestGetForecastInvalidDays(t *testing.T) {
+	uc, _ := setupTestUseCase(t)
+
+	for _, days := range []int{0, -1, schemas.ForecastMaxDays + 1} {
+		if _, err := uc.GetForecast(context.Background(), days); !errors.Is(err, schemas.ErrInvalidForecastDays) {
+			t.Errorf("Expected invalid days error for %d, got %v", days, err)
+		}
+	}
+}
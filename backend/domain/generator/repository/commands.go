@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+	"gorm.io/gorm"
+)
+
+// chunkSize is how many generated transactions are held in memory and inserted at a time
+const chunkSize = 1000
+
+// CreateBatch stores an upload batch and the transactions next returns until it reports false, in one transaction
+// The transactions are streamed in chunks, so large datasets are never held in memory at once
+// It returns how many transactions were stored
+func (r *Repository) CreateBatch(ctx context.Context, batch *schemas.UploadBatch, next func() (schemas.Transaction, bool)) (int, error) {
+	stored := 0
+	err := db.Conn(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(batch).Error; err != nil {
+			return err
+		}
+
+		chunk := make([]schemas.Transaction, 0, chunkSize)
+		for {
+			transaction, ok := next()
+			if ok {
+				chunk = append(chunk, transaction)
+			}
+			if len(chunk) == chunkSize || (!ok && len(chunk) > 0) {
+				if err := tx.CreateInBatches(chunk, 100).Error; err != nil {
+					return err
+				}
+				stored += len(chunk)
+				chunk = chunk[:0]
+			}
+			if !ok {
+				return nil
+			}
+		}
+	})
+	if err != nil {
+		return 0, err
+	}
+	return stored, nil
+}
+
+// Transaction runs fn in a database transaction, which the repositories called with its context join
+func (r *Repository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return db.Transaction(ctx, r.DB, fn)
+}
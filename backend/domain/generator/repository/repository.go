@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/gorm"
+)
+
+// IRepository defines the contract for synthetic data repository operations
+type IRepository interface {
+	// Commands
+	CreateBatch(ctx context.Context, batch *schemas.UploadBatch, next func() (schemas.Transaction, bool)) (int, error)
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Repository implements IRepository
+type Repository struct {
+	DB *gorm.DB
+}
+
+// NewRepository creates a new synthetic data repository instance
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
@@ -0,0 +1,195 @@
+package use_case
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+)
+
+// recentRows is how many recent valid rows duplicates are copied from, keeping duplicates close in time to the original
+const recentRows = 1000
+
+// counterpartySkew is the Zipf exponent of counterparty popularity, so a few counterparties get most transactions
+const counterpartySkew = 1.2
+
+var (
+	firstNames = []string{
+		"ADI", "AGUS", "ANDI", "ANITA", "BAYU", "BUDI", "DEWI", "DIAN", "EKA", "FAJAR", "FITRI", "HADI", "INDAH",
+		"JOHN", "JANE", "KARTIKA", "LINDA", "MAYA", "NINA", "PUTRI", "RINA", "RIZKY", "SARI", "TONO", "WULAN", "YUDI",
+	}
+	lastNames = []string{
+		"DOE", "HARTONO", "KUSUMA", "LESTARI", "NUGROHO", "PRATAMA", "SANTOSO", "SAPUTRA", "SETIAWAN", "SIREGAR",
+		"SUSANTO", "TANJUNG", "WIBOWO", "WIJAYA",
+	}
+	merchantPrefixes = []string{"PT", "CV", "TOKO", "WARUNG", "KEDAI"}
+	merchantNames    = []string{
+		"MAJU JAYA", "SUMBER REJEKI", "SINAR ABADI", "BERKAH", "MAKMUR", "SENTOSA", "CAHAYA", "NUSANTARA",
+		"MITRA USAHA", "INDAH PERMAI", "ANUGERAH", "SEJAHTERA",
+	}
+	creditDescriptions = []string{"salary", "refund", "transfer in", "top up", "cashback", "invoice payment", "interest"}
+	debitDescriptions  = []string{
+		"groceries", "restaurant", "electricity bill", "internet", "transport", "rent", "shopping", "transfer out",
+		"insurance", "phone credit",
+	}
+)
+
+// row is a generated CSV record, with the transaction it stands for unless it is invalid
+type row struct {
+	record      []string
+	transaction schemas.Transaction
+}
+
+// generator produces the rows of a synthetic dataset in timestamp order
+// Every random choice is drawn from one source seeded with the config seed, in a fixed order, so a config always
+// produces the same rows
+type generator struct {
+	cfg            schemas.GeneratorConfig
+	rng            *rand.Rand
+	counterparty   func() int
+	counterparties []string
+	step           float64
+	index          int
+	recent         []row
+	summary        *schemas.GeneratorSummary
+}
+
+// newGenerator creates a generator for a validated config
+func newGenerator(cfg schemas.GeneratorConfig) *generator {
+	rng := rand.New(rand.NewSource(cfg.Seed))
+	g := &generator{
+		cfg:            cfg,
+		rng:            rng,
+		counterparties: counterpartyNames(rng, cfg.Counterparties),
+		step:           float64(cfg.End.Unix()-cfg.Start.Unix()) / float64(cfg.Rows),
+		summary: &schemas.GeneratorSummary{
+			Seed:     cfg.Seed,
+			Rows:     cfg.Rows,
+			Statuses: map[string]int{},
+			Start:    cfg.Start.UTC(),
+			End:      cfg.End.UTC(),
+		},
+	}
+
+	g.counterparty = func() int { return 0 }
+	if cfg.Counterparties > 1 {
+		zipf := rand.NewZipf(rng, counterpartySkew, 1, uint64(cfg.Counterparties-1))
+		g.counterparty = func() int { return int(zipf.Uint64()) }
+	}
+	return g
+}
+
+// counterpartyNames returns n distinct counterparty names, mixing people and merchants
+func counterpartyNames(rng *rand.Rand, n int) []string {
+	names := make([]string, 0, n)
+	taken := make(map[string]bool, n)
+	for len(names) < n {
+		var name string
+		if rng.Intn(3) == 0 {
+			name = merchantPrefixes[rng.Intn(len(merchantPrefixes))] + " " + merchantNames[rng.Intn(len(merchantNames))]
+		} else {
+			name = firstNames[rng.Intn(len(firstNames))] + " " + lastNames[rng.Intn(len(lastNames))]
+		}
+		if taken[name] {
+			name = fmt.Sprintf("%s %d", name, len(names)+1)
+		}
+		taken[name] = true
+		names = append(names, name)
+	}
+	return names
+}
+
+// next returns the next row, or false once all rows have been generated
+func (g *generator) next() (row, bool) {
+	if g.index >= g.cfg.Rows {
+		return row{}, false
+	}
+	timestamp := g.cfg.Start.Unix() + int64((float64(g.index)+g.rng.Float64())*g.step)
+	g.index++
+
+	kind := g.rng.Float64()
+	switch {
+	case kind < g.cfg.DuplicateRate && len(g.recent) > 0:
+		r := g.recent[g.rng.Intn(len(g.recent))]
+		g.count(r.transaction)
+		g.summary.Duplicates++
+		return r, true
+	case kind >= g.cfg.DuplicateRate && kind < g.cfg.DuplicateRate+g.cfg.InvalidRate:
+		g.summary.Invalid++
+		return row{record: g.invalidRecord(g.validRow(timestamp).record)}, true
+	}
+
+	r := g.validRow(timestamp)
+	g.count(r.transaction)
+	g.summary.Valid++
+	if len(g.recent) < recentRows {
+		g.recent = append(g.recent, r)
+	} else {
+		g.recent[g.index%recentRows] = r
+	}
+	return r, true
+}
+
+// validRow generates a row that passes upload validation
+func (g *generator) validRow(timestamp int64) row {
+	transactionType := schemas.TypeDebit
+	amount := g.cfg.DebitAmount
+	descriptions := debitDescriptions
+	if g.rng.Float64() < g.cfg.CreditRatio {
+		transactionType = schemas.TypeCredit
+		amount = g.cfg.CreditAmount
+		descriptions = creditDescriptions
+	}
+
+	t := schemas.Transaction{
+		Timestamp:   timestamp,
+		Name:        g.counterparties[g.counterparty()],
+		Type:        transactionType,
+		Status:      g.cfg.Statuses.Sample(g.rng),
+		Description: descriptions[g.rng.Intn(len(descriptions))],
+	}
+	units := amount.Sample(g.rng)
+	t.Amount = units * 100 // Convert to cents, as uploads do
+
+	return row{
+		record: []string{
+			strconv.FormatInt(t.Timestamp, 10), t.Name, string(t.Type), strconv.FormatInt(units, 10), string(t.Status), t.Description,
+		},
+		transaction: t,
+	}
+}
+
+// invalidRecord breaks one field of a valid record so the row fails upload validation
+func (g *generator) invalidRecord(record []string) []string {
+	switch g.rng.Intn(8) {
+	case 0:
+		// There is no month 13, so the date fails however old a timestamp the policy accepts
+		record[0] = "31/13/1999"
+	case 1:
+		record[0] = "not-a-date"
+	case 2:
+		record[1] = ""
+	case 3:
+		record[2] = "TRANSFER"
+	case 4:
+		record[3] = "-" + record[3]
+	case 5:
+		record[3] = record[3] + "abc"
+	case 6:
+		record[4] = "CANCELLED"
+	default:
+		record = record[:4]
+	}
+	return record
+}
+
+// count adds a generated transaction to the summary
+func (g *generator) count(t schemas.Transaction) {
+	if t.Type == schemas.TypeCredit {
+		g.summary.Credits++
+	} else {
+		g.summary.Debits++
+	}
+	g.summary.Statuses[string(t.Status)]++
+}
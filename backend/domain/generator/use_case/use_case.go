@@ -0,0 +1,135 @@
+package use_case
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"time"
+
+	auditSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/generator/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/requestmeta"
+	"github.com/google/uuid"
+)
+
+// Source labels the upload batches of inserted synthetic data
+const Source = "synthetic"
+
+// csvHeader is the header row of the upload CSV format
+var csvHeader = []string{"timestamp", "name", "type", "amount", "status", "description"}
+
+// IUseCase defines the contract for synthetic data use case operations
+type IUseCase interface {
+	WriteCSV(ctx context.Context, cfg schemas.GeneratorConfig, w io.Writer) (*schemas.GeneratorSummary, error)
+	Insert(ctx context.Context, cfg schemas.GeneratorConfig) (*schemas.GeneratorSummary, error)
+}
+
+// UseCase implements IUseCase
+type UseCase struct {
+	Repository repository.IRepository
+	audit      auditUseCase.IUseCase
+	now        func() time.Time
+}
+
+// NewUseCase creates a new synthetic data use case instance
+func NewUseCase(repo repository.IRepository, audit auditUseCase.IUseCase) IUseCase {
+	return &UseCase{
+		Repository: repo,
+		audit:      audit,
+		now:        time.Now,
+	}
+}
+
+// WriteCSV writes a synthetic dataset in the upload CSV format, invalid rows included
+func (uc *UseCase) WriteCSV(ctx context.Context, cfg schemas.GeneratorConfig, w io.Writer) (*schemas.GeneratorSummary, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return nil, err
+	}
+
+	g := newGenerator(cfg)
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		r, ok := g.next()
+		if !ok {
+			break
+		}
+		if err := writer.Write(r.record); err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+	return g.summary, nil
+}
+
+// Insert stores a synthetic dataset as one upload batch, bypassing the upload pipeline so large datasets load fast
+// Counterparty linking, categorisation rules and anomaly detection are not run on the rows. Duplicate rows are
+// stored as separate transactions, as if uploaded in different files
+func (uc *UseCase) Insert(ctx context.Context, cfg schemas.GeneratorConfig) (*schemas.GeneratorSummary, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if cfg.InvalidRate > 0 {
+		return nil, fmt.Errorf("%w: invalid rows can only be written to CSV", schemas.ErrInvalidGeneratorConfig)
+	}
+
+	batch := &schemas.UploadBatch{
+		ID:           uuid.New().String(),
+		Source:       Source,
+		Filename:     fmt.Sprintf("synthetic-seed-%d.csv", cfg.Seed),
+		TotalRecords: cfg.Rows,
+		UploadedBy:   requestmeta.FromContext(ctx).Actor,
+		CreatedAt:    uc.now().UTC(),
+	}
+
+	g := newGenerator(cfg)
+	next := func() (schemas.Transaction, bool) {
+		r, ok := g.next()
+		if !ok {
+			return schemas.Transaction{}, false
+		}
+		transaction := r.transaction
+		transaction.ID = uuid.New().String()
+		transaction.BatchID = &batch.ID
+		return transaction, true
+	}
+	summary := g.summary
+	summary.BatchID = batch.ID
+
+	// The batch is stored with its audit event, so neither is kept without the other
+	err := uc.Repository.Transaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.Repository.CreateBatch(ctx, batch, next); err != nil {
+			return err
+		}
+
+		return uc.audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionGenerate,
+			TargetType: auditSchemas.TargetTransactions,
+			TargetID:   batch.ID,
+			Detail: map[string]interface{}{
+				"total_records":     batch.TotalRecords,
+				"duplicate_records": summary.Duplicates,
+				"seed":              cfg.Seed,
+				"source":            batch.Source,
+				"filename":          batch.Filename,
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
@@ -0,0 +1,243 @@
+package use_case
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	auditRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/repository"
+	auditSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/generator/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/requestmeta"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupTestUseCase creates a use case backed by an in-memory SQLite database
+func setupTestUseCase(t *testing.T) (IUseCase, *gorm.DB) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to setup test database: %v", err)
+	}
+
+	if err := db.AutoMigrate(&schemas.Transaction{}, &schemas.UploadBatch{}, &schemas.StatementBalance{}, &auditSchemas.AuditEvent{}); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	audit := auditUseCase.NewUseCase(auditRepo.NewRepository(db))
+	return NewUseCase(repository.NewRepository(db), audit), db
+}
+
+// testConfig returns a config for rows rows over January 2024
+func testConfig(t *testing.T, rows int) schemas.GeneratorConfig {
+	credit, err := schemas.ParseAmountDistribution("lognormal:5000000:0.5")
+	if err != nil {
+		t.Fatalf("ParseAmountDistribution failed: %v", err)
+	}
+	debit, err := schemas.ParseAmountDistribution("uniform:1000:200000")
+	if err != nil {
+		t.Fatalf("ParseAmountDistribution failed: %v", err)
+	}
+	statuses, err := schemas.ParseStatusWeights("success=80,failed=10,pending=10")
+	if err != nil {
+		t.Fatalf("ParseStatusWeights failed: %v", err)
+	}
+
+	return schemas.GeneratorConfig{
+		Seed:           42,
+		Rows:           rows,
+		Counterparties: 20,
+		Start:          time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:            time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		CreditRatio:    0.3,
+		CreditAmount:   credit,
+		DebitAmount:    debit,
+		Statuses:       statuses,
+	}
+}
+
+func writeCSV(t *testing.T, uc IUseCase, cfg schemas.GeneratorConfig) (string, *schemas.GeneratorSummary) {
+	var buf bytes.Buffer
+	summary, err := uc.WriteCSV(context.Background(), cfg, &buf)
+	if err != nil {
+		t.Fatalf("WriteCSV failed: %v", err)
+	}
+	return buf.String(), summary
+}
+
+// TestWriteCSVReproducible tests that the same seed gives the same file and another seed a different one
+func TestWriteCSVReproducible(t *testing.T) {
+	uc, _ := setupTestUseCase(t)
+	cfg := testConfig(t, 500)
+	cfg.DuplicateRate = 0.05
+	cfg.InvalidRate = 0.05
+
+	first, _ := writeCSV(t, uc, cfg)
+	second, _ := writeCSV(t, uc, cfg)
+	if first != second {
+		t.Error("Expected the same seed to give the same file")
+	}
+
+	cfg.Seed = 43
+	third, _ := writeCSV(t, uc, cfg)
+	if first == third {
+		t.Error("Expected another seed to give a different file")
+	}
+}
+
+// TestWriteCSVRows tests that valid rows pass upload validation, invalid rows fail it and the summary counts both
+func TestWriteCSVRows(t *testing.T) {
+	uc, _ := setupTestUseCase(t)
+	cfg := testConfig(t, 2000)
+	cfg.DuplicateRate = 0.1
+	cfg.InvalidRate = 0.1
+
+	content, summary := writeCSV(t, uc, cfg)
+	if summary.Valid+summary.Duplicates+summary.Invalid != cfg.Rows {
+		t.Fatalf("Expected %d rows in the summary, got %+v", cfg.Rows, summary)
+	}
+	if summary.Duplicates < 120 || summary.Duplicates > 280 || summary.Invalid < 120 || summary.Invalid > 280 {
+		t.Errorf("Expected about 200 duplicate and invalid rows, got %+v", summary)
+	}
+	if summary.Statuses[string(schemas.StatusSuccess)] < summary.Statuses[string(schemas.StatusFailed)]*4 {
+		t.Errorf("Expected mostly successful transactions, got %v", summary.Statuses)
+	}
+
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		t.Fatalf("failed to read CSV: %v", err)
+	}
+	if len(records) != cfg.Rows+1 || strings.Join(records[0], ",") != "timestamp,name,type,amount,status,description" {
+		t.Fatalf("Expected a header and %d rows, got %d records starting with %v", cfg.Rows, len(records), records[0])
+	}
+
+	fv := validator.NewFieldValidator()
+	invalid := 0
+	seen := make(map[string]bool)
+	duplicates := 0
+	names := make(map[string]bool)
+	for _, record := range records[1:] {
+		if err := validateRecord(fv, record); err != nil {
+			invalid++
+			continue
+		}
+		key := strings.Join(record, ",")
+		if seen[key] {
+			duplicates++
+		}
+		seen[key] = true
+		names[record[1]] = true
+	}
+	if invalid != summary.Invalid {
+		t.Errorf("Expected %d invalid rows, got %d", summary.Invalid, invalid)
+	}
+
+	// Invalid rows must also fail under a policy that accepts any timestamp since 1970
+	policy, err := validator.NewTimestampPolicy(validator.TimestampUnitAuto, "UTC", 1970, 24)
+	if err != nil {
+		t.Fatalf("failed to build timestamp policy: %v", err)
+	}
+	permissive := validator.NewFieldValidator(validator.WithTimestampPolicy(policy))
+	invalid = 0
+	for _, record := range records[1:] {
+		if validateRecord(permissive, record) != nil {
+			invalid++
+		}
+	}
+	if invalid != summary.Invalid {
+		t.Errorf("Expected %d invalid rows under a permissive timestamp policy, got %d", summary.Invalid, invalid)
+	}
+	if duplicates < summary.Duplicates {
+		t.Errorf("Expected at least %d duplicate rows, got %d", summary.Duplicates, duplicates)
+	}
+	if len(names) > cfg.Counterparties {
+		t.Errorf("Expected at most %d counterparties, got %d", cfg.Counterparties, len(names))
+	}
+}
+
+// validateRecord checks a record the way uploads do
+func validateRecord(fv *validator.FieldValidator, record []string) error {
+	if err := fv.ValidateFieldCount(record); err != nil {
+		return err
+	}
+	for i, validate := range []func(string) error{
+		fv.ValidateTimestamp, fv.ValidateName, fv.ValidateTransactionType, fv.ValidateAmount, fv.ValidateStatus, fv.ValidateDescription,
+	} {
+		if err := validate(record[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TestInsert tests that a dataset is stored as one batch and recorded in the audit log
+func TestInsert(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	ctx := requestmeta.WithMeta(context.Background(), requestmeta.Meta{Actor: "tester"})
+	cfg := testConfig(t, 2500)
+	cfg.DuplicateRate = 0.02
+
+	summary, err := uc.Insert(ctx, cfg)
+	if err != nil {
+		t.Fatalf("Insert failed: %v", err)
+	}
+
+	var batch schemas.UploadBatch
+	if err := db.First(&batch, "id = ?", summary.BatchID).Error; err != nil {
+		t.Fatalf("Expected the batch to be stored: %v", err)
+	}
+	if batch.Source != Source || batch.UploadedBy != "tester" || batch.TotalRecords != cfg.Rows {
+		t.Errorf("Unexpected batch: %+v", batch)
+	}
+
+	var count int64
+	db.Model(&schemas.Transaction{}).Where("batch_id = ?", summary.BatchID).Count(&count)
+	if count != int64(cfg.Rows) {
+		t.Errorf("Expected %d transactions, got %d", cfg.Rows, count)
+	}
+	var outside int64
+	db.Model(&schemas.Transaction{}).Where("timestamp < ? OR timestamp >= ?", cfg.Start.Unix(), cfg.End.Unix()).Count(&outside)
+	if outside != 0 {
+		t.Errorf("Expected all timestamps within the time span, got %d outside", outside)
+	}
+
+	var events int64
+	db.Model(&auditSchemas.AuditEvent{}).Where("action = ? AND target_id = ?", auditSchemas.ActionGenerate, summary.BatchID).Count(&events)
+	if events != 1 {
+		t.Errorf("Expected 1 audit event, got %d", events)
+	}
+
+	// Invalid rows cannot be stored
+	cfg.InvalidRate = 0.1
+	if _, err := uc.Insert(ctx, cfg); !errors.Is(err, schemas.ErrInvalidGeneratorConfig) {
+		t.Errorf("Expected ErrInvalidGeneratorConfig, got %v", err)
+	}
+}
+
+// TestInsertRollsBack tests that a dataset whose audit event cannot be written is not stored
+func TestInsertRollsBack(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	if err := db.Migrator().DropTable(&auditSchemas.AuditEvent{}); err != nil {
+		t.Fatalf("failed to drop audit table: %v", err)
+	}
+
+	if _, err := uc.Insert(context.Background(), testConfig(t, 100)); err == nil {
+		t.Fatal("Expected the insert to fail without an audit log")
+	}
+
+	var batches, transactions int64
+	db.Model(&schemas.UploadBatch{}).Count(&batches)
+	db.Model(&schemas.Transaction{}).Count(&transactions)
+	if batches != 0 || transactions != 0 {
+		t.Errorf("Expected nothing stored, got %d batches and %d transactions", batches, transactions)
+	}
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+)
+
+// resultSuffix names the result sidecar of an ingested file
+const resultSuffix = ".result.json"
+
+// Init creates the inbox directory and its subdirectories
+func (r *Repository) Init() error {
+	for _, dir := range []string{ProcessingDir, ProcessedDir, FailedDir} {
+		if err := os.MkdirAll(filepath.Join(r.Dir, dir), 0o750); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Claim moves a file from the inbox to processing/
+// The rename is atomic, so a file is ingested once even if it is claimed twice; the second claim fails with fs.ErrNotExist
+func (r *Repository) Claim(name string) error {
+	return os.Rename(filepath.Join(r.Dir, name), filepath.Join(r.Dir, ProcessingDir, name))
+}
+
+// Finish moves a claimed file to processed/ or failed/, depending on the result status, and writes the result next to it
+// A file whose name is taken there is renamed with a counter, as in report-1.csv; the name used is returned
+func (r *Repository) Finish(name string, result schemas.InboxResult) (string, error) {
+	dir := filepath.Join(r.Dir, ProcessedDir)
+	if result.Status == schemas.InboxStatusFailed {
+		dir = filepath.Join(r.Dir, FailedDir)
+	}
+
+	target, err := freeName(dir, name)
+	if err != nil {
+		return "", err
+	}
+	if err := os.Rename(filepath.Join(r.Dir, ProcessingDir, name), filepath.Join(dir, target)); err != nil {
+		return "", err
+	}
+
+	content, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return target, err
+	}
+
+	// Write the sidecar under a temporary name, so it never appears half written
+	sidecar := filepath.Join(dir, target+resultSuffix)
+	if err := os.WriteFile(sidecar+".tmp", content, 0o640); err != nil {
+		return target, err
+	}
+	return target, os.Rename(sidecar+".tmp", sidecar)
+}
+
+// freeName returns name, or name with a counter before its extensions, such that neither it nor its sidecar exists in dir
+func freeName(dir, name string) (string, error) {
+	base, ext, _ := strings.Cut(name, ".")
+	if ext != "" {
+		ext = "." + ext
+	}
+
+	candidate := name
+	for i := 1; ; i++ {
+		taken := false
+		for _, path := range []string{candidate, candidate + resultSuffix} {
+			_, err := os.Stat(filepath.Join(dir, path))
+			if err == nil {
+				taken = true
+			} else if !errors.Is(err, fs.ErrNotExist) {
+				return "", err
+			}
+		}
+		if !taken {
+			return candidate, nil
+		}
+		candidate = fmt.Sprintf("%s-%d%s", base, i, ext)
+	}
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+)
+
+// temporarySuffixes mark files that are still being written, as SFTP and sync clients name them until the transfer ends
+var temporarySuffixes = []string{".part", ".partial", ".filepart", ".tmp"}
+
+// List returns the files waiting in the inbox, leaving out subdirectories, hidden files and files still being transferred
+func (r *Repository) List() ([]schemas.InboxFile, error) {
+	entries, err := os.ReadDir(r.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []schemas.InboxFile
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || !waiting(entry.Name()) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			// Removed or renamed since the directory was read
+			continue
+		}
+		files = append(files, schemas.InboxFile{
+			Name:    entry.Name(),
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+		})
+	}
+	return files, nil
+}
+
+// ListClaimed returns the names of the files in processing/
+func (r *Repository) ListClaimed() ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(r.Dir, ProcessingDir))
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.Type().IsRegular() {
+			names = append(names, entry.Name())
+		}
+	}
+	return names, nil
+}
+
+// Open opens a claimed file for reading
+func (r *Repository) Open(name string) (*os.File, error) {
+	return os.Open(filepath.Join(r.Dir, ProcessingDir, name))
+}
+
+// waiting reports whether a file in the inbox is ready to be picked up by its name
+func waiting(name string) bool {
+	if strings.HasPrefix(name, ".") || strings.HasPrefix(name, "~") {
+		return false
+	}
+	lower := strings.ToLower(name)
+	for _, suffix := range temporarySuffixes {
+		if strings.HasSuffix(lower, suffix) {
+			return false
+		}
+	}
+	return true
+}
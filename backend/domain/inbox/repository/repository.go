@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"os"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+)
+
+// Subdirectories of the inbox directory
+const (
+	ProcessingDir = "processing"
+	ProcessedDir  = "processed"
+	FailedDir     = "failed"
+)
+
+// IRepository defines the contract for inbox directory operations
+// New files are dropped in the inbox directory itself and moved through its subdirectories as they are ingested
+type IRepository interface {
+	// Commands
+	Init() error
+	Claim(name string) error
+	Finish(name string, result schemas.InboxResult) (string, error)
+
+	// Queries
+	List() ([]schemas.InboxFile, error)
+	ListClaimed() ([]string, error)
+	Open(name string) (*os.File, error)
+}
+
+// Repository implements IRepository
+type Repository struct {
+	Dir string
+}
+
+// NewRepository creates a new inbox repository instance for the directory dir
+func NewRepository(dir string) IRepository {
+	return &Repository{
+		Dir: dir,
+	}
+}
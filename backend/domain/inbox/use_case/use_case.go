@@ -0,0 +1,162 @@
+package use_case
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/inbox/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/requestmeta"
+	"github.com/google/uuid"
+)
+
+// Actor is recorded in the audit log for files ingested from the inbox
+const Actor = "inbox"
+
+// interruptedError is the result of a file left in processing/ when the worker stopped
+const interruptedError = "ingestion was interrupted before it finished; check the upload batches before dropping the file again"
+
+// StoreFunc parses and stores a file the way POST /api/upload does and returns the upload result
+type StoreFunc func(ctx context.Context, filename string, src *os.File, size int64) (interface{}, error)
+
+// IUseCase defines the contract for inbox ingestion use case operations
+type IUseCase interface {
+	Recover(ctx context.Context) ([]schemas.InboxResult, error)
+	Poll(ctx context.Context) ([]schemas.InboxResult, error)
+}
+
+// UseCase implements IUseCase
+type UseCase struct {
+	Repository repository.IRepository
+	store      StoreFunc
+	now        func() time.Time
+	mu         sync.Mutex
+	seen       map[string]schemas.InboxFile
+}
+
+// NewUseCase creates a new inbox ingestion use case instance storing files with store
+func NewUseCase(repo repository.IRepository, store StoreFunc) IUseCase {
+	return &UseCase{
+		Repository: repo,
+		store:      store,
+		now:        time.Now,
+		seen:       make(map[string]schemas.InboxFile),
+	}
+}
+
+// Recover prepares the inbox directory and moves files left in processing/ by a previous run to failed/
+// Such files may have been partly stored, so they are not ingested again without someone checking
+func (uc *UseCase) Recover(ctx context.Context) ([]schemas.InboxResult, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if err := uc.Repository.Init(); err != nil {
+		return nil, err
+	}
+	names, err := uc.Repository.ListClaimed()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []schemas.InboxResult
+	for _, name := range names {
+		now := uc.now().UTC()
+		result := schemas.InboxResult{
+			Filename:   name,
+			Status:     schemas.InboxStatusFailed,
+			Error:      interruptedError,
+			StartedAt:  now,
+			FinishedAt: now,
+		}
+		if _, err := uc.Repository.Finish(name, result); err != nil {
+			return results, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// Poll ingests the files in the inbox whose size and modification time have not changed since the previous poll,
+// so files still being written are left for a later poll, and returns a result per file ingested
+func (uc *UseCase) Poll(ctx context.Context) ([]schemas.InboxResult, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	files, err := uc.Repository.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var ready []string
+	seen := make(map[string]schemas.InboxFile, len(files))
+	for _, file := range files {
+		previous, ok := uc.seen[file.Name]
+		if ok && previous.Size == file.Size && previous.ModTime.Equal(file.ModTime) {
+			ready = append(ready, file.Name)
+			continue
+		}
+		seen[file.Name] = file
+	}
+	uc.seen = seen
+
+	var results []schemas.InboxResult
+	for _, name := range ready {
+		if err := uc.Repository.Claim(name); err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				// Removed, or claimed by another worker, since it was listed
+				continue
+			}
+			return results, err
+		}
+
+		result, err := uc.ingest(ctx, name)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// ingest stores a claimed file and moves it to processed/ or failed/ with its result
+func (uc *UseCase) ingest(ctx context.Context, name string) (schemas.InboxResult, error) {
+	result := schemas.InboxResult{
+		Filename:  name,
+		Status:    schemas.InboxStatusProcessed,
+		StartedAt: uc.now().UTC(),
+	}
+
+	ctx = requestmeta.WithMeta(ctx, requestmeta.Meta{Actor: Actor, RequestID: uuid.New().String()})
+	response, err := uc.storeFile(ctx, name)
+	if err != nil {
+		result.Status = schemas.InboxStatusFailed
+		result.Error = err.Error()
+	} else {
+		result.Result = response
+	}
+	result.FinishedAt = uc.now().UTC()
+
+	if _, err := uc.Repository.Finish(name, result); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// storeFile opens a claimed file and stores it
+func (uc *UseCase) storeFile(ctx context.Context, name string) (interface{}, error) {
+	file, err := uc.Repository.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return uc.store(ctx, name, file, info.Size())
+}
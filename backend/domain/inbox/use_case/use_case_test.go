@@ -0,0 +1,161 @@
+package use_case
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/inbox/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/requestmeta"
+)
+
+// setupTestUseCase creates a use case for a temporary inbox directory whose store function
+// fails for files containing "bad" and otherwise returns the file content
+func setupTestUseCase(t *testing.T) (IUseCase, string) {
+	dir := t.TempDir()
+	store := func(ctx context.Context, filename string, src *os.File, size int64) (interface{}, error) {
+		if actor := requestmeta.FromContext(ctx).Actor; actor != Actor {
+			t.Errorf("Expected actor %q, got %q", Actor, actor)
+		}
+		content, err := io.ReadAll(src)
+		if err != nil {
+			return nil, err
+		}
+		if strings.Contains(string(content), "bad") {
+			return nil, errors.New("invalid file")
+		}
+		return map[string]interface{}{"content": string(content), "size": size}, nil
+	}
+
+	uc := NewUseCase(repository.NewRepository(dir), store)
+	if _, err := uc.Recover(context.Background()); err != nil {
+		t.Fatalf("Recover failed: %v", err)
+	}
+	return uc, dir
+}
+
+func writeFile(t *testing.T, path, content string) {
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+}
+
+func readResult(t *testing.T, path string) schemas.InboxResult {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read result %s: %v", path, err)
+	}
+	var result schemas.InboxResult
+	if err := json.Unmarshal(content, &result); err != nil {
+		t.Fatalf("failed to parse result %s: %v", path, err)
+	}
+	return result
+}
+
+// TestPoll tests that files are ingested once unchanged between polls and moved with their results
+func TestPoll(t *testing.T) {
+	uc, dir := setupTestUseCase(t)
+	ctx := context.Background()
+
+	writeFile(t, filepath.Join(dir, "good.csv"), "good")
+	writeFile(t, filepath.Join(dir, "broken.csv"), "bad")
+	writeFile(t, filepath.Join(dir, "upload.csv.part"), "partial")
+	writeFile(t, filepath.Join(dir, ".hidden.csv"), "hidden")
+
+	// The first poll only notes the files
+	results, err := uc.Poll(ctx)
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if len(results) != 0 {
+		t.Fatalf("Expected no files ingested on the first poll, got %+v", results)
+	}
+
+	// A file still growing waits for another poll
+	writeFile(t, filepath.Join(dir, "growing.csv"), "start")
+	later := time.Now().Add(time.Second)
+	writeFile(t, filepath.Join(dir, "broken.csv"), "still bad")
+	os.Chtimes(filepath.Join(dir, "broken.csv"), later, later)
+
+	results, err = uc.Poll(ctx)
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if len(results) != 1 || results[0].Filename != "good.csv" || results[0].Status != schemas.InboxStatusProcessed {
+		t.Fatalf("Expected good.csv processed, got %+v", results)
+	}
+
+	result := readResult(t, filepath.Join(dir, repository.ProcessedDir, "good.csv.result.json"))
+	if result.Status != schemas.InboxStatusProcessed || result.Result == nil || result.Error != "" {
+		t.Errorf("Unexpected result sidecar: %+v", result)
+	}
+	if _, err := os.Stat(filepath.Join(dir, repository.ProcessedDir, "good.csv")); err != nil {
+		t.Errorf("Expected good.csv in processed/: %v", err)
+	}
+
+	results, err = uc.Poll(ctx)
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("Expected broken.csv and growing.csv ingested, got %+v", results)
+	}
+
+	result = readResult(t, filepath.Join(dir, repository.FailedDir, "broken.csv.result.json"))
+	if result.Status != schemas.InboxStatusFailed || result.Error != "invalid file" {
+		t.Errorf("Unexpected result sidecar: %+v", result)
+	}
+
+	// Temporary and hidden files are never picked up
+	for _, name := range []string{"upload.csv.part", ".hidden.csv"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("Expected %s to stay in the inbox: %v", name, err)
+		}
+	}
+}
+
+// TestPollNameTaken tests that a file dropped again under the same name does not overwrite the earlier one
+func TestPollNameTaken(t *testing.T) {
+	uc, dir := setupTestUseCase(t)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		writeFile(t, filepath.Join(dir, "daily.csv.gz"), "good")
+		uc.Poll(ctx)
+		if _, err := uc.Poll(ctx); err != nil {
+			t.Fatalf("Poll failed: %v", err)
+		}
+	}
+
+	for _, name := range []string{"daily.csv.gz", "daily.csv.gz.result.json", "daily-1.csv.gz", "daily-1.csv.gz.result.json"} {
+		if _, err := os.Stat(filepath.Join(dir, repository.ProcessedDir, name)); err != nil {
+			t.Errorf("Expected %s in processed/: %v", name, err)
+		}
+	}
+}
+
+// TestRecover tests that files left in processing/ are moved to failed/ without being stored again
+func TestRecover(t *testing.T) {
+	uc, dir := setupTestUseCase(t)
+
+	writeFile(t, filepath.Join(dir, repository.ProcessingDir, "interrupted.csv"), "good")
+	results, err := uc.Recover(context.Background())
+	if err != nil {
+		t.Fatalf("Recover failed: %v", err)
+	}
+	if len(results) != 1 || results[0].Status != schemas.InboxStatusFailed {
+		t.Fatalf("Expected interrupted.csv failed, got %+v", results)
+	}
+
+	result := readResult(t, filepath.Join(dir, repository.FailedDir, "interrupted.csv.result.json"))
+	if result.Error != interruptedError || result.Result != nil {
+		t.Errorf("Unexpected result sidecar: %+v", result)
+	}
+}
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+)
+
+// errorResponse maps use case errors to an error response, falling back to a 500 with the given message
+func errorResponse(err error, fallbackMessage string) schemas.ErrorResponse {
+	status := http.StatusInternalServerError
+	message := fallbackMessage
+
+	switch {
+	case errors.Is(err, schemas.ErrSuggestionNotFound):
+		status, message = http.StatusNotFound, constants.MsgLinkSuggestionNotFound
+	case errors.Is(err, schemas.ErrTransactionNotFound):
+		status, message = http.StatusNotFound, constants.MsgTransactionNotFound
+	case errors.Is(err, schemas.ErrSuggestionResolved):
+		status, message = http.StatusConflict, constants.MsgLinkSuggestionResolved
+	case errors.Is(err, schemas.ErrInvalidLink):
+		status, message = http.StatusBadRequest, constants.MsgInvalidLink
+	}
+
+	return schemas.ErrorResponse{
+		Status:  status,
+		Message: message,
+		Error:   err.Error(),
+	}
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"net/http"
+
+	transactionHandler "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/handler"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ListSuggestions returns link suggestions with both transactions, best score first
+func (h *Handler) ListSuggestions(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "ListSuggestions"),
+	)
+
+	page, pageSize, errResp := transactionHandler.ParsePagination(c.Query, h.FieldValidator)
+	if errResp != nil {
+		l.Warn("Invalid pagination parameters", logger.String("error", errResp.Error))
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	response, err := h.UseCase.ListSuggestions(c.Context(), c.Query("status"), page, pageSize)
+	if err != nil {
+		l.Warn("Failed to retrieve link suggestions", logger.Error(err))
+		errResp := errorResponse(err, constants.MsgFailedToRetrieveLinks)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
+
+// SuggestLinks suggests the original transaction of every unlinked credit
+func (h *Handler) SuggestLinks(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "SuggestLinks"),
+	)
+
+	response, err := h.UseCase.SuggestAll(c.Context())
+	if err != nil {
+		l.Error("Failed to suggest links", logger.Error(err))
+		errResp := errorResponse(err, constants.MsgFailedToSuggestLinks)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Links suggested", logger.Int("suggested", response.Suggested))
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"time"
+
+	auditRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/repository"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	linkRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/link/repository"
+	linkUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/link/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/config"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/deps"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+)
+
+const ContextName = "Domain.Link.Handler"
+
+// Handler defines the refund link handlers
+type Handler struct {
+	Logger         *logger.Logger
+	UseCase        linkUseCase.IUseCase
+	FieldValidator *validator.FieldValidator
+}
+
+// NewHandler creates a new link handler instance with all dependencies
+func NewHandler(d *deps.App) *Handler {
+	// Initialize repository
+	repository := linkRepo.NewRepository(d.DB.GetDB())
+
+	// Initialize use case
+	audit := auditUseCase.NewUseCase(auditRepo.NewRepository(d.DB.GetDB()))
+	cfg := config.GetConfig()
+	window := time.Duration(cfg.LinkWindowDays) * 24 * time.Hour
+	reversalWindow := time.Duration(cfg.LinkReversalHours) * time.Hour
+	useCase := linkUseCase.NewUseCase(repository, audit, window, reversalWindow)
+
+	return &Handler{
+		Logger:         d.Logger,
+		UseCase:        useCase,
+		FieldValidator: d.FieldValidator,
+	}
+}
+
+// RegisterApi registers link API routes
+func RegisterApi(d *deps.App) *Handler {
+	handler := NewHandler(d)
+
+	api := d.Fiber.Group("/api")
+
+	api.Get("/links/suggestions", handler.ListSuggestions)
+	api.Post("/links/suggest", handler.SuggestLinks)
+	api.Post("/links/suggestions/:id/confirm", handler.ConfirmSuggestion)
+	api.Post("/links/suggestions/:id/reject", handler.RejectSuggestion)
+	api.Post("/links", handler.CreateLink)
+	api.Delete("/links/:transaction_id", handler.DeleteLink)
+
+	return handler
+}
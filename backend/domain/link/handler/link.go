@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// CreateLink links a transaction to the original transaction it pays back
+func (h *Handler) CreateLink(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "CreateLink"),
+	)
+
+	var req schemas.LinkRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.Warn("Invalid request body", logger.Error(err))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidLink,
+			Error:   err.Error(),
+		})
+	}
+
+	transaction, err := h.UseCase.CreateLink(c.Context(), req)
+	if err != nil {
+		l.Warn("Failed to link transaction", logger.Error(err))
+		errResp := errorResponse(err, constants.MsgFailedToSaveLink)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Transaction linked", logger.String("id", transaction.ID), logger.String("related_id", req.RelatedTransactionID))
+
+	return c.Status(http.StatusCreated).JSON(schemas.SuccessResponse{
+		Status: http.StatusCreated,
+		Data:   transaction,
+	})
+}
+
+// DeleteLink removes the link of a transaction
+func (h *Handler) DeleteLink(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "DeleteLink"),
+	)
+
+	id := c.Params("transaction_id")
+	transaction, err := h.UseCase.DeleteLink(c.Context(), id)
+	if err != nil {
+		l.Warn("Failed to unlink transaction", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToSaveLink)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Transaction unlinked", logger.String("id", id))
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   transaction,
+	})
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ConfirmSuggestion links the transactions of a suggestion, optionally with another relation type
+func (h *Handler) ConfirmSuggestion(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "ConfirmSuggestion"),
+	)
+
+	var req schemas.ConfirmSuggestionRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			l.Warn("Invalid request body", logger.Error(err))
+			return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+				Status:  http.StatusBadRequest,
+				Message: constants.MsgInvalidLink,
+				Error:   err.Error(),
+			})
+		}
+	}
+
+	id := c.Params("id")
+	suggestion, err := h.UseCase.ConfirmSuggestion(c.Context(), id, req)
+	if err != nil {
+		l.Warn("Failed to confirm link suggestion", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToSaveLink)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Link suggestion confirmed", logger.String("id", id))
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   suggestion,
+	})
+}
+
+// RejectSuggestion rejects a suggestion so its pair is not suggested again
+func (h *Handler) RejectSuggestion(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "RejectSuggestion"),
+	)
+
+	id := c.Params("id")
+	suggestion, err := h.UseCase.RejectSuggestion(c.Context(), id)
+	if err != nil {
+		l.Warn("Failed to reject link suggestion", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToSaveLink)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Link suggestion rejected", logger.String("id", id))
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   suggestion,
+	})
+}
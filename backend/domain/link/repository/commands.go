@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreateSuggestions stores link suggestions, skipping pairs that were suggested before
+// Returns how many suggestions were new
+func (r *Repository) CreateSuggestions(ctx context.Context, suggestions []schemas.LinkSuggestion) (int64, error) {
+	if len(suggestions) == 0 {
+		return 0, nil
+	}
+
+	result := db.Conn(ctx, r.DB).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		CreateInBatches(suggestions, 100)
+	return result.RowsAffected, result.Error
+}
+
+// ConfirmSuggestion marks a suggestion confirmed, links its transactions and rejects
+// the other pending suggestions for the same transaction
+func (r *Repository) ConfirmSuggestion(ctx context.Context, suggestion *schemas.LinkSuggestion) error {
+	return db.Conn(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		if err := resolve(tx, suggestion); err != nil {
+			return err
+		}
+
+		err := tx.Model(&schemas.LinkSuggestion{}).
+			Where("transaction_id = ? AND id <> ? AND status = ?", suggestion.TransactionID, suggestion.ID, schemas.SuggestionPending).
+			Updates(map[string]interface{}{
+				"status":      schemas.SuggestionRejected,
+				"resolved_by": suggestion.ResolvedBy,
+				"resolved_at": suggestion.ResolvedAt,
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		return link(tx, suggestion.TransactionID, suggestion.RelatedTransactionID, suggestion.RelationType)
+	})
+}
+
+// RejectSuggestion marks a suggestion rejected so the pair is not suggested again
+func (r *Repository) RejectSuggestion(ctx context.Context, suggestion *schemas.LinkSuggestion) error {
+	return resolve(db.Conn(ctx, r.DB), suggestion)
+}
+
+// Link points a transaction at the original transaction it pays back
+func (r *Repository) Link(ctx context.Context, transactionID string, relatedTransactionID string, relationType string) error {
+	return link(db.Conn(ctx, r.DB), transactionID, relatedTransactionID, relationType)
+}
+
+// Unlink removes the link of a transaction
+func (r *Repository) Unlink(ctx context.Context, transactionID string) error {
+	result := db.Conn(ctx, r.DB).
+		Model(&schemas.Transaction{}).
+		Where("id = ?", transactionID).
+		Updates(map[string]interface{}{
+			"related_transaction_id": nil,
+			"relation_type":          "",
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return schemas.ErrTransactionNotFound
+	}
+	return nil
+}
+
+// Transaction runs fn in one database transaction; repository calls made with the context it receives join it
+func (r *Repository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return db.Transaction(ctx, r.DB, fn)
+}
+
+// resolve stores the status and resolver of a suggestion
+func resolve(tx *gorm.DB, suggestion *schemas.LinkSuggestion) error {
+	return tx.Model(suggestion).Updates(map[string]interface{}{
+		"status":        suggestion.Status,
+		"relation_type": suggestion.RelationType,
+		"resolved_by":   suggestion.ResolvedBy,
+		"resolved_at":   suggestion.ResolvedAt,
+	}).Error
+}
+
+// link sets the related transaction and relation type of a transaction
+func link(tx *gorm.DB, transactionID string, relatedTransactionID string, relationType string) error {
+	result := tx.Model(&schemas.Transaction{}).
+		Where("id = ?", transactionID).
+		Updates(map[string]interface{}{
+			"related_transaction_id": relatedTransactionID,
+			"relation_type":          relationType,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return schemas.ErrTransactionNotFound
+	}
+	return nil
+}
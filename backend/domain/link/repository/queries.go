@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+	"gorm.io/gorm"
+)
+
+// FindSuggestion retrieves a link suggestion by ID
+func (r *Repository) FindSuggestion(ctx context.Context, id string) (*schemas.LinkSuggestion, error) {
+	var suggestion schemas.LinkSuggestion
+	if err := db.Conn(ctx, r.DB).Where("id = ?", id).First(&suggestion).Error; err != nil {
+		return nil, err
+	}
+	return &suggestion, nil
+}
+
+// suggestionsQuery builds the query for suggestions, optionally of one status
+func (r *Repository) suggestionsQuery(ctx context.Context, status string) *gorm.DB {
+	query := db.Conn(ctx, r.DB).Model(&schemas.LinkSuggestion{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	return query
+}
+
+// FindSuggestions retrieves a page of suggestions, best scores first
+func (r *Repository) FindSuggestions(ctx context.Context, status string, page int, pageSize int) ([]schemas.LinkSuggestion, int64, error) {
+	var total int64
+	if err := r.suggestionsQuery(ctx, status).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var suggestions []schemas.LinkSuggestion
+	err := r.suggestionsQuery(ctx, status).
+		Order("score DESC, created_at ASC, id ASC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&suggestions).Error
+	return suggestions, total, err
+}
+
+// FindTransactions retrieves live transactions by ID, keyed by ID
+func (r *Repository) FindTransactions(ctx context.Context, ids []string) (map[string]*schemas.Transaction, error) {
+	found := make(map[string]*schemas.Transaction, len(ids))
+	if len(ids) == 0 {
+		return found, nil
+	}
+
+	var transactions []schemas.Transaction
+	if err := db.Conn(ctx, r.DB).Where("id IN ?", ids).Find(&transactions).Error; err != nil {
+		return nil, err
+	}
+
+	for i := range transactions {
+		found[transactions[i].ID] = &transactions[i]
+	}
+	return found, nil
+}
+
+// FindUnlinkedCredits retrieves SUCCESS and PENDING credits of a counterparty that are not linked yet
+func (r *Repository) FindUnlinkedCredits(ctx context.Context) ([]schemas.Transaction, error) {
+	var transactions []schemas.Transaction
+	err := db.Conn(ctx, r.DB).
+		Where("type = ? AND status IN (?, ?)", schemas.TypeCredit, schemas.StatusSuccess, schemas.StatusPending).
+		Where("related_transaction_id IS NULL AND counterparty_id IS NOT NULL").
+		Order("timestamp ASC").
+		Find(&transactions).Error
+	return transactions, err
+}
+
+// FindOriginals retrieves the SUCCESS debits of a counterparty between two timestamps, both inclusive
+func (r *Repository) FindOriginals(ctx context.Context, counterpartyID string, from int64, to int64) ([]schemas.Transaction, error) {
+	var transactions []schemas.Transaction
+	err := db.Conn(ctx, r.DB).
+		Where("counterparty_id = ? AND type = ? AND status = ?", counterpartyID, schemas.TypeDebit, schemas.StatusSuccess).
+		Where("timestamp BETWEEN ? AND ?", from, to).
+		Order("timestamp DESC").
+		Find(&transactions).Error
+	return transactions, err
+}
+
+// SumLinkedAmounts sums the amounts already linked to each of the given transactions
+// FAILED transactions paid nothing back and are not counted
+func (r *Repository) SumLinkedAmounts(ctx context.Context, relatedTransactionIDs []string) (map[string]int64, error) {
+	sums := make(map[string]int64, len(relatedTransactionIDs))
+	if len(relatedTransactionIDs) == 0 {
+		return sums, nil
+	}
+
+	var rows []struct {
+		RelatedTransactionID string
+		Total                int64
+	}
+	err := db.Conn(ctx, r.DB).
+		Model(&schemas.Transaction{}).
+		Select("related_transaction_id, COALESCE(SUM(amount), 0) AS total").
+		Where("related_transaction_id IN ? AND status <> ?", relatedTransactionIDs, schemas.StatusFailed).
+		Group("related_transaction_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		sums[row.RelatedTransactionID] = row.Total
+	}
+	return sums, nil
+}
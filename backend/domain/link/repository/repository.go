@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/gorm"
+)
+
+// IRepository defines the contract for link repository operations
+type IRepository interface {
+	// Commands
+	CreateSuggestions(ctx context.Context, suggestions []schemas.LinkSuggestion) (int64, error)
+	ConfirmSuggestion(ctx context.Context, suggestion *schemas.LinkSuggestion) error
+	RejectSuggestion(ctx context.Context, suggestion *schemas.LinkSuggestion) error
+	Link(ctx context.Context, transactionID string, relatedTransactionID string, relationType string) error
+	Unlink(ctx context.Context, transactionID string) error
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+
+	// Queries
+	FindSuggestion(ctx context.Context, id string) (*schemas.LinkSuggestion, error)
+	FindSuggestions(ctx context.Context, status string, page int, pageSize int) ([]schemas.LinkSuggestion, int64, error)
+	FindTransactions(ctx context.Context, ids []string) (map[string]*schemas.Transaction, error)
+	FindUnlinkedCredits(ctx context.Context) ([]schemas.Transaction, error)
+	FindOriginals(ctx context.Context, counterpartyID string, from int64, to int64) ([]schemas.Transaction, error)
+	SumLinkedAmounts(ctx context.Context, relatedTransactionIDs []string) (map[string]int64, error)
+}
+
+// Repository implements IRepository
+type Repository struct {
+	DB *gorm.DB
+}
+
+// NewRepository creates a new link repository instance
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
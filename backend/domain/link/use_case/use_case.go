@@ -0,0 +1,434 @@
+package use_case
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	auditSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/link/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/requestmeta"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// IUseCase defines the contract for link use case operations
+type IUseCase interface {
+	Suggest(ctx context.Context, transactions []schemas.Transaction) (int, error)
+	SuggestAll(ctx context.Context) (*schemas.SuggestLinksResponse, error)
+	ListSuggestions(ctx context.Context, status string, page int, pageSize int) (*schemas.LinkSuggestionsResponse, error)
+	ConfirmSuggestion(ctx context.Context, id string, req schemas.ConfirmSuggestionRequest) (*schemas.LinkSuggestion, error)
+	RejectSuggestion(ctx context.Context, id string) (*schemas.LinkSuggestion, error)
+	CreateLink(ctx context.Context, req schemas.LinkRequest) (*schemas.Transaction, error)
+	DeleteLink(ctx context.Context, transactionID string) (*schemas.Transaction, error)
+}
+
+// UseCase implements IUseCase
+type UseCase struct {
+	Repository     repository.IRepository
+	Audit          auditUseCase.IUseCase
+	Window         time.Duration
+	ReversalWindow time.Duration
+	Now            func() time.Time
+}
+
+// NewUseCase creates a new link use case instance
+// window is how long after a debit a credit may still pay it back; a full amount paid back
+// within reversalWindow is suggested as a reversal rather than a refund
+func NewUseCase(repo repository.IRepository, audit auditUseCase.IUseCase, window time.Duration, reversalWindow time.Duration) IUseCase {
+	return &UseCase{
+		Repository:     repo,
+		Audit:          audit,
+		Window:         window,
+		ReversalWindow: reversalWindow,
+		Now:            time.Now,
+	}
+}
+
+// Suggest looks for the original debits of the unlinked credits among the given transactions
+// and stores a suggestion for the best candidate of each; returns how many suggestions are new
+func (uc *UseCase) Suggest(ctx context.Context, transactions []schemas.Transaction) (int, error) {
+	var credits []schemas.Transaction
+	for _, t := range transactions {
+		if t.Type == schemas.TypeCredit && t.Status != schemas.StatusFailed && t.CounterpartyID != nil && t.RelatedTransactionID == nil {
+			credits = append(credits, t)
+		}
+	}
+	return uc.suggest(ctx, credits)
+}
+
+// SuggestAll looks for the original debits of every unlinked credit
+func (uc *UseCase) SuggestAll(ctx context.Context) (*schemas.SuggestLinksResponse, error) {
+	credits, err := uc.Repository.FindUnlinkedCredits(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	suggested, err := uc.suggest(ctx, credits)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schemas.SuggestLinksResponse{
+		Message:   constants.MsgLinksSuggested,
+		Suggested: suggested,
+	}, nil
+}
+
+// suggest pairs each credit with the earlier debit of the same counterparty that it most
+// likely pays back: the amount must fit in what the debit has not had paid back yet,
+// and closer amounts and times score higher
+func (uc *UseCase) suggest(ctx context.Context, credits []schemas.Transaction) (int, error) {
+	now := uc.Now().UTC()
+	window := int64(uc.Window.Seconds())
+	claimed := make(map[string]int64)
+
+	var suggestions []schemas.LinkSuggestion
+	for _, credit := range credits {
+		originals, err := uc.Repository.FindOriginals(ctx, *credit.CounterpartyID, credit.Timestamp-window, credit.Timestamp)
+		if err != nil {
+			return 0, err
+		}
+
+		ids := make([]string, len(originals))
+		for i, o := range originals {
+			ids[i] = o.ID
+		}
+		linked, err := uc.Repository.SumLinkedAmounts(ctx, ids)
+		if err != nil {
+			return 0, err
+		}
+
+		var best *schemas.Transaction
+		bestScore := -1.0
+		for i := range originals {
+			original := &originals[i]
+			if credit.Amount > original.Amount-linked[original.ID]-claimed[original.ID] {
+				continue
+			}
+			if score := uc.score(&credit, original); score > bestScore {
+				best, bestScore = original, score
+			}
+		}
+		if best == nil {
+			continue
+		}
+
+		claimed[best.ID] += credit.Amount
+		suggestions = append(suggestions, schemas.LinkSuggestion{
+			ID:                   uuid.New().String(),
+			TransactionID:        credit.ID,
+			RelatedTransactionID: best.ID,
+			RelationType:         uc.relationType(&credit, best),
+			Score:                bestScore,
+			Status:               schemas.SuggestionPending,
+			CreatedAt:            now,
+		})
+	}
+
+	created, err := uc.Repository.CreateSuggestions(ctx, suggestions)
+	return int(created), err
+}
+
+// score rates how likely a credit pays back a debit, from 0 to 1
+// Amount closeness and time proximity weigh equally
+func (uc *UseCase) score(credit, original *schemas.Transaction) float64 {
+	amount := float64(credit.Amount) / float64(original.Amount)
+	proximity := 1.0
+	if uc.Window > 0 {
+		proximity = 1 - float64(credit.Timestamp-original.Timestamp)/uc.Window.Seconds()
+	}
+	return math.Round((amount+proximity)/2*10000) / 10000
+}
+
+// relationType guesses the relation from the description, the amount and the delay
+func (uc *UseCase) relationType(credit, original *schemas.Transaction) string {
+	if strings.Contains(strings.ToLower(credit.Description), schemas.RelationChargeback) {
+		return schemas.RelationChargeback
+	}
+	if credit.Amount < original.Amount {
+		return schemas.RelationPartialRefund
+	}
+	if credit.Timestamp-original.Timestamp <= int64(uc.ReversalWindow.Seconds()) {
+		return schemas.RelationReversal
+	}
+	return schemas.RelationRefund
+}
+
+// ListSuggestions retrieves suggestions with both transactions, optionally of one status
+func (uc *UseCase) ListSuggestions(ctx context.Context, status string, page int, pageSize int) (*schemas.LinkSuggestionsResponse, error) {
+	if status != "" && !schemas.ValidSuggestionStatus(status) {
+		return nil, fmt.Errorf("%w: unknown status %q", schemas.ErrInvalidLink, status)
+	}
+
+	suggestions, total, err := uc.Repository.FindSuggestions(ctx, status, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, s := range suggestions {
+		ids = append(ids, s.TransactionID, s.RelatedTransactionID)
+	}
+	transactions, err := uc.Repository.FindTransactions(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]schemas.LinkSuggestionDetail, len(suggestions))
+	for i, s := range suggestions {
+		details[i] = schemas.LinkSuggestionDetail{
+			LinkSuggestion:     s,
+			Transaction:        transactions[s.TransactionID],
+			RelatedTransaction: transactions[s.RelatedTransactionID],
+		}
+	}
+
+	filtersMeta := make(map[string]interface{})
+	if status != "" {
+		filtersMeta["status"] = status
+	}
+
+	return &schemas.LinkSuggestionsResponse{
+		Message: constants.MsgLinkSuggestionsRetrieved,
+		Data:    details,
+		Meta: schemas.ResponseMeta{
+			Pagination: schemas.NewPaginationMeta(total, len(details), page, pageSize),
+			Filters:    filtersMeta,
+		},
+	}, nil
+}
+
+// ConfirmSuggestion links the transactions of a pending suggestion
+// The suggestion is loaded and checked in the same database transaction as the link and its audit event,
+// so two confirmations of one suggestion cannot both link it
+func (uc *UseCase) ConfirmSuggestion(ctx context.Context, id string, req schemas.ConfirmSuggestionRequest) (*schemas.LinkSuggestion, error) {
+	var suggestion *schemas.LinkSuggestion
+	err := uc.Repository.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		suggestion, err = uc.pendingSuggestion(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if req.RelationType != "" {
+			if !schemas.ValidRelationType(req.RelationType) {
+				return fmt.Errorf("%w: unknown relation type %q", schemas.ErrInvalidLink, req.RelationType)
+			}
+			suggestion.RelationType = req.RelationType
+		}
+
+		transaction, _, err := uc.linkable(ctx, suggestion.TransactionID, suggestion.RelatedTransactionID)
+		if err != nil {
+			return err
+		}
+
+		uc.resolve(ctx, suggestion, schemas.SuggestionConfirmed)
+		if err := uc.Repository.ConfirmSuggestion(ctx, suggestion); err != nil {
+			return err
+		}
+
+		return uc.recordLink(ctx, auditSchemas.ActionLinkConfirm, transaction, suggestion.RelatedTransactionID, suggestion.RelationType)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return suggestion, nil
+}
+
+// RejectSuggestion marks a pending suggestion rejected
+// The check, the change and the audit event run in one database transaction
+func (uc *UseCase) RejectSuggestion(ctx context.Context, id string) (*schemas.LinkSuggestion, error) {
+	var suggestion *schemas.LinkSuggestion
+	err := uc.Repository.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		suggestion, err = uc.pendingSuggestion(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		uc.resolve(ctx, suggestion, schemas.SuggestionRejected)
+		if err := uc.Repository.RejectSuggestion(ctx, suggestion); err != nil {
+			return err
+		}
+
+		return uc.Audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionLinkReject,
+			TargetType: auditSchemas.TargetTransaction,
+			TargetID:   suggestion.TransactionID,
+			After:      suggestion,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return suggestion, nil
+}
+
+// CreateLink links a transaction to the original transaction it pays back
+func (uc *UseCase) CreateLink(ctx context.Context, req schemas.LinkRequest) (*schemas.Transaction, error) {
+	if req.TransactionID == "" || req.RelatedTransactionID == "" {
+		return nil, fmt.Errorf("%w: transaction_id and related_transaction_id are required", schemas.ErrInvalidLink)
+	}
+	if !schemas.ValidRelationType(req.RelationType) {
+		return nil, fmt.Errorf("%w: relation_type must be one of %s, %s, %s, %s", schemas.ErrInvalidLink,
+			schemas.RelationRefund, schemas.RelationPartialRefund, schemas.RelationReversal, schemas.RelationChargeback)
+	}
+
+	// The checks and the link share a transaction, so concurrent links cannot pay back more than the original
+	var transaction *schemas.Transaction
+	err := uc.Repository.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		transaction, _, err = uc.linkable(ctx, req.TransactionID, req.RelatedTransactionID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.Repository.Link(ctx, req.TransactionID, req.RelatedTransactionID, req.RelationType); err != nil {
+			return err
+		}
+
+		return uc.recordLink(ctx, auditSchemas.ActionLinkCreate, transaction, req.RelatedTransactionID, req.RelationType)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	related := req.RelatedTransactionID
+	transaction.RelatedTransactionID = &related
+	transaction.RelationType = req.RelationType
+	return transaction, nil
+}
+
+// DeleteLink removes the link of a transaction
+// The check, the change and the audit event run in one database transaction
+func (uc *UseCase) DeleteLink(ctx context.Context, transactionID string) (*schemas.Transaction, error) {
+	var transaction *schemas.Transaction
+	err := uc.Repository.Transaction(ctx, func(ctx context.Context) error {
+		transactions, err := uc.Repository.FindTransactions(ctx, []string{transactionID})
+		if err != nil {
+			return err
+		}
+		var ok bool
+		transaction, ok = transactions[transactionID]
+		if !ok {
+			return schemas.ErrTransactionNotFound
+		}
+		if transaction.RelatedTransactionID == nil {
+			return fmt.Errorf("%w: transaction is not linked", schemas.ErrInvalidLink)
+		}
+
+		if err := uc.Repository.Unlink(ctx, transactionID); err != nil {
+			return err
+		}
+
+		before := *transaction
+		transaction.RelatedTransactionID = nil
+		transaction.RelationType = ""
+
+		return uc.Audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionLinkDelete,
+			TargetType: auditSchemas.TargetTransaction,
+			TargetID:   transactionID,
+			Before:     before,
+			After:      transaction,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// pendingSuggestion loads a suggestion that has not been confirmed or rejected yet
+func (uc *UseCase) pendingSuggestion(ctx context.Context, id string) (*schemas.LinkSuggestion, error) {
+	suggestion, err := uc.Repository.FindSuggestion(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, schemas.ErrSuggestionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if suggestion.Status != schemas.SuggestionPending {
+		return nil, fmt.Errorf("%w: %s", schemas.ErrSuggestionResolved, suggestion.Status)
+	}
+	return suggestion, nil
+}
+
+// linkable loads both transactions of a link and checks that they can be linked:
+// they must differ in type, the transaction must not be linked already, must not be older than
+// the original and must fit in what the original has not had paid back yet
+func (uc *UseCase) linkable(ctx context.Context, transactionID string, relatedTransactionID string) (*schemas.Transaction, *schemas.Transaction, error) {
+	if transactionID == relatedTransactionID {
+		return nil, nil, fmt.Errorf("%w: a transaction cannot be linked to itself", schemas.ErrInvalidLink)
+	}
+
+	transactions, err := uc.Repository.FindTransactions(ctx, []string{transactionID, relatedTransactionID})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transaction, ok := transactions[transactionID]
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %s", schemas.ErrTransactionNotFound, transactionID)
+	}
+	related, ok := transactions[relatedTransactionID]
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %s", schemas.ErrTransactionNotFound, relatedTransactionID)
+	}
+
+	if transaction.Type == related.Type {
+		return nil, nil, fmt.Errorf("%w: a %s cannot pay back another %s", schemas.ErrInvalidLink, transaction.Type, related.Type)
+	}
+	if transaction.RelatedTransactionID != nil {
+		return nil, nil, fmt.Errorf("%w: transaction is already linked to %s", schemas.ErrInvalidLink, *transaction.RelatedTransactionID)
+	}
+	if transaction.Timestamp < related.Timestamp {
+		return nil, nil, fmt.Errorf("%w: transaction is older than the transaction it pays back", schemas.ErrInvalidLink)
+	}
+
+	// FAILED transactions paid nothing back, so they always fit
+	if transaction.Status != schemas.StatusFailed {
+		linked, err := uc.Repository.SumLinkedAmounts(ctx, []string{relatedTransactionID})
+		if err != nil {
+			return nil, nil, err
+		}
+		if remaining := related.Amount - linked[relatedTransactionID]; transaction.Amount > remaining {
+			return nil, nil, fmt.Errorf("%w: amount %d exceeds the %d left to pay back", schemas.ErrInvalidLink, transaction.Amount, remaining)
+		}
+	}
+
+	return transaction, related, nil
+}
+
+// resolve sets the status and resolver of a suggestion
+func (uc *UseCase) resolve(ctx context.Context, suggestion *schemas.LinkSuggestion, status string) {
+	now := uc.Now().UTC()
+	suggestion.Status = status
+	suggestion.ResolvedBy = requestmeta.FromContext(ctx).Actor
+	suggestion.ResolvedAt = &now
+}
+
+// recordLink appends an audit event for a transaction that was linked
+func (uc *UseCase) recordLink(ctx context.Context, action string, transaction *schemas.Transaction, relatedTransactionID string, relationType string) error {
+	after := *transaction
+	after.RelatedTransactionID = &relatedTransactionID
+	after.RelationType = relationType
+
+	return uc.Audit.Record(ctx, auditSchemas.AuditEntry{
+		Action:     action,
+		TargetType: auditSchemas.TargetTransaction,
+		TargetID:   transaction.ID,
+		Before:     transaction,
+		After:      after,
+	})
+}
@@ -0,0 +1,230 @@
+package use_case
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	auditRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/repository"
+	auditSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/link/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupTestUseCase creates a use case backed by an in-memory SQLite database with a 90 day window
+func setupTestUseCase(t *testing.T) (IUseCase, *gorm.DB) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to setup test database: %v", err)
+	}
+
+	if err := db.AutoMigrate(&schemas.Transaction{}, &schemas.LinkSuggestion{}, &auditSchemas.AuditEvent{}); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	audit := auditUseCase.NewUseCase(auditRepo.NewRepository(db))
+	return NewUseCase(repository.NewRepository(db), audit, 90*24*time.Hour, 24*time.Hour), db
+}
+
+// storeTransactions inserts transactions of one counterparty
+func storeTransactions(t *testing.T, db *gorm.DB, transactions []schemas.Transaction) {
+	counterparty := "shop"
+	for i := range transactions {
+		transactions[i].CounterpartyID = &counterparty
+	}
+	if err := db.Create(&transactions).Error; err != nil {
+		t.Fatalf("failed to insert test data: %v", err)
+	}
+}
+
+// TestSuggestAll tests that credits are paired with the earlier debit they most likely pay back
+func TestSuggestAll(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+
+	day := int64(24 * 3600)
+	storeTransactions(t, db, []schemas.Transaction{
+		{ID: "order", Timestamp: 10 * day, Type: schemas.TypeDebit, Amount: 5000, Status: schemas.StatusSuccess},
+		{ID: "other", Timestamp: 12 * day, Type: schemas.TypeDebit, Amount: 900, Status: schemas.StatusSuccess},
+		{ID: "reversal", Timestamp: 12*day + 3600, Type: schemas.TypeCredit, Amount: 900, Status: schemas.StatusSuccess},
+		{ID: "partial", Timestamp: 13 * day, Type: schemas.TypeCredit, Amount: 400, Status: schemas.StatusSuccess},
+		{ID: "rest", Timestamp: 20 * day, Type: schemas.TypeCredit, Amount: 4600, Status: schemas.StatusSuccess},
+		{ID: "extra", Timestamp: 21 * day, Type: schemas.TypeCredit, Amount: 100, Status: schemas.StatusSuccess},
+		{ID: "salary", Timestamp: 5 * day, Type: schemas.TypeCredit, Amount: 100, Status: schemas.StatusSuccess},
+	})
+
+	response, err := uc.SuggestAll(context.Background())
+	if err != nil {
+		t.Fatalf("SuggestAll failed: %v", err)
+	}
+	if response.Suggested != 3 {
+		t.Fatalf("Expected 3 suggestions, got %d", response.Suggested)
+	}
+
+	var suggestions []schemas.LinkSuggestion
+	db.Find(&suggestions)
+	byTransaction := make(map[string]schemas.LinkSuggestion)
+	for _, s := range suggestions {
+		byTransaction[s.TransactionID] = s
+	}
+
+	// Once a debit is fully paid back, later credits are not suggested for it
+	expected := map[string][2]string{
+		"reversal": {"other", schemas.RelationReversal},
+		"partial":  {"order", schemas.RelationPartialRefund},
+		"rest":     {"order", schemas.RelationPartialRefund},
+	}
+	for id, want := range expected {
+		s := byTransaction[id]
+		if s.RelatedTransactionID != want[0] || s.RelationType != want[1] {
+			t.Errorf("Unexpected suggestion for %s: %+v", id, s)
+		}
+	}
+	for _, id := range []string{"extra", "salary"} {
+		if _, ok := byTransaction[id]; ok {
+			t.Errorf("Expected no suggestion for %s", id)
+		}
+	}
+
+	// A second scan suggests nothing new
+	again, err := uc.SuggestAll(context.Background())
+	if err != nil {
+		t.Fatalf("SuggestAll failed: %v", err)
+	}
+	if again.Suggested != 0 {
+		t.Errorf("Expected no new suggestions, got %d", again.Suggested)
+	}
+}
+
+// TestConfirmAndRejectSuggestion tests that confirming links the pair and resolved suggestions stay resolved
+func TestConfirmAndRejectSuggestion(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+
+	storeTransactions(t, db, []schemas.Transaction{
+		{ID: "order", Timestamp: 1000, Type: schemas.TypeDebit, Amount: 5000, Status: schemas.StatusSuccess},
+		{ID: "refund", Timestamp: 500000, Type: schemas.TypeCredit, Amount: 5000, Status: schemas.StatusSuccess},
+	})
+	db.Create(&schemas.LinkSuggestion{ID: "s1", TransactionID: "refund", RelatedTransactionID: "order", RelationType: schemas.RelationRefund, Status: schemas.SuggestionPending})
+	db.Create(&schemas.LinkSuggestion{ID: "s2", TransactionID: "order", RelatedTransactionID: "refund", RelationType: schemas.RelationRefund, Status: schemas.SuggestionPending})
+
+	if _, err := uc.ConfirmSuggestion(context.Background(), "s1", schemas.ConfirmSuggestionRequest{RelationType: "bogus"}); !errors.Is(err, schemas.ErrInvalidLink) {
+		t.Errorf("Expected ErrInvalidLink, got %v", err)
+	}
+
+	suggestion, err := uc.ConfirmSuggestion(context.Background(), "s1", schemas.ConfirmSuggestionRequest{RelationType: schemas.RelationChargeback})
+	if err != nil {
+		t.Fatalf("ConfirmSuggestion failed: %v", err)
+	}
+	if suggestion.Status != schemas.SuggestionConfirmed || suggestion.ResolvedAt == nil {
+		t.Errorf("Expected confirmed suggestion, got %+v", suggestion)
+	}
+
+	var refund schemas.Transaction
+	db.First(&refund, "id = ?", "refund")
+	if refund.RelatedTransactionID == nil || *refund.RelatedTransactionID != "order" || refund.RelationType != schemas.RelationChargeback {
+		t.Errorf("Expected refund linked to order as chargeback, got %+v", refund)
+	}
+
+	if _, err := uc.ConfirmSuggestion(context.Background(), "s1", schemas.ConfirmSuggestionRequest{}); !errors.Is(err, schemas.ErrSuggestionResolved) {
+		t.Errorf("Expected ErrSuggestionResolved, got %v", err)
+	}
+	if _, err := uc.RejectSuggestion(context.Background(), "missing"); !errors.Is(err, schemas.ErrSuggestionNotFound) {
+		t.Errorf("Expected ErrSuggestionNotFound, got %v", err)
+	}
+
+	rejected, err := uc.RejectSuggestion(context.Background(), "s2")
+	if err != nil {
+		t.Fatalf("RejectSuggestion failed: %v", err)
+	}
+	if rejected.Status != schemas.SuggestionRejected {
+		t.Errorf("Expected rejected suggestion, got %+v", rejected)
+	}
+}
+
+// TestCreateAndDeleteLink tests manual links and their validation
+func TestCreateAndDeleteLink(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+
+	storeTransactions(t, db, []schemas.Transaction{
+		{ID: "order", Timestamp: 1000, Type: schemas.TypeDebit, Amount: 5000, Status: schemas.StatusSuccess},
+		{ID: "other", Timestamp: 2000, Type: schemas.TypeDebit, Amount: 700, Status: schemas.StatusSuccess},
+		{ID: "refund", Timestamp: 3000, Type: schemas.TypeCredit, Amount: 5000, Status: schemas.StatusSuccess},
+		{ID: "early", Timestamp: 500, Type: schemas.TypeCredit, Amount: 100, Status: schemas.StatusSuccess},
+		{ID: "extra", Timestamp: 4000, Type: schemas.TypeCredit, Amount: 300, Status: schemas.StatusSuccess},
+	})
+
+	invalid := []schemas.LinkRequest{
+		{TransactionID: "refund", RelatedTransactionID: "order", RelationType: "gift"},
+		{TransactionID: "refund", RelatedTransactionID: "refund", RelationType: schemas.RelationRefund},
+		{TransactionID: "other", RelatedTransactionID: "order", RelationType: schemas.RelationRefund},
+		{TransactionID: "early", RelatedTransactionID: "order", RelationType: schemas.RelationRefund},
+		{TransactionID: "refund", RelatedTransactionID: "other", RelationType: schemas.RelationRefund},
+	}
+	for _, req := range invalid {
+		if _, err := uc.CreateLink(context.Background(), req); !errors.Is(err, schemas.ErrInvalidLink) {
+			t.Errorf("Expected ErrInvalidLink for %+v, got %v", req, err)
+		}
+	}
+
+	if _, err := uc.CreateLink(context.Background(), schemas.LinkRequest{TransactionID: "refund", RelatedTransactionID: "missing", RelationType: schemas.RelationRefund}); !errors.Is(err, schemas.ErrTransactionNotFound) {
+		t.Errorf("Expected ErrTransactionNotFound, got %v", err)
+	}
+
+	linked, err := uc.CreateLink(context.Background(), schemas.LinkRequest{TransactionID: "refund", RelatedTransactionID: "order", RelationType: schemas.RelationRefund})
+	if err != nil {
+		t.Fatalf("CreateLink failed: %v", err)
+	}
+	if linked.RelatedTransactionID == nil || *linked.RelatedTransactionID != "order" {
+		t.Errorf("Expected link to order, got %+v", linked)
+	}
+
+	if _, err := uc.CreateLink(context.Background(), schemas.LinkRequest{TransactionID: "refund", RelatedTransactionID: "order", RelationType: schemas.RelationRefund}); !errors.Is(err, schemas.ErrInvalidLink) {
+		t.Errorf("Expected ErrInvalidLink for an already linked transaction, got %v", err)
+	}
+
+	// The order has been paid back in full
+	if _, err := uc.CreateLink(context.Background(), schemas.LinkRequest{TransactionID: "extra", RelatedTransactionID: "order", RelationType: schemas.RelationPartialRefund}); !errors.Is(err, schemas.ErrInvalidLink) {
+		t.Errorf("Expected ErrInvalidLink for a refund beyond the original amount, got %v", err)
+	}
+
+	unlinked, err := uc.DeleteLink(context.Background(), "refund")
+	if err != nil {
+		t.Fatalf("DeleteLink failed: %v", err)
+	}
+	if unlinked.RelatedTransactionID != nil || unlinked.RelationType != "" {
+		t.Errorf("Expected unlinked transaction, got %+v", unlinked)
+	}
+
+	var events int64
+	db.Model(&auditSchemas.AuditEvent{}).Where("target_id = ?", "refund").Count(&events)
+	if events != 2 {
+		t.Errorf("Expected 2 audit events, got %d", events)
+	}
+}
+
+// TestRejectSuggestionRollsBack tests that a rejection whose audit event cannot be written leaves the suggestion pending
+func TestRejectSuggestionRollsBack(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+
+	storeTransactions(t, db, []schemas.Transaction{
+		{ID: "order", Timestamp: 1000, Type: schemas.TypeDebit, Amount: 5000, Status: schemas.StatusSuccess},
+		{ID: "refund", Timestamp: 500000, Type: schemas.TypeCredit, Amount: 5000, Status: schemas.StatusSuccess},
+	})
+	db.Create(&schemas.LinkSuggestion{ID: "s1", TransactionID: "refund", RelatedTransactionID: "order", RelationType: schemas.RelationRefund, Status: schemas.SuggestionPending})
+	if err := db.Migrator().DropTable(&auditSchemas.AuditEvent{}); err != nil {
+		t.Fatalf("failed to drop audit table: %v", err)
+	}
+
+	if _, err := uc.RejectSuggestion(context.Background(), "s1"); err == nil {
+		t.Fatal("Expected the rejection to fail without an audit log")
+	}
+
+	var suggestion schemas.LinkSuggestion
+	db.First(&suggestion, "id = ?", "s1")
+	if suggestion.Status != schemas.SuggestionPending {
+		t.Errorf("Expected the suggestion to stay pending, got %s", suggestion.Status)
+	}
+}
@@ -0,0 +1,17 @@
+package migration
+
+import (
+	auditRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/repository"
+	auditSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/gorm"
+)
+
+// Migrate brings the database schema up to date and protects the audit log from changes
+// The server runs it at startup and flipctl with its migrate command
+func Migrate(db *gorm.DB) error {
+	if err := db.AutoMigrate(&schemas.Transaction{}, &schemas.TransactionRevision{}, &schemas.ClearOperation{}, &schemas.Category{}, &schemas.TransactionTag{}, &schemas.TransactionFlag{}, &schemas.TransactionSplit{}, &schemas.Rule{}, &schemas.Counterparty{}, &schemas.CounterpartyAlias{}, &schemas.UploadBatch{}, &schemas.StatementBalance{}, &schemas.ImportProfile{}, &schemas.UploadSession{}, &schemas.Reconciliation{}, &schemas.ReconciliationItem{}, &schemas.LinkSuggestion{}, &auditSchemas.AuditEvent{}); err != nil {
+		return err
+	}
+	return auditRepo.CreateAppendOnlyTriggers(db)
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// CreateProfile saves a named set of upload settings
+func (h *Handler) CreateProfile(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "CreateProfile"),
+	)
+
+	var req schemas.ImportProfileRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.Warn("Invalid request body", logger.Error(err))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidImportProfileBody,
+			Error:   err.Error(),
+		})
+	}
+
+	profile, err := h.UseCase.CreateProfile(c.Context(), req)
+	if err != nil {
+		l.Warn("Failed to create import profile", logger.Error(err))
+		errResp := errorResponse(err, constants.MsgFailedToSaveImportProfile)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Import profile created", logger.String("id", profile.ID), logger.String("name", profile.Name))
+
+	return c.Status(http.StatusCreated).JSON(schemas.SuccessResponse{
+		Status: http.StatusCreated,
+		Data:   profile,
+	})
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// DeleteProfile removes an import profile
+func (h *Handler) DeleteProfile(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "DeleteProfile"),
+	)
+
+	id := c.Params("id")
+	if err := h.UseCase.DeleteProfile(c.Context(), id); err != nil {
+		l.Warn("Failed to delete import profile", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToDeleteImportProfile)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Import profile deleted", logger.String("id", id))
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data: fiber.Map{
+			"message": constants.MsgImportProfileDeleted,
+		},
+	})
+}
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+)
+
+// errorResponse maps use case errors to an error response, falling back to a 500 with the given message
+func errorResponse(err error, fallbackMessage string) schemas.ErrorResponse {
+	status := http.StatusInternalServerError
+	message := fallbackMessage
+
+	switch {
+	case errors.Is(err, schemas.ErrImportProfileNotFound):
+		status, message = http.StatusNotFound, constants.MsgImportProfileNotFound
+	case errors.Is(err, schemas.ErrImportProfileExists):
+		status, message = http.StatusConflict, constants.MsgImportProfileExists
+	case errors.Is(err, schemas.ErrInvalidParseOptions):
+		status, message = http.StatusBadRequest, constants.MsgInvalidParseOptions
+	case errors.Is(err, schemas.ErrInvalidImportProfile), errors.Is(err, schemas.ErrInvalidSource):
+		status, message = http.StatusBadRequest, constants.MsgInvalidImportProfileBody
+	}
+
+	return schemas.ErrorResponse{
+		Status:  status,
+		Message: message,
+		Error:   err.Error(),
+	}
+}
@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetProfile returns a single import profile by ID
+func (h *Handler) GetProfile(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "GetProfile"),
+	)
+
+	id := c.Params("id")
+	profile, err := h.UseCase.GetProfile(c.Context(), id)
+	if err != nil {
+		l.Warn("Failed to retrieve import profile", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToRetrieveImportProfiles)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   profile,
+	})
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	auditRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/repository"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	profileRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/profile/repository"
+	profileUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/profile/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/deps"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+)
+
+const ContextName = "Domain.Profile.Handler"
+
+// Handler defines the import profile handlers
+type Handler struct {
+	Logger  *logger.Logger
+	UseCase profileUseCase.IUseCase
+}
+
+// NewHandler creates a new import profile handler instance with all dependencies
+func NewHandler(d *deps.App) *Handler {
+	// Initialize repository
+	repository := profileRepo.NewRepository(d.DB.GetDB())
+
+	// Initialize use case
+	audit := auditUseCase.NewUseCase(auditRepo.NewRepository(d.DB.GetDB()))
+	useCase := profileUseCase.NewUseCase(repository, audit)
+
+	return &Handler{
+		Logger:  d.Logger,
+		UseCase: useCase,
+	}
+}
+
+// RegisterApi registers import profile API routes
+func RegisterApi(d *deps.App) *Handler {
+	handler := NewHandler(d)
+
+	api := d.Fiber.Group("/api")
+
+	api.Get("/import-profiles", handler.ListProfiles)
+	api.Post("/import-profiles", handler.CreateProfile)
+	api.Get("/import-profiles/:id", handler.GetProfile)
+	api.Put("/import-profiles/:id", handler.UpdateProfile)
+	api.Delete("/import-profiles/:id", handler.DeleteProfile)
+
+	return handler
+}
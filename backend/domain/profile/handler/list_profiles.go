@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ListProfiles returns all import profiles
+func (h *Handler) ListProfiles(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "ListProfiles"),
+	)
+
+	profiles, err := h.UseCase.ListProfiles(c.Context())
+	if err != nil {
+		l.Error("Failed to retrieve import profiles", logger.Error(err))
+		errResp := errorResponse(err, constants.MsgFailedToRetrieveImportProfiles)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   profiles,
+	})
+}
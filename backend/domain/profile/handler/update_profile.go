@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// UpdateProfile changes the name, source or parse options of an import profile
+// Fields left out of the request keep their current values; "auto" returns an option to detection
+func (h *Handler) UpdateProfile(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "UpdateProfile"),
+	)
+
+	id := c.Params("id")
+
+	var req schemas.ImportProfileRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.Warn("Invalid request body", logger.Error(err))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidImportProfileBody,
+			Error:   err.Error(),
+		})
+	}
+
+	profile, err := h.UseCase.UpdateProfile(c.Context(), id, req)
+	if err != nil {
+		l.Warn("Failed to update import profile", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToSaveImportProfile)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Import profile updated", logger.String("id", id))
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   profile,
+	})
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+)
+
+// Create creates an import profile record
+func (r *Repository) Create(ctx context.Context, profile *schemas.ImportProfile) error {
+	return db.Conn(ctx, r.DB).Create(profile).Error
+}
+
+// Update saves all fields of an existing import profile record
+func (r *Repository) Update(ctx context.Context, profile *schemas.ImportProfile) error {
+	return db.Conn(ctx, r.DB).Save(profile).Error
+}
+
+// Delete removes an import profile
+func (r *Repository) Delete(ctx context.Context, id string) error {
+	result := db.Conn(ctx, r.DB).Delete(&schemas.ImportProfile{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return schemas.ErrImportProfileNotFound
+	}
+	return nil
+}
+
+// Transaction runs fn in a database transaction, which the repositories called with its context join
+func (r *Repository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return db.Transaction(ctx, r.DB, fn)
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+)
+
+// FindAll retrieves all import profiles ordered by name
+func (r *Repository) FindAll(ctx context.Context) ([]schemas.ImportProfile, error) {
+	var profiles []schemas.ImportProfile
+	err := db.Conn(ctx, r.DB).Order("name ASC").Find(&profiles).Error
+	return profiles, err
+}
+
+// FindByID retrieves an import profile by ID
+func (r *Repository) FindByID(ctx context.Context, id string) (*schemas.ImportProfile, error) {
+	var profile schemas.ImportProfile
+	err := db.Conn(ctx, r.DB).Where("id = ?", id).First(&profile).Error
+	if err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+// FindByName retrieves an import profile by name, ignoring case
+func (r *Repository) FindByName(ctx context.Context, name string) (*schemas.ImportProfile, error) {
+	var profile schemas.ImportProfile
+	err := db.Conn(ctx, r.DB).Where("LOWER(name) = LOWER(?)", name).First(&profile).Error
+	if err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/gorm"
+)
+
+// IRepository defines the contract for import profile repository operations
+type IRepository interface {
+	// Commands
+	Create(ctx context.Context, profile *schemas.ImportProfile) error
+	Update(ctx context.Context, profile *schemas.ImportProfile) error
+	Delete(ctx context.Context, id string) error
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+
+	// Queries
+	FindAll(ctx context.Context) ([]schemas.ImportProfile, error)
+	FindByID(ctx context.Context, id string) (*schemas.ImportProfile, error)
+	FindByName(ctx context.Context, name string) (*schemas.ImportProfile, error)
+}
+
+// Repository implements IRepository
+type Repository struct {
+	DB *gorm.DB
+}
+
+// NewRepository creates a new import profile repository instance
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
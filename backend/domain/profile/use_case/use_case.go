@@ -0,0 +1,208 @@
+package use_case
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	auditSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/profile/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// IUseCase defines the contract for import profile use case operations
+type IUseCase interface {
+	ListProfiles(ctx context.Context) ([]schemas.ImportProfile, error)
+	GetProfile(ctx context.Context, id string) (*schemas.ImportProfile, error)
+	ResolveProfile(ctx context.Context, nameOrID string) (*schemas.ImportProfile, error)
+	CreateProfile(ctx context.Context, req schemas.ImportProfileRequest) (*schemas.ImportProfile, error)
+	UpdateProfile(ctx context.Context, id string, req schemas.ImportProfileRequest) (*schemas.ImportProfile, error)
+	DeleteProfile(ctx context.Context, id string) error
+}
+
+// UseCase implements IUseCase
+type UseCase struct {
+	Repository repository.IRepository
+	Audit      auditUseCase.IUseCase
+}
+
+// NewUseCase creates a new import profile use case instance
+func NewUseCase(repo repository.IRepository, audit auditUseCase.IUseCase) IUseCase {
+	return &UseCase{
+		Repository: repo,
+		Audit:      audit,
+	}
+}
+
+// ListProfiles retrieves all import profiles
+func (uc *UseCase) ListProfiles(ctx context.Context) ([]schemas.ImportProfile, error) {
+	profiles, err := uc.Repository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if profiles == nil {
+		profiles = []schemas.ImportProfile{}
+	}
+	return profiles, nil
+}
+
+// GetProfile retrieves a single import profile by ID
+func (uc *UseCase) GetProfile(ctx context.Context, id string) (*schemas.ImportProfile, error) {
+	profile, err := uc.Repository.FindByID(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, schemas.ErrImportProfileNotFound
+	}
+	return profile, err
+}
+
+// ResolveProfile retrieves the import profile an upload refers to, by name or by ID
+func (uc *UseCase) ResolveProfile(ctx context.Context, nameOrID string) (*schemas.ImportProfile, error) {
+	nameOrID = strings.TrimSpace(nameOrID)
+
+	profile, err := uc.Repository.FindByName(ctx, nameOrID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		profile, err = uc.GetProfile(ctx, nameOrID)
+	}
+	if errors.Is(err, schemas.ErrImportProfileNotFound) {
+		return nil, fmt.Errorf("%w: %s", schemas.ErrImportProfileNotFound, nameOrID)
+	}
+	return profile, err
+}
+
+// CreateProfile validates and stores a new import profile
+// The name check, the insert and the audit event run in one database transaction
+func (uc *UseCase) CreateProfile(ctx context.Context, req schemas.ImportProfileRequest) (*schemas.ImportProfile, error) {
+	if req.Name == nil {
+		return nil, fmt.Errorf("%w: name is required", schemas.ErrInvalidImportProfile)
+	}
+
+	profile := &schemas.ImportProfile{ID: uuid.New().String()}
+	err := uc.Repository.Transaction(ctx, func(ctx context.Context) error {
+		if err := uc.applyRequest(ctx, profile, req); err != nil {
+			return err
+		}
+
+		if err := uc.Repository.Create(ctx, profile); err != nil {
+			return err
+		}
+
+		return uc.Audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionProfileCreate,
+			TargetType: auditSchemas.TargetImportProfile,
+			TargetID:   profile.ID,
+			After:      profile,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// UpdateProfile changes the fields present in the request
+// The lookup, the name check, the update and the audit event run in one database transaction
+func (uc *UseCase) UpdateProfile(ctx context.Context, id string, req schemas.ImportProfileRequest) (*schemas.ImportProfile, error) {
+	var profile *schemas.ImportProfile
+	err := uc.Repository.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		profile, err = uc.GetProfile(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		before := *profile
+		if err := uc.applyRequest(ctx, profile, req); err != nil {
+			return err
+		}
+
+		if err := uc.Repository.Update(ctx, profile); err != nil {
+			return err
+		}
+
+		return uc.Audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionProfileUpdate,
+			TargetType: auditSchemas.TargetImportProfile,
+			TargetID:   profile.ID,
+			Before:     &before,
+			After:      profile,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// DeleteProfile removes an import profile; batches uploaded with it are not affected
+// The lookup, the delete and the audit event run in one database transaction
+func (uc *UseCase) DeleteProfile(ctx context.Context, id string) error {
+	return uc.Repository.Transaction(ctx, func(ctx context.Context) error {
+		profile, err := uc.GetProfile(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.Repository.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return uc.Audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionProfileDelete,
+			TargetType: auditSchemas.TargetImportProfile,
+			TargetID:   id,
+			Before:     profile,
+		})
+	})
+}
+
+// applyRequest validates the request fields and copies them onto the profile
+func (uc *UseCase) applyRequest(ctx context.Context, profile *schemas.ImportProfile, req schemas.ImportProfileRequest) error {
+	if req.Name != nil {
+		name, err := schemas.NormalizeProfileName(*req.Name)
+		if err != nil {
+			return err
+		}
+
+		existing, err := uc.Repository.FindByName(ctx, name)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if existing != nil && existing.ID != profile.ID {
+			return fmt.Errorf("%w: %q", schemas.ErrImportProfileExists, name)
+		}
+		profile.Name = name
+	}
+
+	if req.Source != nil {
+		source, err := schemas.NormalizeSource(*req.Source)
+		if err != nil {
+			return err
+		}
+		profile.Source = source
+	}
+
+	options := profile.ParseOptions
+	if req.Encoding != nil {
+		options.Encoding = *req.Encoding
+	}
+	if req.Delimiter != nil {
+		options.Delimiter = *req.Delimiter
+	}
+	if req.DecimalSeparator != nil {
+		options.DecimalSeparator = *req.DecimalSeparator
+	}
+
+	options, err := options.Normalize()
+	if err != nil {
+		return err
+	}
+	profile.ParseOptions = options
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package use_case
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	auditRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/repository"
+	auditSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/profile/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupTestUseCase creates a use case backed by an in-memory SQLite database
+func setupTestUseCase(t *testing.T) (IUseCase, *gorm.DB) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to setup test database: %v", err)
+	}
+
+	if err := db.AutoMigrate(&schemas.ImportProfile{}, &auditSchemas.AuditEvent{}); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	audit := auditUseCase.NewUseCase(auditRepo.NewRepository(db))
+	return NewUseCase(repository.NewRepository(db), audit), db
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+// TestCreateProfileValidation tests required and unique names, option normalization and invalid options
+func TestCreateProfileValidation(t *testing.T) {
+	uc, _ := setupTestUseCase(t)
+	ctx := context.Background()
+
+	profile, err := uc.CreateProfile(ctx, schemas.ImportProfileRequest{
+		Name:             stringPtr(" BCA KlikBisnis "),
+		Source:           stringPtr("Bank"),
+		Encoding:         stringPtr("cp1252"),
+		Delimiter:        stringPtr("semicolon"),
+		DecimalSeparator: stringPtr("auto"),
+	})
+	if err != nil {
+		t.Fatalf("CreateProfile failed: %v", err)
+	}
+	expected := schemas.ParseOptions{Encoding: "windows-1252", Delimiter: ";"}
+	if profile.Name != "BCA KlikBisnis" || profile.Source != "bank" || profile.ParseOptions != expected {
+		t.Errorf("Unexpected profile: %+v", profile)
+	}
+
+	if _, err := uc.CreateProfile(ctx, schemas.ImportProfileRequest{}); !errors.Is(err, schemas.ErrInvalidImportProfile) {
+		t.Errorf("Expected invalid import profile for missing name, got %v", err)
+	}
+
+	if _, err := uc.CreateProfile(ctx, schemas.ImportProfileRequest{Name: stringPtr("bca klikbisnis")}); !errors.Is(err, schemas.ErrImportProfileExists) {
+		t.Errorf("Expected duplicate name to be rejected, got %v", err)
+	}
+
+	if _, err := uc.UpdateProfile(ctx, profile.ID, schemas.ImportProfileRequest{Delimiter: stringPtr(":")}); !errors.Is(err, schemas.ErrInvalidParseOptions) {
+		t.Errorf("Expected invalid parse options, got %v", err)
+	}
+}
+
+// TestResolveProfile tests looking profiles up by name or ID, and deleting them
+func TestResolveProfile(t *testing.T) {
+	uc, _ := setupTestUseCase(t)
+	ctx := context.Background()
+
+	profile, err := uc.CreateProfile(ctx, schemas.ImportProfileRequest{Name: stringPtr("Mandiri"), DecimalSeparator: stringPtr(",")})
+	if err != nil {
+		t.Fatalf("CreateProfile failed: %v", err)
+	}
+
+	for _, ref := range []string{"mandiri", profile.ID} {
+		resolved, err := uc.ResolveProfile(ctx, ref)
+		if err != nil || resolved.ID != profile.ID {
+			t.Errorf("Expected %q to resolve to the profile, got %+v (%v)", ref, resolved, err)
+		}
+	}
+
+	if err := uc.DeleteProfile(ctx, profile.ID); err != nil {
+		t.Fatalf("DeleteProfile failed: %v", err)
+	}
+	if _, err := uc.ResolveProfile(ctx, "mandiri"); !errors.Is(err, schemas.ErrImportProfileNotFound) {
+		t.Errorf("Expected profile not found after delete, got %v", err)
+	}
+}
+
+// TestDeleteProfileRollsBack tests that a delete whose audit event cannot be written keeps the profile
+func TestDeleteProfileRollsBack(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	ctx := context.Background()
+
+	profile, err := uc.CreateProfile(ctx, schemas.ImportProfileRequest{Name: stringPtr("Bank")})
+	if err != nil {
+		t.Fatalf("CreateProfile failed: %v", err)
+	}
+	if err := db.Migrator().DropTable(&auditSchemas.AuditEvent{}); err != nil {
+		t.Fatalf("failed to drop audit table: %v", err)
+	}
+
+	if err := uc.DeleteProfile(ctx, profile.ID); err == nil {
+		t.Fatal("Expected the delete to fail without an audit log")
+	}
+	if _, err := uc.GetProfile(ctx, profile.ID); err != nil {
+		t.Errorf("Expected the profile to remain, got %v", err)
+	}
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Reconcile matches two upload batches and stores the result
+func (h *Handler) Reconcile(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "Reconcile"),
+	)
+
+	var req schemas.ReconciliationRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.Warn("Invalid request body", logger.Error(err))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidReconciliation,
+			Error:   err.Error(),
+		})
+	}
+
+	reconciliation, err := h.UseCase.Reconcile(c.Context(), req)
+	if err != nil {
+		l.Warn("Failed to reconcile batches", logger.Error(err))
+		errResp := errorResponse(err, constants.MsgFailedToReconcile)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Batches reconciled",
+		logger.String("id", reconciliation.ID),
+		logger.Int("matched", reconciliation.MatchedCount),
+		logger.Int("amount_mismatch", reconciliation.AmountMismatchCount),
+		logger.Int("unmatched_left", reconciliation.UnmatchedLeftCount),
+		logger.Int("unmatched_right", reconciliation.UnmatchedRightCount),
+	)
+
+	return c.Status(http.StatusCreated).JSON(schemas.SuccessResponse{
+		Status: http.StatusCreated,
+		Data:   reconciliation,
+	})
+}
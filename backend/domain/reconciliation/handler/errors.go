@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+)
+
+// errorResponse maps use case errors to an error response, falling back to a 500 with the given message
+func errorResponse(err error, fallbackMessage string) schemas.ErrorResponse {
+	status := http.StatusInternalServerError
+	message := fallbackMessage
+
+	switch {
+	case errors.Is(err, schemas.ErrReconciliationNotFound):
+		status, message = http.StatusNotFound, constants.MsgReconciliationNotFound
+	case errors.Is(err, schemas.ErrBatchNotFound):
+		status, message = http.StatusNotFound, constants.MsgBatchNotFound
+	case errors.Is(err, schemas.ErrInvalidReconciliation):
+		status, message = http.StatusBadRequest, constants.MsgInvalidReconciliation
+	}
+
+	return schemas.ErrorResponse{
+		Status:  status,
+		Message: message,
+		Error:   err.Error(),
+	}
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ExportCSV downloads reconciliation items as CSV, optionally for one result
+func (h *Handler) ExportCSV(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "ExportCSV"),
+	)
+
+	id := c.Params("id")
+	result := c.Query("result")
+
+	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="reconciliation-%s.csv"`, id))
+
+	if err := h.UseCase.ExportCSV(c.Context(), id, result, c.Response().BodyWriter()); err != nil {
+		l.Warn("Failed to export reconciliation", logger.Error(err), logger.String("id", id))
+		c.Response().ResetBody()
+		c.Response().Header.Del(fiber.HeaderContentDisposition)
+		errResp := errorResponse(err, constants.MsgFailedToExportReconciliation)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	return c.SendStatus(http.StatusOK)
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"net/http"
+
+	transactionHandler "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/handler"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ListReconciliations returns the most recent reconciliations
+func (h *Handler) ListReconciliations(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "ListReconciliations"),
+	)
+
+	reconciliations, err := h.UseCase.ListReconciliations(c.Context())
+	if err != nil {
+		l.Error("Failed to retrieve reconciliations", logger.Error(err))
+		errResp := errorResponse(err, constants.MsgFailedToRetrieveReconciliations)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   reconciliations,
+	})
+}
+
+// GetReconciliation returns a single reconciliation with its counts
+func (h *Handler) GetReconciliation(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "GetReconciliation"),
+	)
+
+	id := c.Params("id")
+	reconciliation, err := h.UseCase.GetReconciliation(c.Context(), id)
+	if err != nil {
+		l.Warn("Failed to retrieve reconciliation", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToRetrieveReconciliations)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   reconciliation,
+	})
+}
+
+// GetItems returns reconciliation items with both transactions, filtered by result and paginated
+func (h *Handler) GetItems(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "GetItems"),
+	)
+
+	page, pageSize, errResp := transactionHandler.ParsePagination(c.Query, h.FieldValidator)
+	if errResp != nil {
+		l.Warn("Invalid pagination parameters", logger.String("error", errResp.Error))
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	id := c.Params("id")
+	response, err := h.UseCase.GetItems(c.Context(), id, c.Query("result"), page, pageSize)
+	if err != nil {
+		l.Warn("Failed to retrieve reconciliation items", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToRetrieveReconciliations)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	auditRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/repository"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	reconciliationRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/reconciliation/repository"
+	reconciliationUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/reconciliation/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/config"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/deps"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+)
+
+const ContextName = "Domain.Reconciliation.Handler"
+
+// Handler defines the reconciliation handlers
+type Handler struct {
+	Logger         *logger.Logger
+	UseCase        reconciliationUseCase.IUseCase
+	FieldValidator *validator.FieldValidator
+}
+
+// NewHandler creates a new reconciliation handler instance with all dependencies
+func NewHandler(d *deps.App) *Handler {
+	// Initialize repository
+	repository := reconciliationRepo.NewRepository(d.DB.GetDB())
+
+	// Initialize use case
+	cfg := config.GetConfig()
+	audit := auditUseCase.NewUseCase(auditRepo.NewRepository(d.DB.GetDB()))
+	useCase := reconciliationUseCase.NewUseCase(repository, audit, cfg.ReconcileTimeToleranceSeconds, cfg.CounterpartyMatchThreshold)
+
+	return &Handler{
+		Logger:         d.Logger,
+		UseCase:        useCase,
+		FieldValidator: d.FieldValidator,
+	}
+}
+
+// RegisterApi registers reconciliation API routes
+func RegisterApi(d *deps.App) *Handler {
+	handler := NewHandler(d)
+
+	api := d.Fiber.Group("/api")
+
+	api.Get("/reconciliations", handler.ListReconciliations)
+	api.Post("/reconciliations", handler.Reconcile)
+	api.Get("/reconciliations/:id", handler.GetReconciliation)
+	api.Get("/reconciliations/:id/items", handler.GetItems)
+	api.Get("/reconciliations/:id/export", handler.ExportCSV)
+
+	return handler
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+	"gorm.io/gorm"
+)
+
+// Create stores a reconciliation with its items in one transaction
+func (r *Repository) Create(ctx context.Context, reconciliation *schemas.Reconciliation, items []schemas.ReconciliationItem) error {
+	return db.Conn(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(reconciliation).Error; err != nil {
+			return err
+		}
+
+		if len(items) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(items, 100).Error
+	})
+}
+
+// Transaction runs fn in a database transaction, which the repositories called with its context join
+func (r *Repository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return db.Transaction(ctx, r.DB, fn)
+}
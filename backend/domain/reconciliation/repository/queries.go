@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+	"gorm.io/gorm"
+)
+
+// FindByID retrieves a reconciliation by ID
+func (r *Repository) FindByID(ctx context.Context, id string) (*schemas.Reconciliation, error) {
+	var reconciliation schemas.Reconciliation
+	err := db.Conn(ctx, r.DB).Where("id = ?", id).First(&reconciliation).Error
+	if err != nil {
+		return nil, err
+	}
+	return &reconciliation, nil
+}
+
+// FindAll retrieves the most recent reconciliations, newest first
+func (r *Repository) FindAll(ctx context.Context, limit int) ([]schemas.Reconciliation, error) {
+	var reconciliations []schemas.Reconciliation
+	err := db.Conn(ctx, r.DB).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&reconciliations).Error
+	return reconciliations, err
+}
+
+// itemsQuery selects the items of a reconciliation, optionally with one result
+func (r *Repository) itemsQuery(ctx context.Context, reconciliationID string, result string) *gorm.DB {
+	query := db.Conn(ctx, r.DB).
+		Model(&schemas.ReconciliationItem{}).
+		Where("reconciliation_id = ?", reconciliationID)
+	if result != "" {
+		query = query.Where("result = ?", result)
+	}
+	return query
+}
+
+// FindItems retrieves a page of reconciliation items in result order
+func (r *Repository) FindItems(ctx context.Context, reconciliationID string, result string, page int, pageSize int) ([]schemas.ReconciliationItem, int64, error) {
+	var total int64
+	if err := r.itemsQuery(ctx, reconciliationID, result).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var items []schemas.ReconciliationItem
+	err := r.itemsQuery(ctx, reconciliationID, result).
+		Order("id ASC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&items).Error
+	return items, total, err
+}
+
+// IterateItems walks the items of a reconciliation in batches
+func (r *Repository) IterateItems(ctx context.Context, reconciliationID string, result string, batchSize int, fn func(items []schemas.ReconciliationItem) error) error {
+	var batch []schemas.ReconciliationItem
+	return r.itemsQuery(ctx, reconciliationID, result).
+		FindInBatches(&batch, batchSize, func(tx *gorm.DB, _ int) error {
+			return fn(batch)
+		}).Error
+}
+
+// BatchExists checks whether an upload batch ID exists
+func (r *Repository) BatchExists(ctx context.Context, batchID string) (bool, error) {
+	var count int64
+	err := db.Conn(ctx, r.DB).Model(&schemas.UploadBatch{}).Where("id = ?", batchID).Count(&count).Error
+	return count > 0, err
+}
+
+// FindBatchTransactions retrieves the live transactions of an upload batch, oldest first
+func (r *Repository) FindBatchTransactions(ctx context.Context, batchID string) ([]schemas.Transaction, error) {
+	var transactions []schemas.Transaction
+	err := db.Conn(ctx, r.DB).
+		Where("batch_id = ?", batchID).
+		Order("timestamp ASC, id ASC").
+		Find(&transactions).Error
+	return transactions, err
+}
+
+// FindTransactions retrieves transactions by ID, including soft-deleted ones, keyed by ID
+func (r *Repository) FindTransactions(ctx context.Context, ids []string) (map[string]*schemas.Transaction, error) {
+	found := make(map[string]*schemas.Transaction, len(ids))
+	if len(ids) == 0 {
+		return found, nil
+	}
+
+	var transactions []schemas.Transaction
+	if err := db.Conn(ctx, r.DB).Unscoped().Where("id IN ?", ids).Find(&transactions).Error; err != nil {
+		return nil, err
+	}
+
+	for i := range transactions {
+		found[transactions[i].ID] = &transactions[i]
+	}
+	return found, nil
+}
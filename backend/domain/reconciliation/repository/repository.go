@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/gorm"
+)
+
+// IRepository defines the contract for reconciliation repository operations
+type IRepository interface {
+	// Commands
+	Create(ctx context.Context, reconciliation *schemas.Reconciliation, items []schemas.ReconciliationItem) error
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+
+	// Queries
+	FindByID(ctx context.Context, id string) (*schemas.Reconciliation, error)
+	FindAll(ctx context.Context, limit int) ([]schemas.Reconciliation, error)
+	FindItems(ctx context.Context, reconciliationID string, result string, page int, pageSize int) ([]schemas.ReconciliationItem, int64, error)
+	IterateItems(ctx context.Context, reconciliationID string, result string, batchSize int, fn func(items []schemas.ReconciliationItem) error) error
+	BatchExists(ctx context.Context, batchID string) (bool, error)
+	FindBatchTransactions(ctx context.Context, batchID string) ([]schemas.Transaction, error)
+	FindTransactions(ctx context.Context, ids []string) (map[string]*schemas.Transaction, error)
+}
+
+// Repository implements IRepository
+type Repository struct {
+	DB *gorm.DB
+}
+
+// NewRepository creates a new reconciliation repository instance
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
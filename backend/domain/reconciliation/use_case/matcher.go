@@ -0,0 +1,129 @@
+package use_case
+
+import (
+	"math"
+	"sort"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/fuzzy"
+)
+
+// candidate is a possible pairing of a left and a right row
+type candidate struct {
+	left       int
+	right      int
+	amountDiff int64
+	timeDiff   int64
+	score      float64
+}
+
+// reconcile pairs left rows with right rows of the same type whose timestamps are within tolerance
+// and whose names match at threshold (0 ignores names). Pairs with equal amounts are taken first,
+// then the closest in time; a pair whose amounts differ is reported as an amount mismatch.
+// Right rows must be sorted by timestamp.
+func reconcile(left, right []schemas.Transaction, tolerance int64, threshold float64) []schemas.ReconciliationItem {
+	rightNames := make([]string, len(right))
+	for j := range right {
+		rightNames[j] = fuzzy.Normalize(right[j].Name)
+	}
+
+	var candidates []candidate
+	for i := range left {
+		name := fuzzy.Normalize(left[i].Name)
+		start := sort.Search(len(right), func(j int) bool {
+			return right[j].Timestamp >= left[i].Timestamp-tolerance
+		})
+
+		for j := start; j < len(right) && right[j].Timestamp <= left[i].Timestamp+tolerance; j++ {
+			if right[j].Type != left[i].Type || !namesMatch(&left[i], &right[j], name, rightNames[j], threshold) {
+				continue
+			}
+			candidates = append(candidates, candidate{
+				left:       i,
+				right:      j,
+				amountDiff: right[j].Amount - left[i].Amount,
+				timeDiff:   right[j].Timestamp - left[i].Timestamp,
+				score:      math.Round(fuzzy.Similarity(name, rightNames[j])*10000) / 10000,
+			})
+		}
+	}
+
+	sort.SliceStable(candidates, func(a, b int) bool {
+		ca, cb := candidates[a], candidates[b]
+		if (ca.amountDiff == 0) != (cb.amountDiff == 0) {
+			return ca.amountDiff == 0
+		}
+		if abs(ca.timeDiff) != abs(cb.timeDiff) {
+			return abs(ca.timeDiff) < abs(cb.timeDiff)
+		}
+		if ca.score != cb.score {
+			return ca.score > cb.score
+		}
+		return abs(ca.amountDiff) < abs(cb.amountDiff)
+	})
+
+	pairs := make(map[int]candidate)
+	rightUsed := make([]bool, len(right))
+	for _, c := range candidates {
+		if _, ok := pairs[c.left]; ok || rightUsed[c.right] {
+			continue
+		}
+		pairs[c.left] = c
+		rightUsed[c.right] = true
+	}
+
+	items := make([]schemas.ReconciliationItem, 0, len(left)+len(right)-len(pairs))
+	for i := range left {
+		c, ok := pairs[i]
+		if !ok {
+			items = append(items, schemas.ReconciliationItem{
+				Result:            schemas.ReconcileUnmatchedLeft,
+				LeftTransactionID: &left[i].ID,
+			})
+			continue
+		}
+
+		result := schemas.ReconcileMatched
+		if c.amountDiff != 0 {
+			result = schemas.ReconcileAmountMismatch
+		}
+		items = append(items, schemas.ReconciliationItem{
+			Result:             result,
+			LeftTransactionID:  &left[i].ID,
+			RightTransactionID: &right[c.right].ID,
+			AmountDifference:   c.amountDiff,
+			TimeDifference:     c.timeDiff,
+			NameScore:          c.score,
+		})
+	}
+
+	for j := range right {
+		if !rightUsed[j] {
+			items = append(items, schemas.ReconciliationItem{
+				Result:             schemas.ReconcileUnmatchedRight,
+				RightTransactionID: &right[j].ID,
+			})
+		}
+	}
+
+	return items
+}
+
+// namesMatch reports whether two rows name the same party, either through the counterparty
+// directory or by fuzzy comparison of their normalized names
+func namesMatch(left, right *schemas.Transaction, leftName, rightName string, threshold float64) bool {
+	if threshold <= 0 {
+		return true
+	}
+	if left.CounterpartyID != nil && right.CounterpartyID != nil && *left.CounterpartyID == *right.CounterpartyID {
+		return true
+	}
+	return fuzzy.Match(leftName, rightName, threshold)
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,297 @@
+package use_case
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	auditSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/reconciliation/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/requestmeta"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// exportBatchSize is how many items are written at a time when exporting a reconciliation
+const exportBatchSize = 500
+
+// exportHeader is the header row of a reconciliation CSV export
+var exportHeader = []string{
+	"result", "amount_difference", "time_difference", "name_score",
+	"left_id", "left_timestamp", "left_name", "left_type", "left_amount", "left_status",
+	"right_id", "right_timestamp", "right_name", "right_type", "right_amount", "right_status",
+}
+
+// IUseCase defines the contract for reconciliation use case operations
+type IUseCase interface {
+	Reconcile(ctx context.Context, req schemas.ReconciliationRequest) (*schemas.Reconciliation, error)
+	ListReconciliations(ctx context.Context) ([]schemas.Reconciliation, error)
+	GetReconciliation(ctx context.Context, id string) (*schemas.Reconciliation, error)
+	GetItems(ctx context.Context, id string, result string, page int, pageSize int) (*schemas.ReconciliationItemsResponse, error)
+	ExportCSV(ctx context.Context, id string, result string, w io.Writer) error
+}
+
+// UseCase implements IUseCase
+type UseCase struct {
+	Repository    repository.IRepository
+	Audit         auditUseCase.IUseCase
+	TimeTolerance int64
+	NameThreshold float64
+	Now           func() time.Time
+}
+
+// NewUseCase creates a new reconciliation use case instance
+// timeTolerance (seconds) and nameThreshold are used when a request does not set them
+func NewUseCase(repo repository.IRepository, audit auditUseCase.IUseCase, timeTolerance int64, nameThreshold float64) IUseCase {
+	return &UseCase{
+		Repository:    repo,
+		Audit:         audit,
+		TimeTolerance: timeTolerance,
+		NameThreshold: nameThreshold,
+		Now:           time.Now,
+	}
+}
+
+// Reconcile matches the rows of two upload batches and stores the result
+func (uc *UseCase) Reconcile(ctx context.Context, req schemas.ReconciliationRequest) (*schemas.Reconciliation, error) {
+	if req.LeftBatchID == "" || req.RightBatchID == "" {
+		return nil, fmt.Errorf("%w: left_batch_id and right_batch_id are required", schemas.ErrInvalidReconciliation)
+	}
+	if req.LeftBatchID == req.RightBatchID {
+		return nil, fmt.Errorf("%w: a batch cannot be reconciled with itself", schemas.ErrInvalidReconciliation)
+	}
+
+	tolerance := uc.TimeTolerance
+	if req.TimeToleranceSeconds != nil {
+		tolerance = *req.TimeToleranceSeconds
+	}
+	if tolerance < 0 {
+		return nil, fmt.Errorf("%w: time_tolerance_seconds must not be negative", schemas.ErrInvalidReconciliation)
+	}
+
+	threshold := uc.NameThreshold
+	if req.NameThreshold != nil {
+		threshold = *req.NameThreshold
+	}
+	if threshold < 0 || threshold > 1 {
+		return nil, fmt.Errorf("%w: name_threshold must be between 0 and 1", schemas.ErrInvalidReconciliation)
+	}
+
+	left, err := uc.batchTransactions(ctx, req.LeftBatchID)
+	if err != nil {
+		return nil, err
+	}
+	right, err := uc.batchTransactions(ctx, req.RightBatchID)
+	if err != nil {
+		return nil, err
+	}
+
+	reconciliation := &schemas.Reconciliation{
+		ID:                   uuid.New().String(),
+		LeftBatchID:          req.LeftBatchID,
+		RightBatchID:         req.RightBatchID,
+		TimeToleranceSeconds: tolerance,
+		NameThreshold:        threshold,
+		CreatedBy:            requestmeta.FromContext(ctx).Actor,
+		CreatedAt:            uc.Now().UTC(),
+	}
+
+	items := reconcile(left, right, tolerance, threshold)
+	for i := range items {
+		items[i].ReconciliationID = reconciliation.ID
+		switch items[i].Result {
+		case schemas.ReconcileMatched:
+			reconciliation.MatchedCount++
+		case schemas.ReconcileAmountMismatch:
+			reconciliation.AmountMismatchCount++
+		case schemas.ReconcileUnmatchedLeft:
+			reconciliation.UnmatchedLeftCount++
+		case schemas.ReconcileUnmatchedRight:
+			reconciliation.UnmatchedRightCount++
+		}
+	}
+
+	// The result is stored with its audit event, so neither is kept without the other
+	err = uc.Repository.Transaction(ctx, func(ctx context.Context) error {
+		if err := uc.Repository.Create(ctx, reconciliation, items); err != nil {
+			return err
+		}
+
+		return uc.Audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionReconcile,
+			TargetType: auditSchemas.TargetReconciliation,
+			TargetID:   reconciliation.ID,
+			After:      reconciliation,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reconciliation, nil
+}
+
+// batchTransactions loads the rows of an upload batch, failing if the batch does not exist
+func (uc *UseCase) batchTransactions(ctx context.Context, batchID string) ([]schemas.Transaction, error) {
+	exists, err := uc.Repository.BatchExists(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", schemas.ErrBatchNotFound, batchID)
+	}
+	return uc.Repository.FindBatchTransactions(ctx, batchID)
+}
+
+// ListReconciliations retrieves the most recent reconciliations
+func (uc *UseCase) ListReconciliations(ctx context.Context) ([]schemas.Reconciliation, error) {
+	reconciliations, err := uc.Repository.FindAll(ctx, 50)
+	if err != nil {
+		return nil, err
+	}
+	if reconciliations == nil {
+		reconciliations = []schemas.Reconciliation{}
+	}
+	return reconciliations, nil
+}
+
+// GetReconciliation retrieves a single reconciliation with its counts
+func (uc *UseCase) GetReconciliation(ctx context.Context, id string) (*schemas.Reconciliation, error) {
+	reconciliation, err := uc.Repository.FindByID(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, schemas.ErrReconciliationNotFound
+	}
+	return reconciliation, err
+}
+
+// GetItems retrieves reconciliation items with both transactions, optionally for one result
+func (uc *UseCase) GetItems(ctx context.Context, id string, result string, page int, pageSize int) (*schemas.ReconciliationItemsResponse, error) {
+	if result != "" && !schemas.ValidReconcileResult(result) {
+		return nil, fmt.Errorf("%w: unknown result %q", schemas.ErrInvalidReconciliation, result)
+	}
+
+	if _, err := uc.GetReconciliation(ctx, id); err != nil {
+		return nil, err
+	}
+
+	items, total, err := uc.Repository.FindItems(ctx, id, result, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := uc.withTransactions(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+
+	filtersMeta := make(map[string]interface{})
+	if result != "" {
+		filtersMeta["result"] = result
+	}
+
+	return &schemas.ReconciliationItemsResponse{
+		Message: constants.MsgReconciliationItemsRetrieved,
+		Data:    details,
+		Meta: schemas.ResponseMeta{
+			Pagination: schemas.NewPaginationMeta(total, len(details), page, pageSize),
+			Filters:    filtersMeta,
+		},
+	}, nil
+}
+
+// withTransactions fills in the left and right transactions of reconciliation items
+func (uc *UseCase) withTransactions(ctx context.Context, items []schemas.ReconciliationItem) ([]schemas.ReconciliationItemDetail, error) {
+	var ids []string
+	for _, item := range items {
+		if item.LeftTransactionID != nil {
+			ids = append(ids, *item.LeftTransactionID)
+		}
+		if item.RightTransactionID != nil {
+			ids = append(ids, *item.RightTransactionID)
+		}
+	}
+
+	transactions, err := uc.Repository.FindTransactions(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]schemas.ReconciliationItemDetail, len(items))
+	for i, item := range items {
+		details[i].ReconciliationItem = item
+		if item.LeftTransactionID != nil {
+			details[i].Left = transactions[*item.LeftTransactionID]
+		}
+		if item.RightTransactionID != nil {
+			details[i].Right = transactions[*item.RightTransactionID]
+		}
+	}
+	return details, nil
+}
+
+// ExportCSV writes reconciliation items as CSV, optionally for one result
+// Amounts are written in the same units as the upload CSV
+func (uc *UseCase) ExportCSV(ctx context.Context, id string, result string, w io.Writer) error {
+	if result != "" && !schemas.ValidReconcileResult(result) {
+		return fmt.Errorf("%w: unknown result %q", schemas.ErrInvalidReconciliation, result)
+	}
+
+	if _, err := uc.GetReconciliation(ctx, id); err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(exportHeader); err != nil {
+		return err
+	}
+
+	err := uc.Repository.IterateItems(ctx, id, result, exportBatchSize, func(items []schemas.ReconciliationItem) error {
+		details, err := uc.withTransactions(ctx, items)
+		if err != nil {
+			return err
+		}
+
+		for _, detail := range details {
+			record := []string{
+				detail.Result,
+				schemas.FormatAmount(detail.AmountDifference),
+				strconv.FormatInt(detail.TimeDifference, 10),
+				strconv.FormatFloat(detail.NameScore, 'f', -1, 64),
+			}
+			record = append(record, transactionColumns(detail.Left)...)
+			record = append(record, transactionColumns(detail.Right)...)
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// transactionColumns returns the export columns of one side of an item, empty when the side is missing
+func transactionColumns(t *schemas.Transaction) []string {
+	if t == nil {
+		return make([]string, 6)
+	}
+	return []string{
+		t.ID,
+		strconv.FormatInt(t.Timestamp, 10),
+		t.Name,
+		string(t.Type),
+		schemas.FormatAmount(t.Amount),
+		string(t.Status),
+	}
+}
@@ -0,0 +1,198 @@
+package use_case
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	auditRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/repository"
+	auditSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/reconciliation/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupTestUseCase creates a use case backed by an in-memory SQLite database
+func setupTestUseCase(t *testing.T) (IUseCase, *gorm.DB) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to setup test database: %v", err)
+	}
+
+	err = db.AutoMigrate(&schemas.Transaction{}, &schemas.UploadBatch{}, &schemas.Reconciliation{}, &schemas.ReconciliationItem{}, &auditSchemas.AuditEvent{})
+	if err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	audit := auditUseCase.NewUseCase(auditRepo.NewRepository(db))
+	return NewUseCase(repository.NewRepository(db), audit, 3600, 0.8), db
+}
+
+// createBatch stores an upload batch with its transactions
+func createBatch(t *testing.T, db *gorm.DB, batchID string, transactions []schemas.Transaction) {
+	if err := db.Create(&schemas.UploadBatch{ID: batchID, TotalRecords: len(transactions)}).Error; err != nil {
+		t.Fatalf("failed to insert batch: %v", err)
+	}
+	for i := range transactions {
+		transactions[i].BatchID = &batchID
+	}
+	if err := db.Create(&transactions).Error; err != nil {
+		t.Fatalf("failed to insert test data: %v", err)
+	}
+}
+
+// TestReconcileMatchesRows tests the four result sets
+func TestReconcileMatchesRows(t *testing.T) {
+	ledger := []schemas.Transaction{
+		{ID: "L1", Timestamp: 1000, Name: "JOHN DOE", Type: schemas.TypeDebit, Amount: 5000},
+		{ID: "L2", Timestamp: 2000, Name: "JONATHAN SMITH", Type: schemas.TypeDebit, Amount: 7000},
+		{ID: "L3", Timestamp: 3000, Name: "COMPANY A", Type: schemas.TypeCredit, Amount: 9000},
+		{ID: "L4", Timestamp: 4000, Name: "JANE DOE", Type: schemas.TypeDebit, Amount: 1000},
+	}
+	bank := []schemas.Transaction{
+		{ID: "B1", Timestamp: 1200, Name: "John Doe", Type: schemas.TypeDebit, Amount: 5000},
+		{ID: "B2", Timestamp: 2100, Name: "Jonathan Smyth", Type: schemas.TypeDebit, Amount: 7500},
+		{ID: "B3", Timestamp: 3000, Name: "COMPANY B", Type: schemas.TypeCredit, Amount: 9000},
+		{ID: "B4", Timestamp: 9000, Name: "JANE DOE", Type: schemas.TypeDebit, Amount: 1000},
+	}
+
+	items := reconcile(ledger, bank, 3600, 0.8)
+
+	results := make(map[string]string)
+	for _, item := range items {
+		key := ""
+		if item.LeftTransactionID != nil {
+			key += *item.LeftTransactionID
+		}
+		key += "-"
+		if item.RightTransactionID != nil {
+			key += *item.RightTransactionID
+		}
+		results[key] = item.Result
+	}
+
+	expected := map[string]string{
+		"L1-B1": schemas.ReconcileMatched,
+		"L2-B2": schemas.ReconcileAmountMismatch,
+		"L3-":   schemas.ReconcileUnmatchedLeft,
+		"L4-":   schemas.ReconcileUnmatchedLeft,
+		"-B3":   schemas.ReconcileUnmatchedRight,
+		"-B4":   schemas.ReconcileUnmatchedRight,
+	}
+	if len(results) != len(expected) {
+		t.Fatalf("Expected %d items, got %v", len(expected), results)
+	}
+	for key, result := range expected {
+		if results[key] != result {
+			t.Errorf("Expected %s to be %s, got %q", key, result, results[key])
+		}
+	}
+
+	if items[1].AmountDifference != 500 || items[1].TimeDifference != 100 {
+		t.Errorf("Unexpected differences: %+v", items[1])
+	}
+}
+
+// TestReconcilePrefersExactAmount tests that an equal amount wins over a closer timestamp
+func TestReconcilePrefersExactAmount(t *testing.T) {
+	ledger := []schemas.Transaction{
+		{ID: "L1", Timestamp: 1000, Name: "JOHN DOE", Type: schemas.TypeDebit, Amount: 5000},
+	}
+	bank := []schemas.Transaction{
+		{ID: "B1", Timestamp: 1000, Name: "JOHN DOE", Type: schemas.TypeDebit, Amount: 4000},
+		{ID: "B2", Timestamp: 1500, Name: "JOHN DOE", Type: schemas.TypeDebit, Amount: 5000},
+	}
+
+	items := reconcile(ledger, bank, 3600, 0.8)
+	if items[0].Result != schemas.ReconcileMatched || *items[0].RightTransactionID != "B2" {
+		t.Errorf("Expected L1 to match B2, got %+v", items[0])
+	}
+	if items[1].Result != schemas.ReconcileUnmatchedRight {
+		t.Errorf("Expected B1 to stay unmatched, got %+v", items[1])
+	}
+}
+
+// TestReconcileRollsBack tests that a reconciliation whose audit event cannot be written is not stored
+func TestReconcileRollsBack(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+
+	createBatch(t, db, "ledger", []schemas.Transaction{
+		{ID: "L1", Timestamp: 1000, Name: "JOHN DOE", Type: schemas.TypeDebit, Amount: 5000, Status: schemas.StatusSuccess},
+	})
+	createBatch(t, db, "bank", []schemas.Transaction{
+		{ID: "B1", Timestamp: 1100, Name: "JOHN DOE", Type: schemas.TypeDebit, Amount: 5000, Status: schemas.StatusSuccess},
+	})
+	if err := db.Migrator().DropTable(&auditSchemas.AuditEvent{}); err != nil {
+		t.Fatalf("failed to drop audit table: %v", err)
+	}
+
+	if _, err := uc.Reconcile(context.Background(), schemas.ReconciliationRequest{LeftBatchID: "ledger", RightBatchID: "bank"}); err == nil {
+		t.Fatal("Expected the reconciliation to fail without an audit log")
+	}
+
+	var reconciliations, items int64
+	db.Model(&schemas.Reconciliation{}).Count(&reconciliations)
+	db.Model(&schemas.ReconciliationItem{}).Count(&items)
+	if reconciliations != 0 || items != 0 {
+		t.Errorf("Expected nothing stored, got %d reconciliations and %d items", reconciliations, items)
+	}
+}
+
+// TestReconcileStoresAndExports tests a stored run, its item listing and the CSV export
+func TestReconcileStoresAndExports(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	ctx := context.Background()
+
+	createBatch(t, db, "ledger", []schemas.Transaction{
+		{ID: "L1", Timestamp: 1000, Name: "JOHN DOE", Type: schemas.TypeDebit, Amount: 5000, Status: schemas.StatusSuccess},
+		{ID: "L2", Timestamp: 2000, Name: "JANE DOE", Type: schemas.TypeDebit, Amount: 1050, Status: schemas.StatusSuccess},
+	})
+	createBatch(t, db, "bank", []schemas.Transaction{
+		{ID: "B1", Timestamp: 1100, Name: "JOHN DOE", Type: schemas.TypeDebit, Amount: 5000, Status: schemas.StatusSuccess},
+	})
+
+	reconciliation, err := uc.Reconcile(ctx, schemas.ReconciliationRequest{LeftBatchID: "ledger", RightBatchID: "bank"})
+	if err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	if reconciliation.MatchedCount != 1 || reconciliation.UnmatchedLeftCount != 1 || reconciliation.UnmatchedRightCount != 0 {
+		t.Errorf("Unexpected counts: %+v", reconciliation)
+	}
+	if reconciliation.TimeToleranceSeconds != 3600 || reconciliation.NameThreshold != 0.8 {
+		t.Errorf("Expected configured defaults, got %+v", reconciliation)
+	}
+
+	response, err := uc.GetItems(ctx, reconciliation.ID, schemas.ReconcileUnmatchedLeft, 1, 10)
+	if err != nil {
+		t.Fatalf("GetItems failed: %v", err)
+	}
+	if len(response.Data) != 1 || response.Data[0].Left == nil || response.Data[0].Left.ID != "L2" || response.Data[0].Right != nil {
+		t.Errorf("Unexpected unmatched items: %+v", response.Data)
+	}
+
+	var buf bytes.Buffer
+	if err := uc.ExportCSV(ctx, reconciliation.ID, "", &buf); err != nil {
+		t.Fatalf("ExportCSV failed: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], "result,") {
+		t.Fatalf("Unexpected export: %q", buf.String())
+	}
+	if lines[2] != "unmatched_left,0.00,0,0,L2,2000,JANE DOE,DEBIT,10.50,SUCCESS,,,,,," {
+		t.Errorf("Unexpected export row: %q", lines[2])
+	}
+
+	_, err = uc.Reconcile(ctx, schemas.ReconciliationRequest{LeftBatchID: "ledger", RightBatchID: "missing"})
+	if !errors.Is(err, schemas.ErrBatchNotFound) {
+		t.Errorf("Expected batch not found error, got %v", err)
+	}
+
+	_, err = uc.GetItems(ctx, reconciliation.ID, "unknown", 1, 10)
+	if !errors.Is(err, schemas.ErrInvalidReconciliation) {
+		t.Errorf("Expected invalid reconciliation error, got %v", err)
+	}
+}
@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetRecurring returns weekly and monthly recurring series with their expected next payment
+// as_of (YYYY-MM-DD, UTC) judges the series at the end of that day instead of now
+func (h *Handler) GetRecurring(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "GetRecurring"),
+	)
+
+	filters := schemas.RecurringFilters{
+		Type:   strings.ToUpper(c.Query("type")),
+		Period: strings.ToLower(c.Query("period")),
+		Status: strings.ToLower(c.Query("status")),
+	}
+
+	var asOf time.Time
+	if value := c.Query("as_of"); value != "" {
+		day, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return h.badFilter(c, l, fmt.Errorf("invalid as_of format: expected YYYY-MM-DD"))
+		}
+		asOf = day.AddDate(0, 0, 1).Add(-time.Second)
+	}
+
+	if filters.Type != "" {
+		if err := h.FieldValidator.ValidateTransactionType(filters.Type); err != nil {
+			return h.badFilter(c, l, err)
+		}
+	}
+	if filters.Period != "" && !schemas.ValidRecurringPeriod(filters.Period) {
+		return h.badFilter(c, l, fmt.Errorf("unknown period %q", filters.Period))
+	}
+	if filters.Status != "" && !schemas.ValidRecurringStatus(filters.Status) {
+		return h.badFilter(c, l, fmt.Errorf("unknown status %q", filters.Status))
+	}
+
+	response, err := h.UseCase.GetRecurring(c.Context(), asOf, filters)
+	if err != nil {
+		l.Error("Failed to detect recurring transactions", logger.Error(err))
+		return c.Status(http.StatusInternalServerError).JSON(schemas.ErrorResponse{
+			Status:  http.StatusInternalServerError,
+			Message: constants.MsgFailedToDetectRecurring,
+			Error:   err.Error(),
+		})
+	}
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
+
+// badFilter responds with 400 for an invalid recurring filter
+func (h *Handler) badFilter(c *fiber.Ctx, l *logger.Logger, err error) error {
+	l.Warn("Invalid recurring filter", logger.Error(err))
+	return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+		Status:  http.StatusBadRequest,
+		Message: constants.MsgInvalidRecurringFilter,
+		Error:   err.Error(),
+	})
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"time"
+
+	recurringRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/recurring/repository"
+	recurringUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/recurring/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/config"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/deps"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+)
+
+const ContextName = "Domain.Recurring.Handler"
+
+// Handler defines the recurring handlers
+type Handler struct {
+	Logger         *logger.Logger
+	UseCase        recurringUseCase.IUseCase
+	FieldValidator *validator.FieldValidator
+}
+
+// NewHandler creates a new recurring handler instance with all dependencies
+func NewHandler(d *deps.App) *Handler {
+	// Initialize repository
+	repository := recurringRepo.NewRepository(d.DB.GetDB())
+
+	// Initialize use case
+	cfg := config.GetConfig()
+	grace := time.Duration(cfg.RecurringGraceDays) * 24 * time.Hour
+	lookback := time.Duration(cfg.RecurringLookbackDays) * 24 * time.Hour
+	useCase := recurringUseCase.NewUseCase(repository, cfg.RecurringAmountTolerance, cfg.RecurringMinOccurrences, grace, lookback)
+
+	return &Handler{
+		Logger:         d.Logger,
+		UseCase:        useCase,
+		FieldValidator: d.FieldValidator,
+	}
+}
+
+// RegisterApi registers recurring API routes
+func RegisterApi(d *deps.App) *Handler {
+	handler := NewHandler(d)
+
+	api := d.Fiber.Group("/api")
+
+	api.Get("/recurring", handler.GetRecurring)
+
+	return handler
+}
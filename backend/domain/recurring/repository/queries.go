@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+)
+
+// FindCandidates retrieves SUCCESS and PENDING transactions with timestamps from from to to, oldest first, optionally of one type
+// FAILED transactions never moved money, so they do not count as occurrences
+func (r *Repository) FindCandidates(ctx context.Context, transactionType schemas.TransactionType, from, to int64) ([]Candidate, error) {
+	var rows []Candidate
+
+	query := db.Conn(ctx, r.DB).
+		Model(&schemas.Transaction{}).
+		Select(`transactions.id, transactions.timestamp, transactions.name, transactions.type,
+			transactions.amount, transactions.counterparty_id, counterparties.name AS counterparty_name`).
+		Joins("LEFT JOIN counterparties ON counterparties.id = transactions.counterparty_id").
+		Where("transactions.status IN (?, ?)", schemas.StatusSuccess, schemas.StatusPending).
+		Where("transactions.timestamp BETWEEN ? AND ?", from, to)
+
+	if transactionType != "" {
+		query = query.Where("transactions.type = ?", transactionType)
+	}
+
+	err := query.
+		Order("transactions.timestamp ASC, transactions.id ASC").
+		Scan(&rows).Error
+
+	return rows, err
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/gorm"
+)
+
+// IRepository defines the contract for recurring repository operations
+type IRepository interface {
+	// Queries
+	FindCandidates(ctx context.Context, transactionType schemas.TransactionType, from, to int64) ([]Candidate, error)
+}
+
+// Candidate is a transaction that may belong to a recurring series, with its counterparty name
+type Candidate struct {
+	ID               string
+	Timestamp        int64
+	Name             string
+	Type             schemas.TransactionType
+	Amount           int64
+	CounterpartyID   *string
+	CounterpartyName *string
+}
+
+// Repository implements IRepository
+type Repository struct {
+	DB *gorm.DB
+}
+
+// NewRepository creates a new recurring repository instance
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
@@ -0,0 +1,294 @@
+package use_case
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/recurring/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/fuzzy"
+)
+
+const day = 24 * time.Hour
+
+// IUseCase defines the contract for recurring use case operations
+type IUseCase interface {
+	GetRecurring(ctx context.Context, asOf time.Time, filters schemas.RecurringFilters) (*schemas.RecurringResponse, error)
+}
+
+// UseCase implements IUseCase
+type UseCase struct {
+	Repository      repository.IRepository
+	AmountTolerance float64
+	MinOccurrences  int
+	Grace           time.Duration
+	Lookback        time.Duration
+	Now             func() time.Time
+}
+
+// NewUseCase creates a new recurring use case instance
+// amountTolerance is how far (as a fraction) amounts of one series may differ, minOccurrences how many
+// payments make a series, grace how late a payment may be before it counts as late or missed, and lookback
+// how far before the judged moment transactions are read; zero reads the whole history
+func NewUseCase(repo repository.IRepository, amountTolerance float64, minOccurrences int, grace, lookback time.Duration) IUseCase {
+	return &UseCase{
+		Repository:      repo,
+		AmountTolerance: amountTolerance,
+		MinOccurrences:  minOccurrences,
+		Grace:           grace,
+		Lookback:        lookback,
+		Now:             time.Now,
+	}
+}
+
+// seriesKey groups candidates that can form one series before amounts are compared
+type seriesKey struct {
+	party           string
+	transactionType schemas.TransactionType
+}
+
+// GetRecurring detects weekly and monthly series and reports when each is next expected
+// asOf is the moment series are judged at; zero means now, and later transactions are ignored, as are
+// transactions older than the lookback
+func (uc *UseCase) GetRecurring(ctx context.Context, asOf time.Time, filters schemas.RecurringFilters) (*schemas.RecurringResponse, error) {
+	now := uc.Now().UTC()
+	if asOf.IsZero() {
+		asOf = now
+	}
+	asOf = asOf.UTC()
+
+	var from int64
+	if uc.Lookback > 0 {
+		from = asOf.Add(-uc.Lookback).Unix()
+	}
+	candidates, err := uc.Repository.FindCandidates(ctx, schemas.TransactionType(filters.Type), from, asOf.Unix())
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []seriesKey
+	groups := make(map[seriesKey][]repository.Candidate)
+	for _, c := range candidates {
+		key := seriesKey{party: fuzzy.Normalize(c.Name), transactionType: c.Type}
+		if c.CounterpartyID != nil {
+			key.party = *c.CounterpartyID
+		}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], c)
+	}
+
+	response := &schemas.RecurringResponse{
+		Message:     constants.MsgRecurringRetrieved,
+		AsOf:        asOf.Format(time.RFC3339),
+		Filters:     filtersMeta(filters),
+		Series:      []schemas.RecurringSeries{},
+		GeneratedAt: now.Format(time.RFC3339),
+	}
+
+	for _, key := range keys {
+		for _, cluster := range clusterByAmount(groups[key], uc.AmountTolerance) {
+			series, ok := uc.detect(cluster, asOf)
+			if !ok {
+				continue
+			}
+			if filters.Period != "" && series.Period != filters.Period {
+				continue
+			}
+			if filters.Status != "" && series.Status != filters.Status {
+				continue
+			}
+			response.Series = append(response.Series, series)
+		}
+	}
+
+	sort.SliceStable(response.Series, func(i, j int) bool {
+		return response.Series[i].ExpectedNextAt < response.Series[j].ExpectedNextAt
+	})
+
+	return response, nil
+}
+
+// clusterByAmount splits candidates into groups whose amounts are within tolerance of the
+// smallest amount of the group, keeping each group in timestamp order
+func clusterByAmount(candidates []repository.Candidate, tolerance float64) [][]repository.Candidate {
+	byAmount := make([]repository.Candidate, len(candidates))
+	copy(byAmount, candidates)
+	sort.SliceStable(byAmount, func(i, j int) bool {
+		return byAmount[i].Amount < byAmount[j].Amount
+	})
+
+	var clusters [][]repository.Candidate
+	start := 0
+	for i := 1; i <= len(byAmount); i++ {
+		if i < len(byAmount) && float64(byAmount[i].Amount) <= float64(byAmount[start].Amount)*(1+tolerance) {
+			continue
+		}
+		cluster := byAmount[start:i]
+		sort.SliceStable(cluster, func(a, b int) bool {
+			return cluster[a].Timestamp < cluster[b].Timestamp
+		})
+		clusters = append(clusters, cluster)
+		start = i
+	}
+
+	return clusters
+}
+
+// detect judges whether a cluster repeats weekly or monthly and builds its series
+// The period is taken from the median interval; at least half of the intervals must be a single
+// period (longer ones count as missed payments) and no two payments may fall in the same period
+func (uc *UseCase) detect(cluster []repository.Candidate, asOf time.Time) (schemas.RecurringSeries, bool) {
+	if len(cluster) < 2 || len(cluster) < uc.MinOccurrences {
+		return schemas.RecurringSeries{}, false
+	}
+
+	intervals := make([]float64, len(cluster)-1)
+	for i := 1; i < len(cluster); i++ {
+		intervals[i-1] = float64(cluster[i].Timestamp-cluster[i-1].Timestamp) / day.Seconds()
+	}
+
+	period, ok := classify(median(intervals))
+	if !ok {
+		return schemas.RecurringSeries{}, false
+	}
+
+	first, last := cluster[0], cluster[len(cluster)-1]
+	series := schemas.RecurringSeries{
+		Name:              first.Name,
+		Type:              string(first.Type),
+		Period:            period,
+		Occurrences:       len(cluster),
+		MinAmount:         first.Amount,
+		MaxAmount:         first.Amount,
+		FirstSeen:         first.Timestamp,
+		LastSeen:          last.Timestamp,
+		LastTransactionID: last.ID,
+		Status:            schemas.RecurringOnTrack,
+		LateOccurrences:   []schemas.RecurringOccurrence{},
+	}
+	if last.CounterpartyID != nil {
+		series.CounterpartyID = *last.CounterpartyID
+	}
+	if last.CounterpartyName != nil {
+		series.Name = *last.CounterpartyName
+	}
+
+	amounts := make([]float64, len(cluster))
+	single := 0
+	lastLate := false
+	for i, c := range cluster {
+		amounts[i] = float64(c.Amount)
+		if c.Amount < series.MinAmount {
+			series.MinAmount = c.Amount
+		}
+		if c.Amount > series.MaxAmount {
+			series.MaxAmount = c.Amount
+		}
+		if i == 0 {
+			continue
+		}
+
+		// Count whole periods since the previous payment; a payment up to half a period
+		// early still belongs to the period it was due in
+		previous := time.Unix(cluster[i-1].Timestamp, 0).UTC()
+		actual := time.Unix(c.Timestamp, 0).UTC()
+		periods := 1
+		for !actual.Before(schemas.AddRecurringPeriods(previous, period, periods+1).Add(-halfPeriod(period))) {
+			periods++
+		}
+		if actual.Before(schemas.AddRecurringPeriods(previous, period, 1).Add(-halfPeriod(period))) {
+			return schemas.RecurringSeries{}, false
+		}
+		if periods == 1 {
+			single++
+		}
+		series.MissedCount += periods - 1
+
+		expected := schemas.AddRecurringPeriods(previous, period, periods)
+		lastLate = actual.Sub(expected) > uc.Grace
+		if lastLate {
+			series.LateOccurrences = append(series.LateOccurrences, schemas.RecurringOccurrence{
+				TransactionID: c.ID,
+				Timestamp:     c.Timestamp,
+				Amount:        c.Amount,
+				ExpectedAt:    expected.Unix(),
+				DaysLate:      math.Round(actual.Sub(expected).Hours()/24*10) / 10,
+			})
+		}
+	}
+
+	if single*2 < len(intervals) {
+		return schemas.RecurringSeries{}, false
+	}
+
+	series.ExpectedAmount = int64(math.Round(median(amounts)))
+
+	// Roll the expected date forward over payments that are already overdue
+	lastSeen := time.Unix(last.Timestamp, 0).UTC()
+	next := schemas.AddRecurringPeriods(lastSeen, period, 1)
+	for overdue := 1; asOf.Sub(next) > uc.Grace; overdue++ {
+		series.Status = schemas.RecurringMissed
+		series.MissedCount++
+		next = schemas.AddRecurringPeriods(lastSeen, period, overdue+1)
+	}
+	if series.Status != schemas.RecurringMissed && lastLate {
+		series.Status = schemas.RecurringLate
+	}
+
+	series.ExpectedNextAt = next.Unix()
+	series.ExpectedNextDate = next.Format("2006-01-02")
+
+	return series, true
+}
+
+// classify maps a median interval in days to a period
+func classify(days float64) (string, bool) {
+	switch {
+	case days >= 5 && days <= 9:
+		return schemas.PeriodWeekly, true
+	case days >= 26 && days <= 35:
+		return schemas.PeriodMonthly, true
+	}
+	return "", false
+}
+
+// halfPeriod is how early a payment may come and still count for the period it was due in
+func halfPeriod(period string) time.Duration {
+	if period == schemas.PeriodWeekly {
+		return 3*day + 12*time.Hour
+	}
+	return 15 * day
+}
+
+// median returns the middle value of a slice, averaging the two middle values of an even count
+func median(values []float64) float64 {
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+	return sorted[middle]
+}
+
+// filtersMeta returns the applied filters as response metadata
+func filtersMeta(filters schemas.RecurringFilters) map[string]interface{} {
+	meta := make(map[string]interface{})
+	if filters.Type != "" {
+		meta["type"] = filters.Type
+	}
+	if filters.Period != "" {
+		meta["period"] = filters.Period
+	}
+	if filters.Status != "" {
+		meta["status"] = filters.Status
+	}
+	return meta
+}
@@ -0,0 +1,138 @@
+package use_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/recurring/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupTestUseCase creates a use case backed by an in-memory SQLite database
+func setupTestUseCase(t *testing.T) (IUseCase, *gorm.DB) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to setup test database: %v", err)
+	}
+
+	if err := db.AutoMigrate(&schemas.Transaction{}, &schemas.Counterparty{}); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	return NewUseCase(repository.NewRepository(db), 0.1, 3, 2*24*time.Hour, 0), db
+}
+
+// date returns the Unix timestamp of a UTC date at 09:00
+func date(year int, month time.Month, day int) int64 {
+	return time.Date(year, month, day, 9, 0, 0, 0, time.UTC).Unix()
+}
+
+// seed stores transactions for the recurring tests
+func seed(t *testing.T, db *gorm.DB) {
+	salary, streaming := "cp-salary", "cp-streaming"
+	if err := db.Create(&[]schemas.Counterparty{{ID: salary, Name: "COMPANY A"}, {ID: streaming, Name: "STREAMING CO"}}).Error; err != nil {
+		t.Fatalf("failed to insert counterparties: %v", err)
+	}
+
+	transactions := []schemas.Transaction{
+		// Monthly salary; April came in four days late
+		{ID: "S1", Timestamp: date(2024, 1, 25), Name: "COMPANY A", Type: schemas.TypeCredit, Amount: 1200000, Status: schemas.StatusSuccess, CounterpartyID: &salary},
+		{ID: "S2", Timestamp: date(2024, 2, 25), Name: "COMPANY A", Type: schemas.TypeCredit, Amount: 1200000, Status: schemas.StatusSuccess, CounterpartyID: &salary},
+		{ID: "S3", Timestamp: date(2024, 3, 25), Name: "COMPANY A", Type: schemas.TypeCredit, Amount: 1250000, Status: schemas.StatusSuccess, CounterpartyID: &salary},
+		{ID: "S4", Timestamp: date(2024, 4, 29), Name: "COMPANY A", Type: schemas.TypeCredit, Amount: 1200000, Status: schemas.StatusSuccess, CounterpartyID: &salary},
+		// A one-off bonus from the same company is too far from the salary amount
+		{ID: "S5", Timestamp: date(2024, 3, 1), Name: "COMPANY A", Type: schemas.TypeCredit, Amount: 5000000, Status: schemas.StatusSuccess, CounterpartyID: &salary},
+		// Weekly subscription; the failed attempt does not count
+		{ID: "W1", Timestamp: date(2024, 4, 1), Name: "STREAMING CO", Type: schemas.TypeDebit, Amount: 9900, Status: schemas.StatusSuccess, CounterpartyID: &streaming},
+		{ID: "W2", Timestamp: date(2024, 4, 8), Name: "STREAMING CO", Type: schemas.TypeDebit, Amount: 9900, Status: schemas.StatusSuccess, CounterpartyID: &streaming},
+		{ID: "W3", Timestamp: date(2024, 4, 15), Name: "STREAMING CO", Type: schemas.TypeDebit, Amount: 9900, Status: schemas.StatusSuccess, CounterpartyID: &streaming},
+		{ID: "W4", Timestamp: date(2024, 4, 22), Name: "STREAMING CO", Type: schemas.TypeDebit, Amount: 9900, Status: schemas.StatusFailed, CounterpartyID: &streaming},
+		// Irregular payments without a counterparty never form a series
+		{ID: "R1", Timestamp: date(2024, 1, 2), Name: "shop", Type: schemas.TypeDebit, Amount: 5000, Status: schemas.StatusSuccess},
+		{ID: "R2", Timestamp: date(2024, 1, 3), Name: "SHOP", Type: schemas.TypeDebit, Amount: 5000, Status: schemas.StatusSuccess},
+		{ID: "R3", Timestamp: date(2024, 2, 20), Name: "Shop.", Type: schemas.TypeDebit, Amount: 5000, Status: schemas.StatusSuccess},
+	}
+	if err := db.Create(&transactions).Error; err != nil {
+		t.Fatalf("failed to insert test data: %v", err)
+	}
+}
+
+// TestGetRecurringDetectsSeries tests period detection, late payments and missed payments
+func TestGetRecurringDetectsSeries(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	seed(t, db)
+
+	asOf := time.Date(2024, 5, 1, 23, 59, 59, 0, time.UTC)
+	response, err := uc.GetRecurring(context.Background(), asOf, schemas.RecurringFilters{})
+	if err != nil {
+		t.Fatalf("GetRecurring failed: %v", err)
+	}
+	if len(response.Series) != 2 {
+		t.Fatalf("Expected 2 series, got %+v", response.Series)
+	}
+
+	weekly, monthly := response.Series[0], response.Series[1]
+
+	if weekly.Period != schemas.PeriodWeekly || weekly.Name != "STREAMING CO" || weekly.Occurrences != 3 {
+		t.Errorf("Unexpected weekly series: %+v", weekly)
+	}
+	if weekly.Status != schemas.RecurringMissed || weekly.MissedCount != 2 || weekly.ExpectedNextDate != "2024-05-06" {
+		t.Errorf("Expected two missed weekly payments, got %+v", weekly)
+	}
+
+	if monthly.Period != schemas.PeriodMonthly || monthly.CounterpartyID != "cp-salary" || monthly.Occurrences != 4 {
+		t.Errorf("Unexpected monthly series: %+v", monthly)
+	}
+	if monthly.Status != schemas.RecurringLate || monthly.ExpectedAmount != 1200000 || monthly.ExpectedNextDate != "2024-05-29" {
+		t.Errorf("Expected a late monthly series, got %+v", monthly)
+	}
+	if len(monthly.LateOccurrences) != 1 || monthly.LateOccurrences[0].TransactionID != "S4" || monthly.LateOccurrences[0].DaysLate != 4 {
+		t.Errorf("Expected S4 to be four days late, got %+v", monthly.LateOccurrences)
+	}
+}
+
+// TestGetRecurringAsOfAndFilters tests that later transactions are ignored and filters apply
+func TestGetRecurringAsOfAndFilters(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	seed(t, db)
+
+	asOf := time.Date(2024, 4, 16, 23, 59, 59, 0, time.UTC)
+	response, err := uc.GetRecurring(context.Background(), asOf, schemas.RecurringFilters{Status: schemas.RecurringOnTrack})
+	if err != nil {
+		t.Fatalf("GetRecurring failed: %v", err)
+	}
+	if len(response.Series) != 2 {
+		t.Fatalf("Expected both series on track, got %+v", response.Series)
+	}
+	if response.Series[1].Occurrences != 3 || response.Series[1].ExpectedNextDate != "2024-04-25" {
+		t.Errorf("Expected the April salary to still be due, got %+v", response.Series[1])
+	}
+
+	response, err = uc.GetRecurring(context.Background(), asOf, schemas.RecurringFilters{Type: string(schemas.TypeDebit), Period: schemas.PeriodMonthly})
+	if err != nil {
+		t.Fatalf("GetRecurring failed: %v", err)
+	}
+	if len(response.Series) != 0 {
+		t.Errorf("Expected no monthly debit series, got %+v", response.Series)
+	}
+}
+
+// TestGetRecurringLookback tests that transactions older than the lookback are not read
+func TestGetRecurringLookback(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	seed(t, db)
+	uc.(*UseCase).Lookback = 40 * 24 * time.Hour
+
+	// Only the March and April salaries fall in the window, too few for a monthly series
+	asOf := time.Date(2024, 5, 1, 23, 59, 59, 0, time.UTC)
+	response, err := uc.GetRecurring(context.Background(), asOf, schemas.RecurringFilters{})
+	if err != nil {
+		t.Fatalf("GetRecurring failed: %v", err)
+	}
+	if len(response.Series) != 1 || response.Series[0].Period != schemas.PeriodWeekly {
+		t.Errorf("Expected only the weekly series, got %+v", response.Series)
+	}
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	reportUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/report/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetCategoryReport returns credit and debit totals per category over a date range
+// Only SUCCESS transactions are counted unless another status (or ALL) is requested;
+// net_of_refunds=true nets linked refunds off the transactions they pay back
+func (h *Handler) GetCategoryReport(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "GetCategoryReport"),
+	)
+
+	startDate := c.Query("start_date")
+	endDate := c.Query("end_date")
+	status := strings.ToUpper(c.Query("status", string(schemas.StatusSuccess)))
+
+	if status == reportUseCase.StatusAll {
+		status = ""
+	} else if err := h.FieldValidator.ValidateStatus(status); err != nil {
+		l.Warn("Invalid report status", logger.Error(err))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidReportStatus,
+			Error:   err.Error(),
+		})
+	}
+
+	if err := h.FieldValidator.ValidateDateRange(startDate, endDate); err != nil {
+		l.Warn("Invalid date range", logger.Error(err))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidDateRange,
+			Error:   err.Error(),
+		})
+	}
+
+	netOfRefunds := c.QueryBool("net_of_refunds")
+
+	response, err := h.UseCase.GetCategoryReport(c.Context(), status, startDate, endDate, netOfRefunds)
+	if err != nil {
+		l.Error("Failed to build category report", logger.Error(err))
+		return c.Status(http.StatusInternalServerError).JSON(schemas.ErrorResponse{
+			Status:  http.StatusInternalServerError,
+			Message: constants.MsgFailedToBuildReport,
+			Error:   err.Error(),
+		})
+	}
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
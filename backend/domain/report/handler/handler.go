@@ -0,0 +1,44 @@
+package handler
+
+import (
+	reportRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/report/repository"
+	reportUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/report/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/deps"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+)
+
+const ContextName = "Domain.Report.Handler"
+
+// Handler defines the report handlers
+type Handler struct {
+	Logger         *logger.Logger
+	UseCase        reportUseCase.IUseCase
+	FieldValidator *validator.FieldValidator
+}
+
+// NewHandler creates a new report handler instance with all dependencies
+func NewHandler(d *deps.App) *Handler {
+	// Initialize repository
+	repository := reportRepo.NewRepository(d.DB.GetDB())
+
+	// Initialize use case
+	useCase := reportUseCase.NewUseCase(repository)
+
+	return &Handler{
+		Logger:         d.Logger,
+		UseCase:        useCase,
+		FieldValidator: d.FieldValidator,
+	}
+}
+
+// RegisterApi registers report API routes
+func RegisterApi(d *deps.App) *Handler {
+	handler := NewHandler(d)
+
+	api := d.Fiber.Group("/api")
+
+	api.Get("/reports/by-category", handler.GetCategoryReport)
+
+	return handler
+}
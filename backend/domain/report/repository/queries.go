@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+)
+
+// TotalsByCategory sums transaction amounts per category and type
+// Transactions without a category are grouped under an empty category ID
+// A split transaction is counted through its splits, each under its own category
+func (r *Repository) TotalsByCategory(ctx context.Context, filters ReportFilters) ([]CategoryTypeTotal, error) {
+	var rows []CategoryTypeTotal
+
+	amount := "COALESCE(transaction_splits.amount, transactions.amount)"
+	if filters.NetOfRefunds {
+		// Refunds are spread over the splits in proportion to their amounts
+		amount = amount + ` - CAST(ROUND(1.0 * COALESCE((SELECT SUM(refunds.amount) FROM transactions refunds
+			WHERE refunds.related_transaction_id = transactions.id
+			AND refunds.status = 'SUCCESS' AND refunds.deleted_at IS NULL), 0)
+			* COALESCE(transaction_splits.amount, transactions.amount) / transactions.amount) AS INTEGER)`
+	}
+
+	query := db.Conn(ctx, r.DB).
+		Model(&schemas.Transaction{}).
+		Select(`COALESCE(categories.id, '') AS category_id,
+			COALESCE(categories.name, '') AS category_name,
+			transactions.type AS type,
+			COUNT(DISTINCT transactions.id) AS count,
+			COALESCE(SUM(` + amount + `), 0) AS total`).
+		Joins("LEFT JOIN transaction_splits ON transaction_splits.transaction_id = transactions.id").
+		Joins(`LEFT JOIN categories ON categories.id = CASE WHEN transaction_splits.id IS NULL
+			THEN transactions.category_id ELSE transaction_splits.category_id END`)
+
+	if filters.NetOfRefunds {
+		query = query.Where("transactions.related_transaction_id IS NULL")
+	}
+
+	if filters.Status != "" {
+		query = query.Where("transactions.status = ?", filters.Status)
+	}
+	if filters.From > 0 {
+		query = query.Where("transactions.timestamp >= ?", filters.From)
+	}
+	if filters.To > 0 {
+		query = query.Where("transactions.timestamp < ?", filters.To)
+	}
+
+	err := query.
+		Group("categories.id, categories.name, transactions.type").
+		Order("category_name ASC, type ASC").
+		Scan(&rows).Error
+
+	return rows, err
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/gorm"
+)
+
+// IRepository defines the contract for report repository operations
+type IRepository interface {
+	// Queries
+	TotalsByCategory(ctx context.Context, filters ReportFilters) ([]CategoryTypeTotal, error)
+}
+
+// ReportFilters limits the transactions a report covers
+// From and To are Unix timestamps; zero means unbounded. To is exclusive
+// NetOfRefunds leaves out transactions linked to an original and takes their amounts off the original
+type ReportFilters struct {
+	Status       schemas.TransactionStatus
+	From         int64
+	To           int64
+	NetOfRefunds bool
+}
+
+// CategoryTypeTotal is one row of the totals per category and type query
+type CategoryTypeTotal struct {
+	CategoryID   string
+	CategoryName string
+	Type         schemas.TransactionType
+	Count        int64
+	Total        int64
+}
+
+// Repository implements IRepository
+type Repository struct {
+	DB *gorm.DB
+}
+
+// NewRepository creates a new report repository instance
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
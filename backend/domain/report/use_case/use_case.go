@@ -0,0 +1,113 @@
+package use_case
+
+import (
+	"context"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/report/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+)
+
+const (
+	// UncategorizedName is reported for transactions without a category
+	UncategorizedName = "Uncategorized"
+
+	// StatusAll is reported when a report covers every status
+	StatusAll = "ALL"
+)
+
+// IUseCase defines the contract for report use case operations
+type IUseCase interface {
+	GetCategoryReport(ctx context.Context, status string, startDate string, endDate string, netOfRefunds bool) (*schemas.CategoryReportResponse, error)
+}
+
+// UseCase implements IUseCase
+type UseCase struct {
+	Repository repository.IRepository
+	Now        func() time.Time
+}
+
+// NewUseCase creates a new report use case instance
+func NewUseCase(repo repository.IRepository) IUseCase {
+	return &UseCase{
+		Repository: repo,
+		Now:        time.Now,
+	}
+}
+
+// GetCategoryReport returns credit and debit totals per category
+// Dates are YYYY-MM-DD in UTC, both inclusive, matched against the transaction timestamp
+// An empty status includes every status
+// With netOfRefunds, SUCCESS refunds and reversals are taken off the transaction they pay back
+// instead of being counted on their own
+func (uc *UseCase) GetCategoryReport(ctx context.Context, status string, startDate string, endDate string, netOfRefunds bool) (*schemas.CategoryReportResponse, error) {
+	filters := repository.ReportFilters{Status: schemas.TransactionStatus(status), NetOfRefunds: netOfRefunds}
+
+	if startDate != "" {
+		start, err := time.Parse("2006-01-02", startDate)
+		if err != nil {
+			return nil, err
+		}
+		filters.From = start.Unix()
+	}
+
+	if endDate != "" {
+		end, err := time.Parse("2006-01-02", endDate)
+		if err != nil {
+			return nil, err
+		}
+		filters.To = end.AddDate(0, 0, 1).Unix()
+	}
+
+	totals, err := uc.Repository.TotalsByCategory(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == "" {
+		status = StatusAll
+	}
+
+	response := &schemas.CategoryReportResponse{
+		Message:      constants.MsgCategoryReportRetrieved,
+		Status:       status,
+		StartDate:    startDate,
+		EndDate:      endDate,
+		NetOfRefunds: netOfRefunds,
+		Categories:   []schemas.CategoryReportRow{},
+		GeneratedAt:  uc.Now().UTC().Format(time.RFC3339),
+	}
+
+	rowIndex := make(map[string]int)
+	for _, total := range totals {
+		i, ok := rowIndex[total.CategoryID]
+		if !ok {
+			name := total.CategoryName
+			if total.CategoryID == "" {
+				name = UncategorizedName
+			}
+			response.Categories = append(response.Categories, schemas.CategoryReportRow{
+				CategoryID:   total.CategoryID,
+				CategoryName: name,
+			})
+			i = len(response.Categories) - 1
+			rowIndex[total.CategoryID] = i
+		}
+
+		row := &response.Categories[i]
+		switch total.Type {
+		case schemas.TypeCredit:
+			row.CreditCount += total.Count
+			row.CreditTotal += total.Total
+			response.CreditTotal += total.Total
+		case schemas.TypeDebit:
+			row.DebitCount += total.Count
+			row.DebitTotal += total.Total
+			response.DebitTotal += total.Total
+		}
+		row.Net = row.CreditTotal - row.DebitTotal
+	}
+
+	return response, nil
+}
@@ -0,0 +1,169 @@
+package use_case
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/report/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupTestUseCase creates a use case backed by an in-memory SQLite database
+func setupTestUseCase(t *testing.T) (IUseCase, *gorm.DB) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to setup test database: %v", err)
+	}
+
+	if err := db.AutoMigrate(&schemas.Transaction{}, &schemas.Category{}, &schemas.TransactionSplit{}); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	return NewUseCase(repository.NewRepository(db)), db
+}
+
+// TestGetCategoryReport tests totals per category and type within a date range
+func TestGetCategoryReport(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+
+	food := "food"
+	db.Create(&schemas.Category{ID: food, Name: "Food"})
+
+	day := func(date string) int64 {
+		ts, _ := time.Parse("2006-01-02", date)
+		return ts.Unix() + 3600
+	}
+
+	transactions := []schemas.Transaction{
+		{ID: "1", Timestamp: day("2024-01-01"), Type: schemas.TypeDebit, Amount: 500, Status: schemas.StatusSuccess, CategoryID: &food},
+		{ID: "2", Timestamp: day("2024-01-02"), Type: schemas.TypeDebit, Amount: 300, Status: schemas.StatusSuccess, CategoryID: &food},
+		{ID: "3", Timestamp: day("2024-01-02"), Type: schemas.TypeCredit, Amount: 1000, Status: schemas.StatusSuccess},
+		{ID: "4", Timestamp: day("2024-01-02"), Type: schemas.TypeDebit, Amount: 700, Status: schemas.StatusFailed, CategoryID: &food},
+		{ID: "5", Timestamp: day("2024-02-01"), Type: schemas.TypeDebit, Amount: 900, Status: schemas.StatusSuccess, CategoryID: &food},
+	}
+	if err := db.Create(&transactions).Error; err != nil {
+		t.Fatalf("failed to insert test data: %v", err)
+	}
+
+	report, err := uc.GetCategoryReport(context.Background(), string(schemas.StatusSuccess), "2024-01-01", "2024-01-31", false)
+	if err != nil {
+		t.Fatalf("GetCategoryReport failed: %v", err)
+	}
+
+	if len(report.Categories) != 2 {
+		t.Fatalf("Expected 2 categories, got %+v", report.Categories)
+	}
+
+	byID := make(map[string]schemas.CategoryReportRow)
+	for _, row := range report.Categories {
+		byID[row.CategoryID] = row
+	}
+
+	if row := byID[food]; row.DebitCount != 2 || row.DebitTotal != 800 || row.Net != -800 {
+		t.Errorf("Unexpected food totals: %+v", row)
+	}
+
+	if row := byID[""]; row.CategoryName != UncategorizedName || row.CreditTotal != 1000 {
+		t.Errorf("Unexpected uncategorized totals: %+v", row)
+	}
+
+	if report.CreditTotal != 1000 || report.DebitTotal != 800 {
+		t.Errorf("Unexpected report totals: credit %d, debit %d", report.CreditTotal, report.DebitTotal)
+	}
+}
+
+// TestGetCategoryReportNetOfRefunds tests that linked refunds are netted off the debits they pay back
+func TestGetCategoryReportNetOfRefunds(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+
+	food := "food"
+	db.Create(&schemas.Category{ID: food, Name: "Food"})
+
+	original := "1"
+	transactions := []schemas.Transaction{
+		{ID: "1", Timestamp: 1704067200, Type: schemas.TypeDebit, Amount: 500, Status: schemas.StatusSuccess, CategoryID: &food},
+		{ID: "2", Timestamp: 1704153600, Type: schemas.TypeCredit, Amount: 200, Status: schemas.StatusSuccess, RelatedTransactionID: &original, RelationType: schemas.RelationPartialRefund},
+		{ID: "3", Timestamp: 1704153600, Type: schemas.TypeCredit, Amount: 100, Status: schemas.StatusFailed, RelatedTransactionID: &original, RelationType: schemas.RelationPartialRefund},
+		{ID: "4", Timestamp: 1704153600, Type: schemas.TypeCredit, Amount: 1000, Status: schemas.StatusSuccess},
+	}
+	if err := db.Create(&transactions).Error; err != nil {
+		t.Fatalf("failed to insert test data: %v", err)
+	}
+
+	gross, err := uc.GetCategoryReport(context.Background(), string(schemas.StatusSuccess), "", "", false)
+	if err != nil {
+		t.Fatalf("GetCategoryReport failed: %v", err)
+	}
+	if gross.CreditTotal != 1200 || gross.DebitTotal != 500 {
+		t.Errorf("Unexpected gross totals: credit %d, debit %d", gross.CreditTotal, gross.DebitTotal)
+	}
+
+	net, err := uc.GetCategoryReport(context.Background(), string(schemas.StatusSuccess), "", "", true)
+	if err != nil {
+		t.Fatalf("GetCategoryReport failed: %v", err)
+	}
+	if !net.NetOfRefunds {
+		t.Error("Expected report to be net of refunds")
+	}
+	if net.CreditTotal != 1000 || net.DebitTotal != 300 {
+		t.Errorf("Unexpected net totals: credit %d, debit %d", net.CreditTotal, net.DebitTotal)
+	}
+}
+
+// TestGetCategoryReportUsesSplits tests that split transactions are reported by their splits
+func TestGetCategoryReportUsesSplits(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+
+	food, travel := "food", "travel"
+	db.Create(&[]schemas.Category{{ID: food, Name: "Food"}, {ID: travel, Name: "Travel"}})
+
+	original := "1"
+	transactions := []schemas.Transaction{
+		{ID: "1", Timestamp: 1704067200, Type: schemas.TypeDebit, Amount: 1000, Status: schemas.StatusSuccess, CategoryID: &food},
+		{ID: "2", Timestamp: 1704153600, Type: schemas.TypeCredit, Amount: 500, Status: schemas.StatusSuccess, RelatedTransactionID: &original, RelationType: schemas.RelationPartialRefund},
+	}
+	if err := db.Create(&transactions).Error; err != nil {
+		t.Fatalf("failed to insert test data: %v", err)
+	}
+	db.Create(&[]schemas.TransactionSplit{
+		{ID: "a", TransactionID: "1", Position: 1, Amount: 600, CategoryID: &travel},
+		{ID: "b", TransactionID: "1", Position: 2, Amount: 400},
+	})
+
+	report, err := uc.GetCategoryReport(context.Background(), string(schemas.StatusSuccess), "", "", false)
+	if err != nil {
+		t.Fatalf("GetCategoryReport failed: %v", err)
+	}
+
+	byID := make(map[string]schemas.CategoryReportRow)
+	for _, row := range report.Categories {
+		byID[row.CategoryID] = row
+	}
+	if _, ok := byID[food]; ok {
+		t.Errorf("Expected the parent category to be replaced by the splits, got %+v", report.Categories)
+	}
+	if row := byID[travel]; row.DebitCount != 1 || row.DebitTotal != 600 {
+		t.Errorf("Unexpected travel totals: %+v", row)
+	}
+	if row := byID[""]; row.DebitTotal != 400 || row.CreditTotal != 500 {
+		t.Errorf("Unexpected uncategorized totals: %+v", row)
+	}
+	if report.DebitTotal != 1000 {
+		t.Errorf("Expected splits to sum to the parent amount, got %d", report.DebitTotal)
+	}
+
+	net, err := uc.GetCategoryReport(context.Background(), string(schemas.StatusSuccess), "", "", true)
+	if err != nil {
+		t.Fatalf("GetCategoryReport failed: %v", err)
+	}
+	byID = make(map[string]schemas.CategoryReportRow)
+	for _, row := range net.Categories {
+		byID[row.CategoryID] = row
+	}
+	if byID[travel].DebitTotal != 300 || byID[""].DebitTotal != 200 || net.CreditTotal != 0 {
+		t.Errorf("Expected the refund spread over the splits, got %+v", net.Categories)
+	}
+}
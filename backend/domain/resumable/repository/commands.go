@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+)
+
+// stagingSuffix marks the staging files of upload sessions
+const stagingSuffix = ".part"
+
+// Create creates an upload session record and its empty staging file
+func (r *Repository) Create(ctx context.Context, session *schemas.UploadSession) error {
+	if err := os.MkdirAll(r.Dir, 0o700); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(r.path(session.ID), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	if err := db.Conn(ctx, r.DB).Create(session).Error; err != nil {
+		os.Remove(r.path(session.ID))
+		return err
+	}
+	return nil
+}
+
+// Update saves all fields of an existing upload session record
+func (r *Repository) Update(ctx context.Context, session *schemas.UploadSession) error {
+	return db.Conn(ctx, r.DB).Save(session).Error
+}
+
+// Delete removes an upload session record and its staging file
+// The file is only touched once the record was found, so an unknown ID never reaches the staging directory
+func (r *Repository) Delete(ctx context.Context, id string) error {
+	result := db.Conn(ctx, r.DB).Delete(&schemas.UploadSession{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return schemas.ErrUploadSessionNotFound
+	}
+	return r.RemoveFile(id)
+}
+
+// WriteChunk writes a chunk to the staging file of a session at the given offset and syncs it to disk
+// It returns how many bytes were written, which may be fewer than the chunk on error
+func (r *Repository) WriteChunk(id string, offset int64, chunk io.Reader) (int64, error) {
+	file, err := os.OpenFile(r.path(id), os.O_WRONLY, 0o600)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return 0, err
+	}
+	written, err := io.Copy(file, chunk)
+	if err != nil {
+		return written, err
+	}
+	return written, file.Sync()
+}
+
+// Truncate cuts the staging file of a session back to size, dropping a chunk that failed
+func (r *Repository) Truncate(id string, size int64) error {
+	return os.Truncate(r.path(id), size)
+}
+
+// RemoveFile removes the staging file of a session, if it is still there
+func (r *Repository) RemoveFile(id string) error {
+	err := os.Remove(r.path(id))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// RemoveStaleFiles removes staging files last written before the given time, such as those left by a crash
+func (r *Repository) RemoveStaleFiles(before time.Time) (int, error) {
+	entries, err := os.ReadDir(r.Dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), stagingSuffix) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || !info.ModTime().Before(before) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(r.Dir, entry.Name())); err == nil {
+			removed++
+		}
+	}
+	return removed, nil
+}
+
+// path returns the staging file path of a session
+func (r *Repository) path(id string) string {
+	return filepath.Join(r.Dir, id+stagingSuffix)
+}
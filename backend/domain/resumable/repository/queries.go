@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+)
+
+// FindByID retrieves an upload session by ID
+func (r *Repository) FindByID(ctx context.Context, id string) (*schemas.UploadSession, error) {
+	var session schemas.UploadSession
+	err := db.Conn(ctx, r.DB).Where("id = ?", id).First(&session).Error
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// FindExpired retrieves the upload sessions that expired before now
+func (r *Repository) FindExpired(ctx context.Context, now time.Time) ([]schemas.UploadSession, error) {
+	var sessions []schemas.UploadSession
+	err := db.Conn(ctx, r.DB).Where("expires_at < ?", now).Find(&sessions).Error
+	return sessions, err
+}
+
+// OpenFile opens the staging file of a session for reading
+func (r *Repository) OpenFile(id string) (*os.File, error) {
+	return os.Open(r.path(id))
+}
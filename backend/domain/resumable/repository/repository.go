@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"io"
+	"os"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/gorm"
+)
+
+// IRepository defines the contract for resumable upload repository operations
+// Sessions are kept in the database and their chunks in a staging file per session
+type IRepository interface {
+	// Commands
+	Create(ctx context.Context, session *schemas.UploadSession) error
+	Update(ctx context.Context, session *schemas.UploadSession) error
+	Delete(ctx context.Context, id string) error
+	WriteChunk(id string, offset int64, chunk io.Reader) (int64, error)
+	Truncate(id string, size int64) error
+	RemoveFile(id string) error
+	RemoveStaleFiles(before time.Time) (int, error)
+
+	// Queries
+	FindByID(ctx context.Context, id string) (*schemas.UploadSession, error)
+	FindExpired(ctx context.Context, now time.Time) ([]schemas.UploadSession, error)
+	OpenFile(id string) (*os.File, error)
+}
+
+// Repository implements IRepository
+type Repository struct {
+	DB  *gorm.DB
+	Dir string
+}
+
+// NewRepository creates a new resumable upload repository instance staging chunks under dir
+func NewRepository(db *gorm.DB, dir string) IRepository {
+	return &Repository{
+		DB:  db,
+		Dir: dir,
+	}
+}
@@ -0,0 +1,334 @@
+package use_case
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/resumable/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/requestmeta"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// IUseCase defines the contract for resumable upload use case operations
+type IUseCase interface {
+	CreateSession(ctx context.Context, req schemas.UploadSessionRequest, format string) (*schemas.UploadSession, error)
+	GetSession(ctx context.Context, id string) (*schemas.UploadSession, error)
+	AppendChunk(ctx context.Context, id string, offset int64, chunk io.Reader, checksum []byte) (*schemas.UploadSession, error)
+	OpenCompleted(ctx context.Context, id string) (*os.File, *schemas.UploadSession, error)
+	ReleaseSession(ctx context.Context, id string) error
+	CompleteSession(ctx context.Context, id string, result interface{}) (*schemas.UploadSession, error)
+	DeleteSession(ctx context.Context, id string) error
+	CleanupExpired(ctx context.Context) (int, error)
+}
+
+// UseCase implements IUseCase
+type UseCase struct {
+	Repository repository.IRepository
+	ttl        time.Duration
+	now        func() time.Time
+	locks      sync.Map
+}
+
+// NewUseCase creates a new resumable upload use case instance
+// ttl is how long a session may go without a chunk before it expires and is cleaned up
+func NewUseCase(repo repository.IRepository, ttl time.Duration) IUseCase {
+	return &UseCase{
+		Repository: repo,
+		ttl:        ttl,
+		now:        time.Now,
+	}
+}
+
+// CreateSession starts a resumable upload of a file whose name, format and size the handler has checked
+func (uc *UseCase) CreateSession(ctx context.Context, req schemas.UploadSessionRequest, format string) (*schemas.UploadSession, error) {
+	if req.Size <= 0 {
+		return nil, fmt.Errorf("%w: size must be positive", schemas.ErrInvalidUploadSession)
+	}
+
+	checksum := strings.ToLower(strings.TrimSpace(req.Checksum))
+	if checksum != "" {
+		if digest, err := hex.DecodeString(checksum); err != nil || len(digest) != sha256.Size {
+			return nil, fmt.Errorf("%w: checksum must be a hex SHA-256 digest", schemas.ErrInvalidUploadSession)
+		}
+	}
+
+	source, err := schemas.NormalizeSource(req.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := schemas.ParseOptions{
+		Encoding:         req.Encoding,
+		Delimiter:        req.Delimiter,
+		DecimalSeparator: req.DecimalSeparator,
+	}.Normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.now().UTC()
+	session := &schemas.UploadSession{
+		ID:           uuid.New().String(),
+		Filename:     strings.TrimSpace(req.Filename),
+		Format:       format,
+		Size:         req.Size,
+		Checksum:     checksum,
+		Source:       source,
+		Profile:      strings.TrimSpace(req.Profile),
+		ParseOptions: options,
+		CreatedBy:    requestmeta.FromContext(ctx).Actor,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		ExpiresAt:    now.Add(uc.ttl),
+	}
+	if err := uc.Repository.Create(ctx, session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// GetSession retrieves an upload session by ID
+// An expired session is removed and reported as expired
+func (uc *UseCase) GetSession(ctx context.Context, id string) (*schemas.UploadSession, error) {
+	session, err := uc.Repository.FindByID(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, schemas.ErrUploadSessionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.now().After(session.ExpiresAt) {
+		if err := uc.Repository.Delete(ctx, id); err != nil && !errors.Is(err, schemas.ErrUploadSessionNotFound) {
+			return nil, err
+		}
+		return nil, schemas.ErrUploadSessionExpired
+	}
+	return session, nil
+}
+
+// AppendChunk writes a chunk at the given offset, which must be the number of bytes received so far
+// With a checksum, the SHA-256 of the chunk must match it; a chunk that fails, or would pass the declared size,
+// is dropped so the client can send it again from the same offset. A complete or consumed session takes no chunks
+func (uc *UseCase) AppendChunk(ctx context.Context, id string, offset int64, chunk io.Reader, checksum []byte) (*schemas.UploadSession, error) {
+	session, unlock, err := uc.lock(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if session.Consumed() {
+		return session, schemas.ErrUploadSessionConsumed
+	}
+	if offset != session.Offset {
+		return session, fmt.Errorf("%w: expected offset %d, got %d", schemas.ErrUploadOffsetMismatch, session.Offset, offset)
+	}
+	if session.Complete() {
+		return session, schemas.ErrUploadComplete
+	}
+
+	// Read one byte past the remaining size to notice a chunk that is too long
+	remaining := session.Size - session.Offset
+	hash := sha256.New()
+	written, err := uc.Repository.WriteChunk(id, offset, io.TeeReader(io.LimitReader(chunk, remaining+1), hash))
+	switch {
+	case err != nil:
+	case written > remaining:
+		err = fmt.Errorf("%w: chunk passes the declared size of %d bytes", schemas.ErrInvalidUploadSession, session.Size)
+	case checksum != nil && !bytes.Equal(hash.Sum(nil), checksum):
+		err = schemas.ErrUploadChecksumMismatch
+	}
+	if err != nil {
+		if truncateErr := uc.Repository.Truncate(id, offset); truncateErr != nil {
+			return session, errors.Join(err, truncateErr)
+		}
+		return session, err
+	}
+
+	now := uc.now().UTC()
+	session.Offset += written
+	session.UpdatedAt = now
+	session.ExpiresAt = now.Add(uc.ttl)
+	if err := uc.Repository.Update(ctx, session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// OpenCompleted opens the staged file of a complete session, after checking the checksum of the whole file if one was given,
+// and marks the session consumed so the file is stored only once; ReleaseSession or CompleteSession must follow
+// A file that fails the check cannot be repaired chunk by chunk, so its session is removed
+func (uc *UseCase) OpenCompleted(ctx context.Context, id string) (*os.File, *schemas.UploadSession, error) {
+	session, unlock, err := uc.lock(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer unlock()
+
+	if session.Consumed() {
+		return nil, session, schemas.ErrUploadSessionConsumed
+	}
+	if !session.Complete() {
+		return nil, session, fmt.Errorf("%w: %d of %d bytes received", schemas.ErrUploadIncomplete, session.Offset, session.Size)
+	}
+
+	file, err := uc.Repository.OpenFile(id)
+	if err != nil {
+		return nil, session, err
+	}
+
+	if session.Checksum != "" {
+		hash := sha256.New()
+		if _, err := io.Copy(hash, file); err != nil {
+			file.Close()
+			return nil, session, err
+		}
+		if hex.EncodeToString(hash.Sum(nil)) != session.Checksum {
+			file.Close()
+			if err := uc.Repository.Delete(ctx, id); err != nil {
+				return nil, session, err
+			}
+			return nil, session, fmt.Errorf("%w: the file does not match the checksum given when the upload started", schemas.ErrUploadChecksumMismatch)
+		}
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			file.Close()
+			return nil, session, err
+		}
+	}
+
+	now := uc.now().UTC()
+	session.ConsumedAt = &now
+	session.UpdatedAt = now
+	if err := uc.Repository.Update(ctx, session); err != nil {
+		file.Close()
+		return nil, session, err
+	}
+	return file, session, nil
+}
+
+// ReleaseSession undoes OpenCompleted after the file failed to store, so the client can retry without sending it again
+func (uc *UseCase) ReleaseSession(ctx context.Context, id string) error {
+	session, unlock, err := uc.lock(ctx, id)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	session.ConsumedAt = nil
+	session.UpdatedAt = uc.now().UTC()
+	return uc.Repository.Update(ctx, session)
+}
+
+// CompleteSession keeps the result of a stored file on its session and removes the staged file
+// The session stays until it expires, so a client whose last response was lost can still fetch the result
+func (uc *UseCase) CompleteSession(ctx context.Context, id string, result interface{}) (*schemas.UploadSession, error) {
+	session, unlock, err := uc.lock(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.now().UTC()
+	session.Result = encoded
+	session.UpdatedAt = now
+	session.ExpiresAt = now.Add(uc.ttl)
+	if err := uc.Repository.Update(ctx, session); err != nil {
+		return nil, err
+	}
+	if err := uc.Repository.RemoveFile(id); err != nil {
+		return session, err
+	}
+	return session, nil
+}
+
+// DeleteSession cancels an upload session, or removes one whose file has been stored
+// A session whose file is being stored cannot be cancelled
+func (uc *UseCase) DeleteSession(ctx context.Context, id string) error {
+	session, unlock, err := uc.lock(ctx, id)
+	if errors.Is(err, schemas.ErrUploadSessionExpired) {
+		// GetSession already removed it
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if session.Consumed() && session.Result == nil {
+		return schemas.ErrUploadSessionConsumed
+	}
+	defer uc.locks.Delete(id)
+
+	return uc.Repository.Delete(ctx, id)
+}
+
+// CleanupExpired removes expired sessions and staging files left without a session for longer than the session lifetime
+// It returns the number of sessions removed
+func (uc *UseCase) CleanupExpired(ctx context.Context) (int, error) {
+	now := uc.now()
+	expired, err := uc.Repository.FindExpired(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, session := range expired {
+		err := uc.DeleteSession(ctx, session.ID)
+		if err != nil && !errors.Is(err, schemas.ErrUploadSessionNotFound) {
+			return removed, err
+		}
+		removed++
+	}
+
+	if _, err := uc.Repository.RemoveStaleFiles(now.Add(-uc.ttl)); err != nil {
+		return removed, err
+	}
+	return removed, nil
+}
+
+// lock serializes the requests of one session, so concurrent chunks cannot both write at the same offset, and returns the session
+// The session is looked up before a mutex is stored for it and again once it is held; unknown and expired sessions leave
+// no mutex behind, so requests for random IDs cannot grow the lock map
+func (uc *UseCase) lock(ctx context.Context, id string) (*schemas.UploadSession, func(), error) {
+	if _, err := uc.GetSession(ctx, id); err != nil {
+		uc.evict(id, err)
+		return nil, nil, err
+	}
+
+	value, _ := uc.locks.LoadOrStore(id, &sync.Mutex{})
+	mu := value.(*sync.Mutex)
+	mu.Lock()
+
+	// The session may have been removed while waiting for the mutex
+	session, err := uc.GetSession(ctx, id)
+	if err != nil {
+		uc.evict(id, err)
+		mu.Unlock()
+		return nil, nil, err
+	}
+	return session, mu.Unlock, nil
+}
+
+// evict drops the mutex of a session that err reports as gone
+func (uc *UseCase) evict(id string, err error) {
+	if errors.Is(err, schemas.ErrUploadSessionNotFound) || errors.Is(err, schemas.ErrUploadSessionExpired) {
+		uc.locks.Delete(id)
+	}
+}
@@ -0,0 +1,316 @@
+package use_case
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/resumable/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+const testFile = "timestamp,name,type,amount,status,description\n1624507883,JOHN DOE,DEBIT,250000,SUCCESS,restaurant\n"
+
+// setupTestUseCase creates a use case backed by an in-memory SQLite database and a temporary staging directory
+func setupTestUseCase(t *testing.T) (*UseCase, string) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to setup test database: %v", err)
+	}
+
+	if err := db.AutoMigrate(&schemas.UploadSession{}); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	dir := t.TempDir()
+	return NewUseCase(repository.NewRepository(db, dir), time.Hour).(*UseCase), dir
+}
+
+func digest(s string) []byte {
+	sum := sha256.Sum256([]byte(s))
+	return sum[:]
+}
+
+// createSession starts an upload of testFile with its checksum
+func createSession(t *testing.T, uc *UseCase) *schemas.UploadSession {
+	session, err := uc.CreateSession(context.Background(), schemas.UploadSessionRequest{
+		Filename: "transactions.csv",
+		Size:     int64(len(testFile)),
+		Checksum: strings.ToUpper(hex.EncodeToString(digest(testFile))),
+		Source:   "Bank",
+	}, validator.FormatCSV)
+	if err != nil {
+		t.Fatalf("CreateSession failed: %v", err)
+	}
+	return session
+}
+
+// TestCreateSessionValidation tests size, checksum, source and parse option checks
+func TestCreateSessionValidation(t *testing.T) {
+	uc, _ := setupTestUseCase(t)
+	ctx := context.Background()
+
+	session := createSession(t, uc)
+	if session.Offset != 0 || session.Source != "bank" || session.Checksum != hex.EncodeToString(digest(testFile)) {
+		t.Errorf("Unexpected session: %+v", session)
+	}
+
+	tests := []struct {
+		name     string
+		req      schemas.UploadSessionRequest
+		expected error
+	}{
+		{"zero size", schemas.UploadSessionRequest{Filename: "a.csv"}, schemas.ErrInvalidUploadSession},
+		{"bad checksum", schemas.UploadSessionRequest{Filename: "a.csv", Size: 1, Checksum: "abc"}, schemas.ErrInvalidUploadSession},
+		{"bad source", schemas.UploadSessionRequest{Filename: "a.csv", Size: 1, Source: "bad source"}, schemas.ErrInvalidSource},
+		{"bad delimiter", schemas.UploadSessionRequest{Filename: "a.csv", Size: 1, Delimiter: "#"}, schemas.ErrInvalidParseOptions},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := uc.CreateSession(ctx, tt.req, validator.FormatCSV); !errors.Is(err, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+// TestAppendChunk tests resuming from the stored offset, and dropping chunks that fail their checks
+func TestAppendChunk(t *testing.T) {
+	uc, _ := setupTestUseCase(t)
+	ctx := context.Background()
+	session := createSession(t, uc)
+	first, rest := testFile[:20], testFile[20:]
+
+	session, err := uc.AppendChunk(ctx, session.ID, 0, strings.NewReader(first), digest(first))
+	if err != nil {
+		t.Fatalf("AppendChunk failed: %v", err)
+	}
+	if session.Offset != 20 || session.Complete() {
+		t.Fatalf("Expected offset 20, got %d", session.Offset)
+	}
+
+	// A chunk sent again from an old offset is rejected with the current offset
+	session, err = uc.AppendChunk(ctx, session.ID, 0, strings.NewReader(first), nil)
+	if !errors.Is(err, schemas.ErrUploadOffsetMismatch) || session.Offset != 20 {
+		t.Errorf("Expected offset mismatch at 20, got %v", err)
+	}
+
+	// A corrupted chunk is dropped
+	if _, err := uc.AppendChunk(ctx, session.ID, 20, strings.NewReader(strings.ToUpper(rest)), digest(rest)); !errors.Is(err, schemas.ErrUploadChecksumMismatch) {
+		t.Errorf("Expected checksum mismatch, got %v", err)
+	}
+
+	// A chunk past the declared size is dropped
+	if _, err := uc.AppendChunk(ctx, session.ID, 20, strings.NewReader(rest+"extra"), nil); !errors.Is(err, schemas.ErrInvalidUploadSession) {
+		t.Errorf("Expected invalid upload session for an oversized chunk, got %v", err)
+	}
+
+	if _, _, err := uc.OpenCompleted(ctx, session.ID); !errors.Is(err, schemas.ErrUploadIncomplete) {
+		t.Errorf("Expected incomplete upload, got %v", err)
+	}
+
+	session, err = uc.AppendChunk(ctx, session.ID, 20, strings.NewReader(rest), digest(rest))
+	if err != nil {
+		t.Fatalf("AppendChunk failed: %v", err)
+	}
+	if !session.Complete() {
+		t.Fatalf("Expected complete session, got offset %d of %d", session.Offset, session.Size)
+	}
+
+	file, _, err := uc.OpenCompleted(ctx, session.ID)
+	if err != nil {
+		t.Fatalf("OpenCompleted failed: %v", err)
+	}
+	content, _ := io.ReadAll(file)
+	file.Close()
+	if string(content) != testFile {
+		t.Errorf("Expected staged file to match the upload, got %q", content)
+	}
+
+	if _, err := uc.CompleteSession(ctx, session.ID, map[string]int{"total_records": 1}); err != nil {
+		t.Fatalf("CompleteSession failed: %v", err)
+	}
+	if err := uc.DeleteSession(ctx, session.ID); err != nil {
+		t.Fatalf("DeleteSession failed: %v", err)
+	}
+	if _, err := uc.GetSession(ctx, session.ID); !errors.Is(err, schemas.ErrUploadSessionNotFound) {
+		t.Errorf("Expected session not found after delete, got %v", err)
+	}
+}
+
+// TestCompletedSessionStoresOnce tests that a complete file is handed out for storing once, can be retried after a failure,
+// and keeps its result once stored
+func TestCompletedSessionStoresOnce(t *testing.T) {
+	uc, dir := setupTestUseCase(t)
+	ctx := context.Background()
+	session := createSession(t, uc)
+
+	if _, err := uc.AppendChunk(ctx, session.ID, 0, strings.NewReader(testFile), nil); err != nil {
+		t.Fatalf("AppendChunk failed: %v", err)
+	}
+	file, _, err := uc.OpenCompleted(ctx, session.ID)
+	if err != nil {
+		t.Fatalf("OpenCompleted failed: %v", err)
+	}
+	file.Close()
+
+	// While the file is stored, neither an empty chunk nor another open nor a cancel gets through
+	if _, err := uc.AppendChunk(ctx, session.ID, session.Size, strings.NewReader(""), nil); !errors.Is(err, schemas.ErrUploadSessionConsumed) {
+		t.Errorf("Expected consumed session, got %v", err)
+	}
+	if _, _, err := uc.OpenCompleted(ctx, session.ID); !errors.Is(err, schemas.ErrUploadSessionConsumed) {
+		t.Errorf("Expected consumed session, got %v", err)
+	}
+	if err := uc.DeleteSession(ctx, session.ID); !errors.Is(err, schemas.ErrUploadSessionConsumed) {
+		t.Errorf("Expected consumed session, got %v", err)
+	}
+
+	// A failed store releases the session, which is complete and takes no more chunks but can be opened again
+	if err := uc.ReleaseSession(ctx, session.ID); err != nil {
+		t.Fatalf("ReleaseSession failed: %v", err)
+	}
+	if _, err := uc.AppendChunk(ctx, session.ID, session.Size, strings.NewReader(""), nil); !errors.Is(err, schemas.ErrUploadComplete) {
+		t.Errorf("Expected complete session, got %v", err)
+	}
+	file, _, err = uc.OpenCompleted(ctx, session.ID)
+	if err != nil {
+		t.Fatalf("OpenCompleted after release failed: %v", err)
+	}
+	file.Close()
+
+	if _, err := uc.CompleteSession(ctx, session.ID, map[string]int{"total_records": 1}); err != nil {
+		t.Fatalf("CompleteSession failed: %v", err)
+	}
+	stored, err := uc.GetSession(ctx, session.ID)
+	if err != nil {
+		t.Fatalf("GetSession failed: %v", err)
+	}
+	if string(stored.Result) != `{"total_records":1}` {
+		t.Errorf("Expected the result to be kept, got %s", stored.Result)
+	}
+	if _, err := os.Stat(filepath.Join(dir, session.ID+".part")); !os.IsNotExist(err) {
+		t.Errorf("Expected the staged file to be removed, got %v", err)
+	}
+	if _, _, err := uc.OpenCompleted(ctx, session.ID); !errors.Is(err, schemas.ErrUploadSessionConsumed) {
+		t.Errorf("Expected consumed session, got %v", err)
+	}
+}
+
+// TestOpenCompletedChecksumMismatch tests that a file not matching the checksum given at the start is removed
+func TestOpenCompletedChecksumMismatch(t *testing.T) {
+	uc, _ := setupTestUseCase(t)
+	ctx := context.Background()
+	session := createSession(t, uc)
+
+	other := strings.Replace(testFile, "250000", "990000", 1)
+	if _, err := uc.AppendChunk(ctx, session.ID, 0, strings.NewReader(other), nil); err != nil {
+		t.Fatalf("AppendChunk failed: %v", err)
+	}
+
+	if _, _, err := uc.OpenCompleted(ctx, session.ID); !errors.Is(err, schemas.ErrUploadChecksumMismatch) {
+		t.Errorf("Expected checksum mismatch, got %v", err)
+	}
+	if _, err := uc.GetSession(ctx, session.ID); !errors.Is(err, schemas.ErrUploadSessionNotFound) {
+		t.Errorf("Expected session to be removed, got %v", err)
+	}
+}
+
+// TestCleanupExpired tests that expired sessions and orphaned staging files are removed
+func TestCleanupExpired(t *testing.T) {
+	uc, dir := setupTestUseCase(t)
+	ctx := context.Background()
+	start := time.Now()
+
+	expired := createSession(t, uc)
+	orphan := filepath.Join(dir, "orphan.part")
+	if err := os.WriteFile(orphan, []byte("partial"), 0600); err != nil {
+		t.Fatalf("failed to write orphan file: %v", err)
+	}
+	old := start.Add(-2 * time.Hour)
+	os.Chtimes(orphan, old, old)
+
+	// A chunk keeps the other session alive past the expiry of the first
+	uc.now = func() time.Time { return start.Add(50 * time.Minute) }
+	active := createSession(t, uc)
+	if _, err := uc.AppendChunk(ctx, active.ID, 0, strings.NewReader(testFile[:10]), nil); err != nil {
+		t.Fatalf("AppendChunk failed: %v", err)
+	}
+	written := start.Add(50 * time.Minute)
+	os.Chtimes(filepath.Join(dir, active.ID+".part"), written, written)
+
+	uc.now = func() time.Time { return start.Add(90 * time.Minute) }
+	removed, err := uc.CleanupExpired(ctx)
+	if err != nil {
+		t.Fatalf("CleanupExpired failed: %v", err)
+	}
+	if removed != 1 {
+		t.Errorf("Expected 1 session removed, got %d", removed)
+	}
+	if _, err := os.Stat(filepath.Join(dir, expired.ID+".part")); !os.IsNotExist(err) {
+		t.Errorf("Expected staging file of expired session to be removed, got %v", err)
+	}
+	if _, err := os.Stat(orphan); !os.IsNotExist(err) {
+		t.Errorf("Expected orphaned staging file to be removed, got %v", err)
+	}
+	if _, err := uc.GetSession(ctx, active.ID); err != nil {
+		t.Errorf("Expected active session to remain, got %v", err)
+	}
+
+	uc.now = func() time.Time { return start.Add(3 * time.Hour) }
+	if _, err := uc.GetSession(ctx, active.ID); !errors.Is(err, schemas.ErrUploadSessionExpired) {
+		t.Errorf("Expected expired session, got %v", err)
+	}
+}
+
+// TestUnknownSessionsLeaveNoTrace tests that requests for unknown or expired sessions keep no lock and touch no staging file
+func TestUnknownSessionsLeaveNoTrace(t *testing.T) {
+	uc, dir := setupTestUseCase(t)
+	ctx := context.Background()
+
+	// A staging file without a session, named like the ID asked for
+	stray := filepath.Join(dir, "unknown.part")
+	if err := os.WriteFile(stray, []byte("partial"), 0600); err != nil {
+		t.Fatalf("failed to write stray file: %v", err)
+	}
+
+	if _, err := uc.AppendChunk(ctx, "unknown", 0, strings.NewReader(testFile), nil); !errors.Is(err, schemas.ErrUploadSessionNotFound) {
+		t.Errorf("Expected session not found, got %v", err)
+	}
+	if _, _, err := uc.OpenCompleted(ctx, "unknown"); !errors.Is(err, schemas.ErrUploadSessionNotFound) {
+		t.Errorf("Expected session not found, got %v", err)
+	}
+	if err := uc.DeleteSession(ctx, "unknown"); !errors.Is(err, schemas.ErrUploadSessionNotFound) {
+		t.Errorf("Expected session not found, got %v", err)
+	}
+	if _, err := os.Stat(stray); err != nil {
+		t.Errorf("Expected the stray file to be left alone, got %v", err)
+	}
+
+	session := createSession(t, uc)
+	if _, err := uc.AppendChunk(ctx, session.ID, 0, strings.NewReader(testFile[:10]), nil); err != nil {
+		t.Fatalf("AppendChunk failed: %v", err)
+	}
+	uc.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	if _, err := uc.AppendChunk(ctx, session.ID, 10, strings.NewReader(testFile[10:]), nil); !errors.Is(err, schemas.ErrUploadSessionExpired) {
+		t.Errorf("Expected session expired, got %v", err)
+	}
+
+	locks := 0
+	uc.locks.Range(func(_, _ interface{}) bool {
+		locks++
+		return true
+	})
+	if locks != 0 {
+		t.Errorf("Expected no locks left, got %d", locks)
+	}
+}
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ApplyRule back-applies a rule to existing transactions
+// The body is optional; {"overwrite": true} also replaces categories that are already set
+func (h *Handler) ApplyRule(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "ApplyRule"),
+	)
+
+	id := c.Params("id")
+
+	var req schemas.RuleApplyRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			l.Warn("Invalid request body", logger.Error(err))
+			return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+				Status:  http.StatusBadRequest,
+				Message: constants.MsgInvalidRequestBody,
+				Error:   err.Error(),
+			})
+		}
+	}
+
+	response, err := h.UseCase.ApplyRule(c.Context(), id, req)
+	if err != nil {
+		l.Warn("Failed to apply rule", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToApplyRule)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Rule applied",
+		logger.String("id", id),
+		logger.Int("matched", response.Matched),
+		logger.Int("categorized", response.Categorized),
+	)
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// CreateRule stores a new categorisation rule
+func (h *Handler) CreateRule(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "CreateRule"),
+	)
+
+	var req schemas.RuleRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.Warn("Invalid request body", logger.Error(err))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidRuleBody,
+			Error:   err.Error(),
+		})
+	}
+
+	rule, err := h.UseCase.CreateRule(c.Context(), req)
+	if err != nil {
+		l.Warn("Failed to create rule", logger.Error(err))
+		errResp := errorResponse(err, constants.MsgFailedToSaveRule)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Rule created", logger.String("id", rule.ID))
+
+	return c.Status(http.StatusCreated).JSON(schemas.SuccessResponse{
+		Status: http.StatusCreated,
+		Data:   rule,
+	})
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// DeleteRule removes a rule
+func (h *Handler) DeleteRule(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "DeleteRule"),
+	)
+
+	id := c.Params("id")
+	if err := h.UseCase.DeleteRule(c.Context(), id); err != nil {
+		l.Warn("Failed to delete rule", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToDeleteRule)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Rule deleted", logger.String("id", id))
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data: fiber.Map{
+			"message": constants.MsgRuleDeleted,
+		},
+	})
+}
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ListRules returns all rules in evaluation order
+func (h *Handler) ListRules(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "ListRules"),
+	)
+
+	rules, err := h.UseCase.ListRules(c.Context())
+	if err != nil {
+		l.Error("Failed to retrieve rules", logger.Error(err))
+		errResp := errorResponse(err, constants.MsgFailedToRetrieveRules)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   rules,
+	})
+}
+
+// GetRule returns a single rule by ID
+func (h *Handler) GetRule(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "GetRule"),
+	)
+
+	id := c.Params("id")
+	rule, err := h.UseCase.GetRule(c.Context(), id)
+	if err != nil {
+		l.Warn("Failed to retrieve rule", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToRetrieveRules)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   rule,
+	})
+}
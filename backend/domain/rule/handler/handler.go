@@ -0,0 +1,50 @@
+package handler
+
+import (
+	auditRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/repository"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	ruleRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/rule/repository"
+	ruleUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/rule/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/deps"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+)
+
+const ContextName = "Domain.Rule.Handler"
+
+// Handler defines the categorisation rule handlers
+type Handler struct {
+	Logger  *logger.Logger
+	UseCase ruleUseCase.IUseCase
+}
+
+// NewHandler creates a new rule handler instance with all dependencies
+func NewHandler(d *deps.App) *Handler {
+	// Initialize repository
+	repository := ruleRepo.NewRepository(d.DB.GetDB())
+
+	// Initialize use case
+	audit := auditUseCase.NewUseCase(auditRepo.NewRepository(d.DB.GetDB()))
+	useCase := ruleUseCase.NewUseCase(repository, audit)
+
+	return &Handler{
+		Logger:  d.Logger,
+		UseCase: useCase,
+	}
+}
+
+// RegisterApi registers rule API routes
+func RegisterApi(d *deps.App) *Handler {
+	handler := NewHandler(d)
+
+	api := d.Fiber.Group("/api")
+
+	api.Get("/rules", handler.ListRules)
+	api.Post("/rules", handler.CreateRule)
+	api.Post("/rules/test", handler.TestRule)
+	api.Get("/rules/:id", handler.GetRule)
+	api.Put("/rules/:id", handler.UpdateRule)
+	api.Delete("/rules/:id", handler.DeleteRule)
+	api.Post("/rules/:id/apply", handler.ApplyRule)
+
+	return handler
+}
@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// TestRule shows which existing transactions a rule in the request body would match
+// Nothing is stored or changed
+func (h *Handler) TestRule(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "TestRule"),
+	)
+
+	var req schemas.RuleRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.Warn("Invalid request body", logger.Error(err))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidRuleBody,
+			Error:   err.Error(),
+		})
+	}
+
+	response, err := h.UseCase.TestRule(c.Context(), req)
+	if err != nil {
+		l.Warn("Failed to test rule", logger.Error(err))
+		errResp := errorResponse(err, constants.MsgFailedToTestRule)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
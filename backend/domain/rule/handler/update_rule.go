@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// UpdateRule changes a rule; fields left out of the request keep their current values
+func (h *Handler) UpdateRule(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "UpdateRule"),
+	)
+
+	id := c.Params("id")
+
+	var req schemas.RuleRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.Warn("Invalid request body", logger.Error(err))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidRuleBody,
+			Error:   err.Error(),
+		})
+	}
+
+	rule, err := h.UseCase.UpdateRule(c.Context(), id, req)
+	if err != nil {
+		l.Warn("Failed to update rule", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToSaveRule)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Rule updated", logger.String("id", id))
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   rule,
+	})
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+	"gorm.io/gorm/clause"
+)
+
+// Create creates a rule record
+func (r *Repository) Create(ctx context.Context, rule *schemas.Rule) error {
+	return db.Conn(ctx, r.DB).Create(rule).Error
+}
+
+// Update saves all fields of an existing rule record
+func (r *Repository) Update(ctx context.Context, rule *schemas.Rule) error {
+	return db.Conn(ctx, r.DB).Save(rule).Error
+}
+
+// Delete removes a rule record
+func (r *Repository) Delete(ctx context.Context, id string) error {
+	result := db.Conn(ctx, r.DB).Delete(&schemas.Rule{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return schemas.ErrRuleNotFound
+	}
+	return nil
+}
+
+// ApplyCategory sets the category of the given transactions
+// Without overwrite only transactions without a category are changed
+func (r *Repository) ApplyCategory(ctx context.Context, transactionIDs []string, categoryID string, overwrite bool) (int64, error) {
+	query := db.Conn(ctx, r.DB).
+		Model(&schemas.Transaction{}).
+		Where("id IN ?", transactionIDs)
+	if !overwrite {
+		query = query.Where("category_id IS NULL")
+	}
+
+	result := query.UpdateColumn("category_id", categoryID)
+	return result.RowsAffected, result.Error
+}
+
+// AddTags stores tags, skipping ones a transaction already has
+func (r *Repository) AddTags(ctx context.Context, tags []schemas.TransactionTag) (int64, error) {
+	if len(tags) == 0 {
+		return 0, nil
+	}
+
+	result := db.Conn(ctx, r.DB).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		CreateInBatches(tags, 100)
+	return result.RowsAffected, result.Error
+}
+
+// Transaction runs fn in a database transaction, which the repositories called with its context join
+func (r *Repository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return db.Transaction(ctx, r.DB, fn)
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+	"gorm.io/gorm"
+)
+
+// FindAll retrieves all rules in evaluation order
+func (r *Repository) FindAll(ctx context.Context) ([]schemas.Rule, error) {
+	var rules []schemas.Rule
+	err := db.Conn(ctx, r.DB).Order("priority ASC, created_at ASC").Find(&rules).Error
+	return rules, err
+}
+
+// FindByID retrieves a rule by ID
+func (r *Repository) FindByID(ctx context.Context, id string) (*schemas.Rule, error) {
+	var rule schemas.Rule
+	err := db.Conn(ctx, r.DB).Where("id = ?", id).First(&rule).Error
+	if err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// CategoryExists checks whether a category ID exists
+func (r *Repository) CategoryExists(ctx context.Context, categoryID string) (bool, error) {
+	var count int64
+	err := db.Conn(ctx, r.DB).Model(&schemas.Category{}).Where("id = ?", categoryID).Count(&count).Error
+	return count > 0, err
+}
+
+// IterateTransactions walks all live transactions in batches
+func (r *Repository) IterateTransactions(ctx context.Context, batchSize int, fn func(transactions []schemas.Transaction) error) error {
+	var batch []schemas.Transaction
+	return db.Conn(ctx, r.DB).FindInBatches(&batch, batchSize, func(tx *gorm.DB, _ int) error {
+		return fn(batch)
+	}).Error
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/gorm"
+)
+
+// IRepository defines the contract for rule repository operations
+type IRepository interface {
+	// Commands
+	Create(ctx context.Context, rule *schemas.Rule) error
+	Update(ctx context.Context, rule *schemas.Rule) error
+	Delete(ctx context.Context, id string) error
+	ApplyCategory(ctx context.Context, transactionIDs []string, categoryID string, overwrite bool) (int64, error)
+	AddTags(ctx context.Context, tags []schemas.TransactionTag) (int64, error)
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+
+	// Queries
+	FindAll(ctx context.Context) ([]schemas.Rule, error)
+	FindByID(ctx context.Context, id string) (*schemas.Rule, error)
+	CategoryExists(ctx context.Context, categoryID string) (bool, error)
+	IterateTransactions(ctx context.Context, batchSize int, fn func(transactions []schemas.Transaction) error) error
+}
+
+// Repository implements IRepository
+type Repository struct {
+	DB *gorm.DB
+}
+
+// NewRepository creates a new rule repository instance
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
@@ -0,0 +1,343 @@
+package use_case
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	auditSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/rule/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// scanBatchSize is how many transactions are evaluated at a time when applying or testing a rule
+	scanBatchSize = 500
+
+	// maxTestMatches limits how many matching transactions a rule test returns
+	maxTestMatches = 100
+)
+
+// IUseCase defines the contract for rule use case operations
+type IUseCase interface {
+	ListRules(ctx context.Context) ([]schemas.Rule, error)
+	GetRule(ctx context.Context, id string) (*schemas.Rule, error)
+	CreateRule(ctx context.Context, req schemas.RuleRequest) (*schemas.Rule, error)
+	UpdateRule(ctx context.Context, id string, req schemas.RuleRequest) (*schemas.Rule, error)
+	DeleteRule(ctx context.Context, id string) error
+	ApplyRule(ctx context.Context, id string, req schemas.RuleApplyRequest) (*schemas.RuleApplyResponse, error)
+	TestRule(ctx context.Context, req schemas.RuleRequest) (*schemas.RuleTestResponse, error)
+	Categorize(ctx context.Context, transactions []schemas.Transaction) ([]schemas.TransactionTag, error)
+}
+
+// UseCase implements IUseCase
+type UseCase struct {
+	Repository repository.IRepository
+	Audit      auditUseCase.IUseCase
+}
+
+// NewUseCase creates a new rule use case instance
+func NewUseCase(repo repository.IRepository, audit auditUseCase.IUseCase) IUseCase {
+	return &UseCase{
+		Repository: repo,
+		Audit:      audit,
+	}
+}
+
+// ListRules retrieves all rules in evaluation order
+func (uc *UseCase) ListRules(ctx context.Context) ([]schemas.Rule, error) {
+	rules, err := uc.Repository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if rules == nil {
+		rules = []schemas.Rule{}
+	}
+	return rules, nil
+}
+
+// GetRule retrieves a single rule by ID
+func (uc *UseCase) GetRule(ctx context.Context, id string) (*schemas.Rule, error) {
+	rule, err := uc.Repository.FindByID(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, schemas.ErrRuleNotFound
+	}
+	return rule, err
+}
+
+// CreateRule validates and stores a new rule
+// New rules are enabled and match all conditions unless the request says otherwise
+// The category check, the insert and the audit event run in one database transaction
+func (uc *UseCase) CreateRule(ctx context.Context, req schemas.RuleRequest) (*schemas.Rule, error) {
+	rule := &schemas.Rule{
+		ID:      uuid.New().String(),
+		Enabled: true,
+		Match:   schemas.RuleMatchAll,
+	}
+	err := uc.Repository.Transaction(ctx, func(ctx context.Context) error {
+		if err := uc.applyRequest(ctx, rule, req); err != nil {
+			return err
+		}
+
+		if err := uc.Repository.Create(ctx, rule); err != nil {
+			return err
+		}
+
+		return uc.Audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionRuleCreate,
+			TargetType: auditSchemas.TargetRule,
+			TargetID:   rule.ID,
+			After:      rule,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// UpdateRule changes the fields present in the request
+// The lookup, the category check, the update and the audit event run in one database transaction
+func (uc *UseCase) UpdateRule(ctx context.Context, id string, req schemas.RuleRequest) (*schemas.Rule, error) {
+	var rule *schemas.Rule
+	err := uc.Repository.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		rule, err = uc.GetRule(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		before := *rule
+		if err := uc.applyRequest(ctx, rule, req); err != nil {
+			return err
+		}
+
+		if err := uc.Repository.Update(ctx, rule); err != nil {
+			return err
+		}
+
+		return uc.Audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionRuleUpdate,
+			TargetType: auditSchemas.TargetRule,
+			TargetID:   rule.ID,
+			Before:     &before,
+			After:      rule,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// DeleteRule removes a rule; categories and tags it already set are kept
+// The lookup, the delete and the audit event run in one database transaction
+func (uc *UseCase) DeleteRule(ctx context.Context, id string) error {
+	return uc.Repository.Transaction(ctx, func(ctx context.Context) error {
+		rule, err := uc.GetRule(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.Repository.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return uc.Audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionRuleDelete,
+			TargetType: auditSchemas.TargetRule,
+			TargetID:   id,
+			Before:     rule,
+		})
+	})
+}
+
+// ApplyRule back-applies a rule to existing transactions
+// The rule is applied even when it is disabled, so it can be tried before it runs on uploads
+// Every batch and the audit event run in one database transaction, so a failure part way leaves no transaction changed
+func (uc *UseCase) ApplyRule(ctx context.Context, id string, req schemas.RuleApplyRequest) (*schemas.RuleApplyResponse, error) {
+	response := &schemas.RuleApplyResponse{Message: constants.MsgRuleApplied}
+	err := uc.Repository.Transaction(ctx, func(ctx context.Context) error {
+		rule, err := uc.GetRule(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		compiled, err := rule.Compile()
+		if err != nil {
+			return err
+		}
+
+		err = uc.Repository.IterateTransactions(ctx, scanBatchSize, func(transactions []schemas.Transaction) error {
+			var ids []string
+			var tags []schemas.TransactionTag
+			for i := range transactions {
+				if !compiled.Matches(&transactions[i]) {
+					continue
+				}
+				ids = append(ids, transactions[i].ID)
+				for _, tag := range rule.Tags {
+					tags = append(tags, schemas.TransactionTag{TransactionID: transactions[i].ID, Tag: tag, CreatedAt: time.Now()})
+				}
+			}
+
+			if len(ids) == 0 {
+				return nil
+			}
+			response.Matched += len(ids)
+
+			if rule.CategoryID != nil {
+				categorized, err := uc.Repository.ApplyCategory(ctx, ids, *rule.CategoryID, req.Overwrite)
+				if err != nil {
+					return err
+				}
+				response.Categorized += int(categorized)
+			}
+
+			tagged, err := uc.Repository.AddTags(ctx, tags)
+			if err != nil {
+				return err
+			}
+			response.Tagged += int(tagged)
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return uc.Audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionRuleApply,
+			TargetType: auditSchemas.TargetRule,
+			TargetID:   rule.ID,
+			Detail: map[string]interface{}{
+				"overwrite":   req.Overwrite,
+				"matched":     response.Matched,
+				"categorized": response.Categorized,
+				"tagged":      response.Tagged,
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// TestRule shows which existing transactions a rule would match, without changing anything
+func (uc *UseCase) TestRule(ctx context.Context, req schemas.RuleRequest) (*schemas.RuleTestResponse, error) {
+	rule := &schemas.Rule{Enabled: true, Match: schemas.RuleMatchAll}
+	if err := uc.applyRequest(ctx, rule, req); err != nil {
+		return nil, err
+	}
+
+	compiled, err := rule.Compile()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &schemas.RuleTestResponse{
+		Message: constants.MsgRuleTested,
+		Data:    []schemas.Transaction{},
+	}
+	err = uc.Repository.IterateTransactions(ctx, scanBatchSize, func(transactions []schemas.Transaction) error {
+		for i := range transactions {
+			response.Scanned++
+			if !compiled.Matches(&transactions[i]) {
+				continue
+			}
+			response.Matched++
+			if len(response.Data) < maxTestMatches {
+				response.Data = append(response.Data, transactions[i])
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// Categorize runs the enabled rules over new transactions before they are stored
+// Categories are set on the transactions; the tags to store are returned
+func (uc *UseCase) Categorize(ctx context.Context, transactions []schemas.Transaction) ([]schemas.TransactionTag, error) {
+	rules, err := uc.Repository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleSet, err := schemas.NewRuleSet(rules)
+	if err != nil {
+		return nil, err
+	}
+	if ruleSet.Len() == 0 {
+		return nil, nil
+	}
+
+	now := time.Now()
+	var tags []schemas.TransactionTag
+	for i := range transactions {
+		for _, tag := range ruleSet.Apply(&transactions[i]) {
+			tags = append(tags, schemas.TransactionTag{TransactionID: transactions[i].ID, Tag: tag, CreatedAt: now})
+		}
+	}
+
+	return tags, nil
+}
+
+// applyRequest copies the request fields onto the rule and validates the result
+func (uc *UseCase) applyRequest(ctx context.Context, rule *schemas.Rule, req schemas.RuleRequest) error {
+	if req.Name != nil {
+		rule.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Priority != nil {
+		rule.Priority = *req.Priority
+	}
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+	if req.Match != nil {
+		rule.Match = strings.ToLower(strings.TrimSpace(*req.Match))
+	}
+	if req.Conditions != nil {
+		rule.Conditions = *req.Conditions
+	}
+
+	if req.Tags != nil {
+		tags, err := schemas.NormalizeTags(*req.Tags)
+		if err != nil {
+			return err
+		}
+		rule.Tags = tags
+	}
+
+	if req.CategoryID != nil {
+		categoryID := strings.TrimSpace(*req.CategoryID)
+		if categoryID == "" {
+			rule.CategoryID = nil
+		} else {
+			exists, err := uc.Repository.CategoryExists(ctx, categoryID)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return fmt.Errorf("%w: category %s not found", schemas.ErrInvalidRule, categoryID)
+			}
+			rule.CategoryID = &categoryID
+		}
+	}
+
+	_, err := rule.Compile()
+	return err
+}
@@ -0,0 +1,170 @@
+package use_case
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	auditRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/repository"
+	auditSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/rule/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupTestUseCase creates a use case backed by an in-memory SQLite database with sample transactions
+func setupTestUseCase(t *testing.T) (IUseCase, *gorm.DB) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to setup test database: %v", err)
+	}
+
+	if err := db.AutoMigrate(&schemas.Transaction{}, &schemas.Category{}, &schemas.TransactionTag{}, &schemas.Rule{}, &auditSchemas.AuditEvent{}); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	manual := "manual"
+	db.Create(&[]schemas.Category{{ID: "shopping", Name: "Shopping"}, {ID: manual, Name: "Manual"}})
+	db.Create(&[]schemas.Transaction{
+		{ID: "1", Name: "E-COMMERCE A", Type: schemas.TypeDebit, Amount: 100, Status: schemas.StatusSuccess},
+		{ID: "2", Name: "E-COMMERCE B", Type: schemas.TypeDebit, Amount: 200, Status: schemas.StatusSuccess, CategoryID: &manual},
+		{ID: "3", Name: "E-COMMERCE C", Type: schemas.TypeCredit, Amount: 300, Status: schemas.StatusSuccess},
+		{ID: "4", Name: "JOHN DOE", Type: schemas.TypeDebit, Amount: 400, Status: schemas.StatusSuccess},
+	})
+
+	audit := auditUseCase.NewUseCase(auditRepo.NewRepository(db))
+	return NewUseCase(repository.NewRepository(db), audit), db
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func shoppingRule() schemas.RuleRequest {
+	conditions := []schemas.RuleCondition{
+		{Field: "name", Operator: "contains", Value: "E-COMMERCE"},
+		{Field: "type", Operator: "equals", Value: "DEBIT"},
+	}
+	tags := []string{"Online"}
+	return schemas.RuleRequest{
+		Name:       stringPtr("E-commerce debits"),
+		Conditions: &conditions,
+		CategoryID: stringPtr("shopping"),
+		Tags:       &tags,
+	}
+}
+
+// TestCreateRuleValidation tests defaults and that unknown categories are rejected
+func TestCreateRuleValidation(t *testing.T) {
+	uc, _ := setupTestUseCase(t)
+	ctx := context.Background()
+
+	rule, err := uc.CreateRule(ctx, shoppingRule())
+	if err != nil {
+		t.Fatalf("CreateRule failed: %v", err)
+	}
+	if !rule.Enabled || rule.Match != schemas.RuleMatchAll || rule.Tags[0] != "online" {
+		t.Errorf("Unexpected rule defaults: %+v", rule)
+	}
+
+	req := shoppingRule()
+	req.CategoryID = stringPtr("missing")
+	if _, err := uc.CreateRule(ctx, req); !errors.Is(err, schemas.ErrInvalidRule) {
+		t.Errorf("Expected invalid rule for unknown category, got %v", err)
+	}
+}
+
+// TestTestAndApplyRule tests matching existing rows and back-applying without overwriting categories
+func TestTestAndApplyRule(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	ctx := context.Background()
+
+	tested, err := uc.TestRule(ctx, shoppingRule())
+	if err != nil {
+		t.Fatalf("TestRule failed: %v", err)
+	}
+	if tested.Matched != 2 || tested.Scanned != 4 {
+		t.Errorf("Expected 2 of 4 rows to match, got %+v", tested)
+	}
+
+	rule, err := uc.CreateRule(ctx, shoppingRule())
+	if err != nil {
+		t.Fatalf("CreateRule failed: %v", err)
+	}
+
+	applied, err := uc.ApplyRule(ctx, rule.ID, schemas.RuleApplyRequest{})
+	if err != nil {
+		t.Fatalf("ApplyRule failed: %v", err)
+	}
+	if applied.Matched != 2 || applied.Categorized != 1 || applied.Tagged != 2 {
+		t.Errorf("Unexpected apply result: %+v", applied)
+	}
+
+	var kept schemas.Transaction
+	db.First(&kept, "id = ?", "2")
+	if kept.CategoryID == nil || *kept.CategoryID != "manual" {
+		t.Errorf("Expected manual category to be kept without overwrite, got %v", kept.CategoryID)
+	}
+
+	applied, err = uc.ApplyRule(ctx, rule.ID, schemas.RuleApplyRequest{Overwrite: true})
+	if err != nil {
+		t.Fatalf("ApplyRule with overwrite failed: %v", err)
+	}
+	if applied.Categorized != 2 || applied.Tagged != 0 {
+		t.Errorf("Unexpected overwrite result: %+v", applied)
+	}
+}
+
+// TestApplyRuleRollsBack tests that a back-apply whose audit event cannot be written leaves every transaction unchanged
+func TestApplyRuleRollsBack(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	ctx := context.Background()
+
+	rule, err := uc.CreateRule(ctx, shoppingRule())
+	if err != nil {
+		t.Fatalf("CreateRule failed: %v", err)
+	}
+	if err := db.Migrator().DropTable(&auditSchemas.AuditEvent{}); err != nil {
+		t.Fatalf("failed to drop audit table: %v", err)
+	}
+
+	if _, err := uc.ApplyRule(ctx, rule.ID, schemas.RuleApplyRequest{}); err == nil {
+		t.Fatal("Expected the apply to fail without an audit log")
+	}
+
+	var categorized, tags int64
+	db.Model(&schemas.Transaction{}).Where("category_id = ?", "shopping").Count(&categorized)
+	db.Model(&schemas.TransactionTag{}).Count(&tags)
+	if categorized != 0 || tags != 0 {
+		t.Errorf("Expected no changes, got %d categorized and %d tags", categorized, tags)
+	}
+}
+
+// TestCategorize tests that enabled rules categorise new transactions before they are stored
+func TestCategorize(t *testing.T) {
+	uc, _ := setupTestUseCase(t)
+	ctx := context.Background()
+
+	if _, err := uc.CreateRule(ctx, shoppingRule()); err != nil {
+		t.Fatalf("CreateRule failed: %v", err)
+	}
+
+	transactions := []schemas.Transaction{
+		{ID: "new-1", Name: "E-COMMERCE D", Type: schemas.TypeDebit},
+		{ID: "new-2", Name: "JANE DOE", Type: schemas.TypeDebit},
+	}
+
+	tags, err := uc.Categorize(ctx, transactions)
+	if err != nil {
+		t.Fatalf("Categorize failed: %v", err)
+	}
+
+	if transactions[0].CategoryID == nil || *transactions[0].CategoryID != "shopping" || transactions[1].CategoryID != nil {
+		t.Errorf("Unexpected categories: %v, %v", transactions[0].CategoryID, transactions[1].CategoryID)
+	}
+	if len(tags) != 1 || tags[0].TransactionID != "new-1" || tags[0].Tag != "online" {
+		t.Errorf("Unexpected tags: %+v", tags)
+	}
+}
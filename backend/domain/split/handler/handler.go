@@ -0,0 +1,48 @@
+package handler
+
+import (
+	auditRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/repository"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	splitRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/split/repository"
+	splitUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/split/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/deps"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+)
+
+const ContextName = "Domain.Split.Handler"
+
+// Handler defines the transaction split handlers
+type Handler struct {
+	Logger         *logger.Logger
+	UseCase        splitUseCase.IUseCase
+	FieldValidator *validator.FieldValidator
+}
+
+// NewHandler creates a new split handler instance with all dependencies
+func NewHandler(d *deps.App) *Handler {
+	// Initialize repository
+	repository := splitRepo.NewRepository(d.DB.GetDB())
+
+	// Initialize use case
+	audit := auditUseCase.NewUseCase(auditRepo.NewRepository(d.DB.GetDB()))
+	useCase := splitUseCase.NewUseCase(repository, audit)
+
+	return &Handler{
+		Logger:         d.Logger,
+		UseCase:        useCase,
+		FieldValidator: d.FieldValidator,
+	}
+}
+
+// RegisterApi registers split API routes
+func RegisterApi(d *deps.App) *Handler {
+	handler := NewHandler(d)
+
+	api := d.Fiber.Group("/api")
+
+	api.Get("/transactions/:id/splits", handler.GetSplits)
+	api.Put("/transactions/:id/splits", handler.ReplaceSplits)
+
+	return handler
+}
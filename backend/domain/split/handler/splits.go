@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetSplits returns the splits of a transaction
+func (h *Handler) GetSplits(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "GetSplits"),
+	)
+
+	id := c.Params("id")
+	response, err := h.UseCase.GetSplits(c.Context(), id)
+	if err != nil {
+		l.Warn("Failed to retrieve splits", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToRetrieveSplits)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
+
+// ReplaceSplits replaces the splits of a transaction; an empty list removes them
+func (h *Handler) ReplaceSplits(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "ReplaceSplits"),
+	)
+
+	var req schemas.SplitRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.Warn("Invalid request body", logger.Error(err))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidSplit,
+			Error:   err.Error(),
+		})
+	}
+
+	id := c.Params("id")
+	response, err := h.UseCase.ReplaceSplits(c.Context(), id, req, h.FieldValidator)
+	if err != nil {
+		l.Warn("Failed to save splits", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToSaveSplits)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Splits updated", logger.String("id", id), logger.Int("splits", len(response.Splits)))
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
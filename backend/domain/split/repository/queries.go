@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+)
+
+// FindTransaction retrieves a transaction by ID
+func (r *Repository) FindTransaction(ctx context.Context, id string) (*schemas.Transaction, error) {
+	var transaction schemas.Transaction
+	if err := db.Conn(ctx, r.DB).Where("id = ?", id).First(&transaction).Error; err != nil {
+		return nil, err
+	}
+	return &transaction, nil
+}
+
+// FindSplits retrieves the splits of a transaction in allocation order
+func (r *Repository) FindSplits(ctx context.Context, transactionID string) ([]schemas.TransactionSplit, error) {
+	var splits []schemas.TransactionSplit
+	err := db.Conn(ctx, r.DB).
+		Where("transaction_id = ?", transactionID).
+		Order("position ASC").
+		Find(&splits).Error
+	return splits, err
+}
+
+// CountCategories counts how many of the given category IDs exist
+func (r *Repository) CountCategories(ctx context.Context, ids []string) (int64, error) {
+	var count int64
+	err := db.Conn(ctx, r.DB).
+		Model(&schemas.Category{}).
+		Where("id IN ?", ids).
+		Count(&count).Error
+	return count, err
+}
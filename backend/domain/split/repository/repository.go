@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"gorm.io/gorm"
+)
+
+// IRepository defines the contract for split repository operations
+type IRepository interface {
+	// Commands
+	ReplaceSplits(ctx context.Context, transactionID string, splits []schemas.TransactionSplit) error
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+
+	// Queries
+	FindTransaction(ctx context.Context, id string) (*schemas.Transaction, error)
+	FindSplits(ctx context.Context, transactionID string) ([]schemas.TransactionSplit, error)
+	CountCategories(ctx context.Context, ids []string) (int64, error)
+}
+
+// Repository implements IRepository
+type Repository struct {
+	DB *gorm.DB
+}
+
+// NewRepository creates a new split repository instance
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
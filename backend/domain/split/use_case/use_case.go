@@ -0,0 +1,189 @@
+package use_case
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	auditSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/split/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// IUseCase defines the contract for split use case operations
+type IUseCase interface {
+	GetSplits(ctx context.Context, transactionID string) (*schemas.SplitResponse, error)
+	ReplaceSplits(ctx context.Context, transactionID string, req schemas.SplitRequest, fieldValidator *validator.FieldValidator) (*schemas.SplitResponse, error)
+}
+
+// UseCase implements IUseCase
+type UseCase struct {
+	Repository repository.IRepository
+	Audit      auditUseCase.IUseCase
+	Now        func() time.Time
+}
+
+// NewUseCase creates a new split use case instance
+func NewUseCase(repo repository.IRepository, audit auditUseCase.IUseCase) IUseCase {
+	return &UseCase{
+		Repository: repo,
+		Audit:      audit,
+		Now:        time.Now,
+	}
+}
+
+// GetSplits retrieves the splits of a transaction
+func (uc *UseCase) GetSplits(ctx context.Context, transactionID string) (*schemas.SplitResponse, error) {
+	transaction, err := uc.findTransaction(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	splits, err := uc.Repository.FindSplits(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schemas.SplitResponse{
+		Message:       constants.MsgSplitsRetrieved,
+		TransactionID: transaction.ID,
+		Amount:        transaction.Amount,
+		Splits:        splits,
+	}, nil
+}
+
+// ReplaceSplits replaces the splits of a transaction
+// Amounts use the CSV units and must sum exactly to the transaction amount; an empty list removes the splits
+// The checks against the transaction, the replacement and the audit event run in one database transaction, so an
+// amount changed meanwhile cannot leave splits that no longer sum to it
+func (uc *UseCase) ReplaceSplits(ctx context.Context, transactionID string, req schemas.SplitRequest, fieldValidator *validator.FieldValidator) (*schemas.SplitResponse, error) {
+	var transaction *schemas.Transaction
+	var splits []schemas.TransactionSplit
+	err := uc.Repository.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		transaction, err = uc.findTransaction(ctx, transactionID)
+		if err != nil {
+			return err
+		}
+
+		splits, err = uc.buildSplits(ctx, transaction, req, fieldValidator)
+		if err != nil {
+			return err
+		}
+
+		before, err := uc.Repository.FindSplits(ctx, transactionID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.Repository.ReplaceSplits(ctx, transactionID, splits); err != nil {
+			return err
+		}
+
+		return uc.Audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionSplitUpdate,
+			TargetType: auditSchemas.TargetTransaction,
+			TargetID:   transactionID,
+			Before:     before,
+			After:      splits,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &schemas.SplitResponse{
+		Message:       constants.MsgSplitsUpdated,
+		TransactionID: transaction.ID,
+		Amount:        transaction.Amount,
+		Splits:        splits,
+	}, nil
+}
+
+// buildSplits validates the allocations of a request and converts them to splits
+func (uc *UseCase) buildSplits(ctx context.Context, transaction *schemas.Transaction, req schemas.SplitRequest, fieldValidator *validator.FieldValidator) ([]schemas.TransactionSplit, error) {
+	splits := []schemas.TransactionSplit{}
+	if len(req.Splits) == 0 {
+		return splits, nil
+	}
+	if len(req.Splits) < 2 || len(req.Splits) > schemas.MaxSplits {
+		return nil, fmt.Errorf("%w: a split needs between 2 and %d allocations", schemas.ErrInvalidSplit, schemas.MaxSplits)
+	}
+
+	now := uc.Now().UTC()
+	var total int64
+	var categoryIDs []string
+	seen := make(map[string]bool)
+
+	for i, allocation := range req.Splits {
+		if err := fieldValidator.ValidateAmount(allocation.Amount.String()); err != nil {
+			return nil, fmt.Errorf("%w: allocation %d: amount: %v", schemas.ErrInvalidSplit, i+1, err)
+		}
+		// Convert to cents, same as the CSV upload
+		amountFloat, _ := strconv.ParseFloat(strings.TrimSpace(allocation.Amount.String()), 64)
+		amount := int64(math.Round(amountFloat * 100))
+		if amount <= 0 {
+			return nil, fmt.Errorf("%w: allocation %d: amount must be greater than zero", schemas.ErrInvalidSplit, i+1)
+		}
+
+		note, err := schemas.NormalizeSplitNote(allocation.Note)
+		if err != nil {
+			return nil, fmt.Errorf("allocation %d: %w", i+1, err)
+		}
+
+		var categoryID *string
+		if allocation.CategoryID != nil && strings.TrimSpace(*allocation.CategoryID) != "" {
+			id := strings.TrimSpace(*allocation.CategoryID)
+			categoryID = &id
+			if !seen[id] {
+				seen[id] = true
+				categoryIDs = append(categoryIDs, id)
+			}
+		}
+
+		total += amount
+		splits = append(splits, schemas.TransactionSplit{
+			ID:            uuid.New().String(),
+			TransactionID: transaction.ID,
+			Position:      i + 1,
+			Amount:        amount,
+			CategoryID:    categoryID,
+			Note:          note,
+			CreatedAt:     now,
+		})
+	}
+
+	if total != transaction.Amount {
+		return nil, fmt.Errorf("%w: allocations sum to %d but the transaction amount is %d (cents)", schemas.ErrInvalidSplit, total, transaction.Amount)
+	}
+
+	if len(categoryIDs) > 0 {
+		found, err := uc.Repository.CountCategories(ctx, categoryIDs)
+		if err != nil {
+			return nil, err
+		}
+		if found != int64(len(categoryIDs)) {
+			return nil, fmt.Errorf("%w: one of %s", schemas.ErrCategoryNotFound, strings.Join(categoryIDs, ", "))
+		}
+	}
+
+	return splits, nil
+}
+
+// findTransaction loads a transaction, mapping a missing row to ErrTransactionNotFound
+func (uc *UseCase) findTransaction(ctx context.Context, id string) (*schemas.Transaction, error) {
+	transaction, err := uc.Repository.FindTransaction(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, schemas.ErrTransactionNotFound
+	}
+	return transaction, err
+}
@@ -0,0 +1,133 @@
+package use_case
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	auditRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/repository"
+	auditSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/split/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupTestUseCase creates a use case backed by an in-memory SQLite database with one 100.00 debit
+func setupTestUseCase(t *testing.T) (IUseCase, *gorm.DB) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to setup test database: %v", err)
+	}
+
+	if err := db.AutoMigrate(&schemas.Transaction{}, &schemas.TransactionSplit{}, &schemas.Category{}, &auditSchemas.AuditEvent{}); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	db.Create(&schemas.Transaction{ID: "1", Timestamp: 1000, Name: "OFFICE", Type: schemas.TypeDebit, Amount: 10000, Status: schemas.StatusSuccess})
+	db.Create(&schemas.Category{ID: "rent", Name: "Rent"})
+
+	audit := auditUseCase.NewUseCase(auditRepo.NewRepository(db))
+	return NewUseCase(repository.NewRepository(db), audit), db
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+// TestReplaceSplits tests that allocations are stored in cents and can be removed again
+func TestReplaceSplits(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	ctx := context.Background()
+	fieldValidator := validator.NewFieldValidator()
+
+	req := schemas.SplitRequest{Splits: []schemas.SplitAllocation{
+		{Amount: "60.25", CategoryID: stringPtr("rent"), Note: " desks "},
+		{Amount: "39.75", Note: "chairs"},
+	}}
+
+	response, err := uc.ReplaceSplits(ctx, "1", req, fieldValidator)
+	if err != nil {
+		t.Fatalf("ReplaceSplits failed: %v", err)
+	}
+	if len(response.Splits) != 2 || response.Splits[0].Amount != 6025 || response.Splits[0].Note != "desks" || *response.Splits[0].CategoryID != "rent" {
+		t.Errorf("Unexpected splits: %+v", response.Splits)
+	}
+
+	stored, err := uc.GetSplits(ctx, "1")
+	if err != nil {
+		t.Fatalf("GetSplits failed: %v", err)
+	}
+	if len(stored.Splits) != 2 || stored.Splits[1].Position != 2 || stored.Splits[1].CategoryID != nil {
+		t.Errorf("Unexpected stored splits: %+v", stored.Splits)
+	}
+
+	if _, err := uc.ReplaceSplits(ctx, "1", schemas.SplitRequest{}, fieldValidator); err != nil {
+		t.Fatalf("ReplaceSplits failed to remove splits: %v", err)
+	}
+
+	var count int64
+	db.Model(&schemas.TransactionSplit{}).Count(&count)
+	if count != 0 {
+		t.Errorf("Expected splits to be removed, got %d", count)
+	}
+
+	var events int64
+	db.Model(&auditSchemas.AuditEvent{}).Where("action = ?", auditSchemas.ActionSplitUpdate).Count(&events)
+	if events != 2 {
+		t.Errorf("Expected 2 audit events, got %d", events)
+	}
+}
+
+// TestReplaceSplitsRollsBack tests that a replacement whose audit event cannot be written keeps the old splits
+func TestReplaceSplitsRollsBack(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	if err := db.Migrator().DropTable(&auditSchemas.AuditEvent{}); err != nil {
+		t.Fatalf("failed to drop audit table: %v", err)
+	}
+
+	req := schemas.SplitRequest{Splits: []schemas.SplitAllocation{{Amount: "60"}, {Amount: "40"}}}
+	if _, err := uc.ReplaceSplits(context.Background(), "1", req, validator.NewFieldValidator()); err == nil {
+		t.Fatal("Expected the replacement to fail without an audit log")
+	}
+
+	var count int64
+	db.Model(&schemas.TransactionSplit{}).Count(&count)
+	if count != 0 {
+		t.Errorf("Expected no splits to be stored, got %d", count)
+	}
+}
+
+// TestReplaceSplitsValidation tests that allocations must be valid and sum to the transaction amount
+func TestReplaceSplitsValidation(t *testing.T) {
+	uc, _ := setupTestUseCase(t)
+	ctx := context.Background()
+	fieldValidator := validator.NewFieldValidator()
+
+	tests := []struct {
+		name   string
+		splits []schemas.SplitAllocation
+		err    error
+	}{
+		{"single allocation", []schemas.SplitAllocation{{Amount: "100"}}, schemas.ErrInvalidSplit},
+		{"wrong sum", []schemas.SplitAllocation{{Amount: "60"}, {Amount: "30"}}, schemas.ErrInvalidSplit},
+		{"zero amount", []schemas.SplitAllocation{{Amount: "100"}, {Amount: "0"}}, schemas.ErrInvalidSplit},
+		{"negative amount", []schemas.SplitAllocation{{Amount: "110"}, {Amount: "-10"}}, schemas.ErrInvalidSplit},
+		{"unknown category", []schemas.SplitAllocation{{Amount: "50", CategoryID: stringPtr("food")}, {Amount: "50"}}, schemas.ErrCategoryNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.ReplaceSplits(ctx, "1", schemas.SplitRequest{Splits: tt.splits}, fieldValidator)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+
+	if _, err := uc.GetSplits(ctx, "missing"); !errors.Is(err, schemas.ErrTransactionNotFound) {
+		t.Errorf("Expected ErrTransactionNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// CreateTransaction stores a single manually entered transaction
+func (h *Handler) CreateTransaction(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "CreateTransaction"),
+	)
+
+	var req schemas.TransactionRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.Warn("Invalid request body", logger.Error(err))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidTransactionBody,
+			Error:   err.Error(),
+		})
+	}
+
+	transaction, err := h.UseCase.CreateTransaction(c.Context(), req, h.FieldValidator)
+	if err != nil {
+		l.Warn("Failed to create transaction", logger.Error(err))
+		errResp := errorResponse(err, constants.MsgFailedToCreateTransaction)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Transaction created", logger.String("id", transaction.ID))
+
+	return c.Status(http.StatusCreated).JSON(schemas.SuccessResponse{
+		Status: http.StatusCreated,
+		Data:   transaction,
+	})
+}
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+)
+
+// errorResponse maps use case errors to an error response, falling back to a 500 with the given message
+func errorResponse(err error, fallbackMessage string) schemas.ErrorResponse {
+	switch {
+	case errors.Is(err, schemas.ErrTransactionNotFound):
+		return schemas.ErrorResponse{
+			Status:  http.StatusNotFound,
+			Message: constants.MsgTransactionNotFound,
+			Error:   err.Error(),
+		}
+	case errors.Is(err, schemas.ErrInvalidTransaction):
+		return schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidTransactionBody,
+			Error:   err.Error(),
+		}
+	default:
+		return schemas.ErrorResponse{
+			Status:  http.StatusInternalServerError,
+			Message: fallbackMessage,
+			Error:   err.Error(),
+		}
+	}
+}
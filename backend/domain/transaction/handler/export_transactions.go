@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ExportTransactions downloads every transaction matching the filters and sort of GetTransactions
+// format=csv uses the upload CSV layout and format=xlsx adds issues and summary sheets
+// The file is streamed, so the response is never held in memory
+func (h *Handler) ExportTransactions(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "ExportTransactions"),
+	)
+
+	format := strings.ToLower(c.Query("format", schemas.ExportFormatCSV))
+	if !schemas.ValidExportFormat(format) {
+		l.Warn("Invalid export format", logger.String("format", format))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidExportFormat,
+			Error:   fmt.Sprintf("unknown format %q, expected %s or %s", format, schemas.ExportFormatCSV, schemas.ExportFormatXLSX),
+		})
+	}
+
+	filters, errResp := h.parseFilters(c, l)
+	if errResp != nil {
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	sort, errResp := h.parseSort(c, l, h.FieldValidator.ValidateSortField)
+	if errResp != nil {
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	// Too many rows for a sheet is only found part way through a stream, after the status has been sent
+	if err := h.UseCase.CheckExport(c.Context(), format, filters); err != nil {
+		if errors.Is(err, schemas.ErrExportTooLarge) {
+			l.Warn("Export too large for XLSX", logger.Error(err))
+			return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+				Status:  http.StatusBadRequest,
+				Message: constants.MsgExportTooLarge,
+				Error:   err.Error(),
+			})
+		}
+		l.Error("Failed to check export", logger.Error(err))
+		return c.Status(http.StatusInternalServerError).JSON(schemas.ErrorResponse{
+			Status:  http.StatusInternalServerError,
+			Message: constants.MsgFailedToExportTransactions,
+			Error:   err.Error(),
+		})
+	}
+
+	// escape=1 quotes CSV text a spreadsheet would run as a formula; the quote stays in the data if it is uploaded again
+	escape := c.QueryBool("escape")
+	export := func(ctx context.Context, w io.Writer) error {
+		return h.UseCase.ExportCSV(ctx, filters, sort, escape, w)
+	}
+	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	if format == schemas.ExportFormatXLSX {
+		export = func(ctx context.Context, w io.Writer) error {
+			return h.UseCase.ExportXLSX(ctx, filters, sort, w)
+		}
+		c.Set(fiber.HeaderContentType, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	}
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="transactions.%s"`, format))
+
+	// The writer runs after the handler returns, so it cannot use the request context
+	// Its own context is cancelled once the client stops reading, which closes the database cursor
+	ctx, cancel := context.WithCancel(context.Background())
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer cancel()
+		if err := export(ctx, &streamWriter{w: w, cancel: cancel}); err != nil {
+			l.Error("Failed to export transactions", logger.Error(err))
+		}
+	})
+
+	return nil
+}
+
+// streamWriter writes an export to the client and cancels the export when a write fails
+type streamWriter struct {
+	w      *bufio.Writer
+	cancel context.CancelFunc
+}
+
+// Write passes p to the client, cancelling the export if the client is gone
+func (s *streamWriter) Write(p []byte) (int, error) {
+	n, err := s.w.Write(p)
+	if err != nil {
+		s.cancel()
+	}
+	return n, err
+}
@@ -2,8 +2,6 @@ package handler
 
 import (
 	"net/http"
-	"strconv"
-	"strings"
 
 	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
 	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
@@ -19,112 +17,21 @@ func (h *Handler) GetIssues(c *fiber.Ctx) error {
 	)
 
 	// Parse pagination parameters
-	page := 1
-	pageSize := 10
-
-	if p := c.Query("page"); p != "" {
-		if parsed, err := strconv.Atoi(p); err == nil && parsed > 0 {
-			page = parsed
-		}
-	}
-
-	if ps := c.Query("page_size"); ps != "" {
-		if parsed, err := strconv.Atoi(ps); err == nil && parsed > 0 {
-			pageSize = parsed
-		}
-	}
-
-	// Limit page size to 100
-	if pageSize > 100 {
-		pageSize = 100
-	}
-
-	// Validate pagination
-	if err := h.FieldValidator.ValidatePaginationParams(page, pageSize); err != nil {
-		l.Warn("Invalid pagination parameters", logger.Error(err))
-		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
-			Status:  http.StatusBadRequest,
-			Message: constants.MsgInvalidPagination,
-			Error:   err.Error(),
-		})
+	page, pageSize, errResp := h.parsePagination(c, l)
+	if errResp != nil {
+		return c.Status(errResp.Status).JSON(errResp)
 	}
 
 	// Parse filter parameters
-	filters := schemas.TransactionFilters{
-		Status:      strings.ToUpper(c.Query("status")),
-		Type:        strings.ToUpper(c.Query("type")),
-		SearchQuery: c.Query("search"),
-		StartDate:   c.Query("start_date"),
-		EndDate:     c.Query("end_date"),
-	}
-
-	// Validate search query
-	if filters.SearchQuery != "" {
-		if err := h.FieldValidator.ValidateSearchQuery(filters.SearchQuery); err != nil {
-			l.Warn("Invalid search query", logger.Error(err))
-			return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
-				Status:  http.StatusBadRequest,
-				Message: constants.MsgInvalidSearchQuery,
-				Error:   err.Error(),
-			})
-		}
-	}
-
-	// Parse amount filter if provided
-	if amountStr := c.Query("amount"); amountStr != "" {
-		if err := h.FieldValidator.ValidateAmountFilter(amountStr); err != nil {
-			l.Warn("Invalid amount filter", logger.Error(err))
-			return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
-				Status:  http.StatusBadRequest,
-				Message: constants.MsgInvalidAmountFilter,
-				Error:   err.Error(),
-			})
-		}
-		if amount, err := strconv.ParseInt(amountStr, 10, 64); err == nil {
-			filters.Amount = amount
-		}
-	}
-
-	// Validate date range
-	if err := h.FieldValidator.ValidateDateRange(filters.StartDate, filters.EndDate); err != nil {
-		l.Warn("Invalid date range", logger.Error(err))
-		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
-			Status:  http.StatusBadRequest,
-			Message: constants.MsgInvalidDateRange,
-			Error:   err.Error(),
-		})
-	}
-
-	// Parse sort parameters (optional - no defaults)
-	sortBy := c.Query("sort_by", "")
-	sortOrder := c.Query("sort_order", "")
-
-	// Only validate sort parameters if they are provided
-	if sortBy != "" {
-		if err := h.FieldValidator.ValidateSortField(sortBy); err != nil {
-			l.Warn("Invalid sort field", logger.Error(err))
-			return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
-				Status:  http.StatusBadRequest,
-				Message: constants.MsgInvalidSortField,
-				Error:   err.Error(),
-			})
-		}
-	}
-
-	if sortOrder != "" {
-		if err := h.FieldValidator.ValidateSortOrder(sortOrder); err != nil {
-			l.Warn("Invalid sort order", logger.Error(err))
-			return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
-				Status:  http.StatusBadRequest,
-				Message: constants.MsgInvalidSortOrder,
-				Error:   err.Error(),
-			})
-		}
+	filters, errResp := h.parseFilters(c, l)
+	if errResp != nil {
+		return c.Status(errResp.Status).JSON(errResp)
 	}
 
-	sort := schemas.TransactionSort{
-		By:    sortBy,
-		Order: strings.ToUpper(sortOrder),
+	// Parse sort parameters (optional - no defaults, age is allowed for issues)
+	sort, errResp := h.parseSort(c, l, h.FieldValidator.ValidateIssueSortField)
+	if errResp != nil {
+		return c.Status(errResp.Status).JSON(errResp)
 	}
 
 	response, err := h.UseCase.GetIssuesWithFiltersAndSort(c.Context(), page, pageSize, filters, sort)
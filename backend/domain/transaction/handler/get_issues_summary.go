@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetIssuesSummary returns issue counts and totals bucketed by age, with SLA breaches
+func (h *Handler) GetIssuesSummary(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "GetIssuesSummary"),
+	)
+
+	filters, errResp := h.parseFilters(c, l)
+	if errResp != nil {
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	response, err := h.UseCase.GetIssuesSummary(c.Context(), filters)
+	if err != nil {
+		l.Error("Failed to summarize issues", logger.Error(err))
+		return c.Status(http.StatusInternalServerError).JSON(schemas.ErrorResponse{
+			Status:  http.StatusInternalServerError,
+			Message: constants.MsgFailedToSummarizeIssues,
+			Error:   err.Error(),
+		})
+	}
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
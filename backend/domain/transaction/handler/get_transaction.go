@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetTransaction returns a single transaction by ID
+func (h *Handler) GetTransaction(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "GetTransaction"),
+	)
+
+	id := c.Params("id")
+	transaction, err := h.UseCase.GetTransaction(c.Context(), id)
+	if err != nil {
+		l.Warn("Failed to retrieve transaction", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToRetrieveTransaction)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   transaction,
+	})
+}
+
+// GetTransactionRevisions returns the manual edit history of a transaction
+func (h *Handler) GetTransactionRevisions(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "GetTransactionRevisions"),
+	)
+
+	id := c.Params("id")
+	revisions, err := h.UseCase.GetTransactionRevisions(c.Context(), id)
+	if err != nil {
+		l.Error("Failed to retrieve transaction revisions", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToRetrieveTransaction)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   revisions,
+	})
+}
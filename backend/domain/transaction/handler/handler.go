@@ -1,8 +1,8 @@
 package handler
 
 import (
-	transactionRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/repository"
 	transactionUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/wiring"
 	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/deps"
 	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
 	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
@@ -19,16 +19,10 @@ type Handler struct {
 
 // NewHandler creates a new transaction handler instance with all dependencies
 func NewHandler(d *deps.App) *Handler {
-	// Initialize repository
-	repository := transactionRepo.NewRepository(d.DB.GetDB())
-	
-	// Initialize use case
-	useCase := transactionUseCase.NewUseCase(repository)
-	
 	return &Handler{
 		Logger:         d.Logger,
-		UseCase:        useCase,
-		FieldValidator: validator.NewFieldValidator(),
+		UseCase:        wiring.Transactions(d),
+		FieldValidator: d.FieldValidator,
 	}
 }
 
@@ -40,7 +34,15 @@ func RegisterApi(d *deps.App) *Handler {
 	
 	api.Get("/balance", handler.GetBalance)
 	api.Get("/transactions", handler.GetTransactions)
+	api.Post("/transactions", handler.CreateTransaction)
+	api.Get("/transactions/export", handler.ExportTransactions)
+	api.Get("/transactions/:id", handler.GetTransaction)
+	api.Get("/transactions/:id/revisions", handler.GetTransactionRevisions)
+	api.Put("/transactions/:id", handler.UpdateTransaction)
+	api.Patch("/transactions/:id", handler.UpdateTransaction)
+	api.Delete("/transactions/:id", handler.DeleteTransaction)
 	api.Get("/issues", handler.GetIssues)
+	api.Get("/issues/summary", handler.GetIssuesSummary)
 	
 	return handler
 }
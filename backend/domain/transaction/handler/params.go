@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+	"github.com/gofiber/fiber/v2"
+)
+
+// parsePagination reads and validates the page and page_size query parameters
+func (h *Handler) parsePagination(c *fiber.Ctx, l *logger.Logger) (int, int, *schemas.ErrorResponse) {
+	page, pageSize, errResp := ParsePagination(c.Query, h.FieldValidator)
+	if errResp != nil {
+		l.Warn("Invalid pagination parameters", logger.String("error", errResp.Error))
+	}
+	return page, pageSize, errResp
+}
+
+// ParsePagination reads and validates the page and page_size query parameters shared by every list endpoint
+// The page defaults to 1 and the page size to 10, capped at 100
+func ParsePagination(query func(key string, defaultValue ...string) string, fieldValidator *validator.FieldValidator) (int, int, *schemas.ErrorResponse) {
+	page := 1
+	pageSize := 10
+
+	if p := query("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil && parsed > 0 {
+			page = parsed
+		}
+	}
+
+	if ps := query("page_size"); ps != "" {
+		if parsed, err := strconv.Atoi(ps); err == nil && parsed > 0 {
+			pageSize = parsed
+		}
+	}
+
+	// Limit page size to 100
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	if err := fieldValidator.ValidatePaginationParams(page, pageSize); err != nil {
+		return 0, 0, &schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidPagination,
+			Error:   err.Error(),
+		}
+	}
+
+	return page, pageSize, nil
+}
+
+// parseFilters reads and validates the filter query parameters shared by list endpoints
+func (h *Handler) parseFilters(c *fiber.Ctx, l *logger.Logger) (schemas.TransactionFilters, *schemas.ErrorResponse) {
+	filters, errResp := ParseFilters(c.Query, h.FieldValidator)
+	if errResp != nil {
+		l.Warn("Invalid filter", logger.String("message", errResp.Message), logger.String("error", errResp.Error))
+	}
+	return filters, errResp
+}
+
+// ParseFilters reads and validates transaction filters by their query parameter names, such as status or start_date
+// query returns the value of a parameter; flipctl passes the values of its --filter flags the same way
+func ParseFilters(query func(key string, defaultValue ...string) string, fieldValidator *validator.FieldValidator) (schemas.TransactionFilters, *schemas.ErrorResponse) {
+	filters := schemas.TransactionFilters{
+		Status:       strings.ToUpper(query("status")),
+		Type:         strings.ToUpper(query("type")),
+		SearchQuery:  query("search"),
+		StartDate:    query("start_date"),
+		EndDate:      query("end_date"),
+		Category:     strings.TrimSpace(query("category")),
+		Counterparty: strings.TrimSpace(query("counterparty")),
+		Batch:        strings.TrimSpace(query("batch")),
+	}
+
+	// Validate tag filter
+	if tag := query("tag"); tag != "" {
+		tags, err := schemas.NormalizeTags([]string{tag})
+		if err != nil {
+			return filters, &schemas.ErrorResponse{
+				Status:  http.StatusBadRequest,
+				Message: constants.MsgInvalidTag,
+				Error:   err.Error(),
+			}
+		}
+		filters.Tag = tags[0]
+	}
+
+	// Validate anomaly reason filter
+	if reason := strings.ToUpper(strings.TrimSpace(query("reason"))); reason != "" {
+		if !schemas.ValidFlagReason(reason) {
+			return filters, &schemas.ErrorResponse{
+				Status:  http.StatusBadRequest,
+				Message: constants.MsgInvalidFlagReason,
+				Error:   "unknown reason " + reason,
+			}
+		}
+		filters.Reason = reason
+	}
+
+	// Validate search query
+	if filters.SearchQuery != "" {
+		if err := fieldValidator.ValidateSearchQuery(filters.SearchQuery); err != nil {
+			return filters, &schemas.ErrorResponse{
+				Status:  http.StatusBadRequest,
+				Message: constants.MsgInvalidSearchQuery,
+				Error:   err.Error(),
+			}
+		}
+	}
+
+	// Parse amount filter if provided
+	if amountStr := query("amount"); amountStr != "" {
+		if err := fieldValidator.ValidateAmountFilter(amountStr); err != nil {
+			return filters, &schemas.ErrorResponse{
+				Status:  http.StatusBadRequest,
+				Message: constants.MsgInvalidAmountFilter,
+				Error:   err.Error(),
+			}
+		}
+		if amount, err := strconv.ParseInt(amountStr, 10, 64); err == nil {
+			filters.Amount = amount
+		}
+	}
+
+	// Validate date range
+	if err := fieldValidator.ValidateDateRange(filters.StartDate, filters.EndDate); err != nil {
+		return filters, &schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidDateRange,
+			Error:   err.Error(),
+		}
+	}
+
+	return filters, nil
+}
+
+// parseSort reads and validates the optional sort_by and sort_order query parameters
+func (h *Handler) parseSort(c *fiber.Ctx, l *logger.Logger, validateField func(string) error) (schemas.TransactionSort, *schemas.ErrorResponse) {
+	sort, errResp := ParseSort(c.Query("sort_by", ""), c.Query("sort_order", ""), validateField, h.FieldValidator)
+	if errResp != nil {
+		l.Warn("Invalid sort", logger.String("message", errResp.Message), logger.String("error", errResp.Error))
+	}
+	return sort, errResp
+}
+
+// ParseSort validates an optional sort field and order, checking the field with validateField
+func ParseSort(sortBy, sortOrder string, validateField func(string) error, fieldValidator *validator.FieldValidator) (schemas.TransactionSort, *schemas.ErrorResponse) {
+	sortBy = strings.TrimSpace(sortBy)
+	sortOrder = strings.TrimSpace(sortOrder)
+
+	// Only validate sort parameters if they are provided
+	if sortBy != "" {
+		if err := validateField(sortBy); err != nil {
+			return schemas.TransactionSort{}, &schemas.ErrorResponse{
+				Status:  http.StatusBadRequest,
+				Message: constants.MsgInvalidSortField,
+				Error:   err.Error(),
+			}
+		}
+	}
+
+	if sortOrder != "" {
+		if err := fieldValidator.ValidateSortOrder(sortOrder); err != nil {
+			return schemas.TransactionSort{}, &schemas.ErrorResponse{
+				Status:  http.StatusBadRequest,
+				Message: constants.MsgInvalidSortOrder,
+				Error:   err.Error(),
+			}
+		}
+	}
+
+	return schemas.TransactionSort{
+		By:    strings.ToLower(sortBy),
+		Order: strings.ToUpper(sortOrder),
+	}, nil
+}
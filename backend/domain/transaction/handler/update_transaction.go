@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// UpdateTransaction corrects the fields of a transaction
+// PUT requires every field, PATCH only changes the fields provided
+func (h *Handler) UpdateTransaction(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "UpdateTransaction"),
+	)
+
+	id := c.Params("id")
+	partial := c.Method() == fiber.MethodPatch
+
+	var req schemas.TransactionRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.Warn("Invalid request body", logger.Error(err))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidTransactionBody,
+			Error:   err.Error(),
+		})
+	}
+
+	transaction, err := h.UseCase.UpdateTransaction(c.Context(), id, req, partial, h.FieldValidator)
+	if err != nil {
+		l.Warn("Failed to update transaction", logger.Error(err), logger.String("id", id))
+		errResp := errorResponse(err, constants.MsgFailedToUpdateTransaction)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Transaction updated", logger.String("id", id))
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   transaction,
+	})
+}
@@ -2,13 +2,17 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // Create creates a single transaction record
 func (r *Repository) Create(ctx context.Context, transaction *schemas.Transaction) error {
-	return r.DB.WithContext(ctx).Create(transaction).Error
+	return db.Conn(ctx, r.DB).Create(transaction).Error
 }
 
 // CreateBatch creates multiple transaction records
@@ -16,10 +20,162 @@ func (r *Repository) CreateBatch(ctx context.Context, transactions []schemas.Tra
 	if len(transactions) == 0 {
 		return nil
 	}
-	return r.DB.WithContext(ctx).CreateInBatches(transactions, 100).Error
+	return db.Conn(ctx, r.DB).CreateInBatches(transactions, 100).Error
 }
 
-// DeleteAll deletes all transaction records
-func (r *Repository) DeleteAll(ctx context.Context) error {
-	return r.DB.WithContext(ctx).Exec("DELETE FROM transactions").Error
+// CreateUploadBatch stores the record of an uploaded file
+func (r *Repository) CreateUploadBatch(ctx context.Context, batch *schemas.UploadBatch) error {
+	return db.Conn(ctx, r.DB).Create(batch).Error
+}
+
+// Update saves all fields of an existing transaction record
+func (r *Repository) Update(ctx context.Context, transaction *schemas.Transaction) error {
+	return db.Conn(ctx, r.DB).Save(transaction).Error
+}
+
+// Delete soft-deletes a single transaction record
+func (r *Repository) Delete(ctx context.Context, id string) error {
+	result := db.Conn(ctx, r.DB).Delete(&schemas.Transaction{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return schemas.ErrTransactionNotFound
+	}
+	return nil
+}
+
+// CreateRevision stores a revision of a manually changed transaction
+func (r *Repository) CreateRevision(ctx context.Context, revision *schemas.TransactionRevision) error {
+	return db.Conn(ctx, r.DB).Create(revision).Error
+}
+
+// CreateTags stores tags for transactions, skipping ones a transaction already has
+func (r *Repository) CreateTags(ctx context.Context, tags []schemas.TransactionTag) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	return db.Conn(ctx, r.DB).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		CreateInBatches(tags, 100).Error
+}
+
+// SoftDeleteAll soft-deletes all live transaction records and stores the clear operation
+// Rows are tagged with the operation ID so the clear can be restored exactly
+func (r *Repository) SoftDeleteAll(ctx context.Context, operation *schemas.ClearOperation) error {
+	return db.Conn(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&schemas.Transaction{}).
+			Where("deleted_at IS NULL").
+			UpdateColumns(map[string]interface{}{
+				"deleted_at": operation.ClearedAt,
+				"clear_id":   operation.ID,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		operation.AffectedRows = result.RowsAffected
+		return tx.Create(operation).Error
+	})
+}
+
+// RestoreClear brings back the transactions soft-deleted by a clear operation
+// It returns ErrNothingToRestore when the operation was restored or purged in the meantime
+func (r *Repository) RestoreClear(ctx context.Context, operation *schemas.ClearOperation) (int64, error) {
+	var restored int64
+
+	err := db.Conn(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(operation).
+			Where("restored_at IS NULL AND purged_at IS NULL").
+			Updates(map[string]interface{}{
+				"restored_by": operation.RestoredBy,
+				"restored_at": operation.RestoredAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return schemas.ErrNothingToRestore
+		}
+
+		result = tx.Unscoped().
+			Model(&schemas.Transaction{}).
+			Where("clear_id = ?", operation.ID).
+			UpdateColumns(map[string]interface{}{
+				"deleted_at": nil,
+				"clear_id":   "",
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		restored = result.RowsAffected
+		return nil
+	})
+
+	return restored, err
+}
+
+// PurgeDeleted hard-deletes every soft-deleted transaction record
+// Their tags and flags are removed and clear operations that were not restored are marked as purged
+func (r *Repository) PurgeDeleted(ctx context.Context, purgedBy string, purgedAt time.Time) (int64, error) {
+	var purged int64
+
+	err := db.Conn(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("transaction_id IN (SELECT id FROM transactions WHERE deleted_at IS NOT NULL)").
+			Delete(&schemas.TransactionTag{}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("transaction_id IN (SELECT id FROM transactions WHERE deleted_at IS NOT NULL)").
+			Delete(&schemas.TransactionFlag{}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("transaction_id IN (SELECT id FROM transactions WHERE deleted_at IS NOT NULL)").
+			Delete(&schemas.TransactionSplit{}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("transaction_id IN (SELECT id FROM transactions WHERE deleted_at IS NOT NULL) OR related_transaction_id IN (SELECT id FROM transactions WHERE deleted_at IS NOT NULL)").
+			Delete(&schemas.LinkSuggestion{}).Error
+		if err != nil {
+			return err
+		}
+
+		// Links to purged rows would point at nothing
+		err = tx.Model(&schemas.Transaction{}).
+			Where("related_transaction_id IN (SELECT id FROM transactions WHERE deleted_at IS NOT NULL)").
+			Updates(map[string]interface{}{
+				"related_transaction_id": nil,
+				"relation_type":          "",
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		result := tx.Unscoped().
+			Where("deleted_at IS NOT NULL").
+			Delete(&schemas.Transaction{})
+		if result.Error != nil {
+			return result.Error
+		}
+		purged = result.RowsAffected
+
+		return tx.Model(&schemas.ClearOperation{}).
+			Where("restored_at IS NULL AND purged_at IS NULL").
+			Updates(map[string]interface{}{
+				"purged_by": purgedBy,
+				"purged_at": purgedAt,
+			}).Error
+	})
+
+	return purged, err
+}
+
+// Transaction runs fn in a database transaction, which the repositories called with its context join
+func (r *Repository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return db.Transaction(ctx, r.DB, fn)
 }
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+)
+
+// TestSoftDeleteAllAndRestore tests that a clear can be restored exactly
+func TestSoftDeleteAllAndRestore(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	transactions := []schemas.Transaction{
+		{ID: "1", Status: schemas.StatusSuccess},
+		{ID: "2", Status: schemas.StatusFailed},
+		{ID: "3", Status: schemas.StatusPending},
+	}
+	if err := db.CreateInBatches(transactions, 100).Error; err != nil {
+		t.Fatalf("failed to insert test data: %v", err)
+	}
+
+	// A row deleted individually before the clear must stay deleted after restore
+	if err := repo.Delete(ctx, "3"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	operation := &schemas.ClearOperation{ID: "clear-1", ClearedBy: "tester", ClearedAt: time.Now().UTC()}
+	if err := repo.SoftDeleteAll(ctx, operation); err != nil {
+		t.Fatalf("SoftDeleteAll failed: %v", err)
+	}
+
+	if operation.AffectedRows != 2 {
+		t.Errorf("Expected 2 affected rows, got %d", operation.AffectedRows)
+	}
+
+	count, _ := repo.Count(ctx)
+	if count != 0 {
+		t.Errorf("Expected no live transactions after clear, got %d", count)
+	}
+
+	restoredAt := time.Now().UTC()
+	operation.RestoredBy = "tester"
+	operation.RestoredAt = &restoredAt
+	restored, err := repo.RestoreClear(ctx, operation)
+	if err != nil {
+		t.Fatalf("RestoreClear failed: %v", err)
+	}
+
+	if restored != 2 {
+		t.Errorf("Expected 2 restored rows, got %d", restored)
+	}
+
+	count, _ = repo.Count(ctx)
+	if count != 2 {
+		t.Errorf("Expected 2 live transactions after restore, got %d", count)
+	}
+
+	if _, err := repo.FindLatestClearOperation(ctx); err == nil {
+		t.Error("Restored clear should not be restorable again")
+	}
+
+	// A restore that read the operation before the first one committed must not restore it again
+	if _, err := repo.RestoreClear(ctx, operation); !errors.Is(err, schemas.ErrNothingToRestore) {
+		t.Errorf("Expected nothing to restore, got %v", err)
+	}
+}
+
+// TestPurgeDeleted tests that purge hard-deletes only soft-deleted rows
+func TestPurgeDeleted(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	transactions := []schemas.Transaction{
+		{ID: "1", Status: schemas.StatusSuccess},
+		{ID: "2", Status: schemas.StatusFailed},
+	}
+	if err := db.CreateInBatches(transactions, 100).Error; err != nil {
+		t.Fatalf("failed to insert test data: %v", err)
+	}
+
+	if err := repo.Delete(ctx, "2"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	purged, err := repo.PurgeDeleted(ctx, "tester", time.Now().UTC())
+	if err != nil {
+		t.Fatalf("PurgeDeleted failed: %v", err)
+	}
+
+	if purged != 1 {
+		t.Errorf("Expected 1 purged row, got %d", purged)
+	}
+
+	var total int64
+	db.Unscoped().Model(&schemas.Transaction{}).Count(&total)
+	if total != 1 {
+		t.Errorf("Expected 1 row left in table, got %d", total)
+	}
+}
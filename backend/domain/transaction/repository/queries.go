@@ -3,34 +3,89 @@ package repository
 import (
 	"context"
 	"fmt"
-	"math"
 	"strings"
 
 	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
 	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
+	"gorm.io/gorm"
 )
 
 // FindByID finds a transaction by its ID
 func (r *Repository) FindByID(ctx context.Context, id string) (*schemas.Transaction, error) {
 	var transaction schemas.Transaction
-	err := r.DB.WithContext(ctx).First(&transaction, "id = ?", id).Error
+	err := db.Conn(ctx, r.DB).First(&transaction, "id = ?", id).Error
 	if err != nil {
 		return nil, err
 	}
 	return &transaction, nil
 }
 
+// FindRevisions retrieves the revision history of a transaction, oldest first
+func (r *Repository) FindRevisions(ctx context.Context, transactionID string) ([]schemas.TransactionRevision, error) {
+	var revisions []schemas.TransactionRevision
+	err := db.Conn(ctx, r.DB).
+		Where("transaction_id = ?", transactionID).
+		Order("created_at ASC").
+		Find(&revisions).Error
+	return revisions, err
+}
+
+// FindClearOperation finds a clear operation by its ID
+func (r *Repository) FindClearOperation(ctx context.Context, id string) (*schemas.ClearOperation, error) {
+	var operation schemas.ClearOperation
+	err := db.Conn(ctx, r.DB).First(&operation, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &operation, nil
+}
+
+// FindLatestClearOperation finds the most recent clear operation that was neither restored nor purged
+func (r *Repository) FindLatestClearOperation(ctx context.Context) (*schemas.ClearOperation, error) {
+	var operation schemas.ClearOperation
+	err := db.Conn(ctx, r.DB).
+		Where("restored_at IS NULL AND purged_at IS NULL").
+		Order("cleared_at DESC").
+		First(&operation).Error
+	if err != nil {
+		return nil, err
+	}
+	return &operation, nil
+}
+
+// FindUploadBatches retrieves the most recent upload batches, newest first, with their statement balance checks
+func (r *Repository) FindUploadBatches(ctx context.Context, limit int) ([]schemas.UploadBatch, error) {
+	var batches []schemas.UploadBatch
+	err := db.Conn(ctx, r.DB).
+		Preload("Balances").
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&batches).Error
+	return batches, err
+}
+
+// FindClearOperations retrieves the most recent clear operations, newest first
+func (r *Repository) FindClearOperations(ctx context.Context, limit int) ([]schemas.ClearOperation, error) {
+	var operations []schemas.ClearOperation
+	err := db.Conn(ctx, r.DB).
+		Order("cleared_at DESC").
+		Limit(limit).
+		Find(&operations).Error
+	return operations, err
+}
+
 // FindAll retrieves all transactions
 func (r *Repository) FindAll(ctx context.Context) ([]schemas.Transaction, error) {
 	var transactions []schemas.Transaction
-	err := r.DB.WithContext(ctx).Find(&transactions).Error
+	err := db.Conn(ctx, r.DB).Find(&transactions).Error
 	return transactions, err
 }
 
 // FindByStatus retrieves all transactions with a specific status
 func (r *Repository) FindByStatus(ctx context.Context, status schemas.TransactionStatus) ([]schemas.Transaction, error) {
 	var transactions []schemas.Transaction
-	err := r.DB.WithContext(ctx).
+	err := db.Conn(ctx, r.DB).
 		Where("status = ?", status).
 		Order("timestamp DESC").
 		Find(&transactions).Error
@@ -43,7 +98,7 @@ func (r *Repository) GetBalance(ctx context.Context) (int64, int64, error) {
 	var debits int64
 
 	// Calculate total credits (type = CREDIT and status = SUCCESS)
-	err := r.DB.WithContext(ctx).
+	err := db.Conn(ctx, r.DB).
 		Model(&schemas.Transaction{}).
 		Where("type = ? AND status = ?", schemas.TypeCredit, schemas.StatusSuccess).
 		Select("COALESCE(SUM(amount), 0)").
@@ -54,7 +109,7 @@ func (r *Repository) GetBalance(ctx context.Context) (int64, int64, error) {
 	}
 
 	// Calculate total debits (type = DEBIT and status = SUCCESS)
-	err = r.DB.WithContext(ctx).
+	err = db.Conn(ctx, r.DB).
 		Model(&schemas.Transaction{}).
 		Where("type = ? AND status = ?", schemas.TypeDebit, schemas.StatusSuccess).
 		Select("COALESCE(SUM(amount), 0)").
@@ -67,31 +122,8 @@ func (r *Repository) GetBalance(ctx context.Context) (int64, int64, error) {
 	return credits - debits, credits, err
 }
 
-// GetIssuesWithFiltersAndSort retrieves transactions with filtering, sorting, and pagination
-func (r *Repository) GetIssuesWithFiltersAndSort(
-	ctx context.Context,
-	page, pageSize int,
-	filters schemas.TransactionFilters,
-	sort schemas.TransactionSort,
-) (*schemas.IssuesResponse, error) {
-	if page < 1 {
-		page = 1
-	}
-	if pageSize < 1 {
-		pageSize = 10
-	}
-	if pageSize > 100 {
-		pageSize = 100
-	}
-
-	var transactions []schemas.Transaction
-	var total int64
-
-	// Build query
-	query := r.DB.WithContext(ctx).
-		Where("status IN (?, ?)", schemas.StatusFailed, schemas.StatusPending)
-
-	// Apply filters
+// applyFilters applies the shared transaction filters to a query
+func applyFilters(query *gorm.DB, filters schemas.TransactionFilters) *gorm.DB {
 	if filters.Status != "" {
 		query = query.Where("status = ?", strings.ToUpper(filters.Status))
 	}
@@ -117,96 +149,281 @@ func (r *Repository) GetIssuesWithFiltersAndSort(
 		query = query.Where("DATE(created_at) <= ?", filters.EndDate)
 	}
 
-	// Get total count
-	err := query.Model(&schemas.Transaction{}).Count(&total).Error
+	if filters.Category != "" {
+		query = query.Where(
+			"category_id IN (SELECT id FROM categories WHERE id = ? OR LOWER(name) = LOWER(?))",
+			filters.Category, filters.Category,
+		)
+	}
+
+	if filters.Tag != "" {
+		query = query.Where(
+			"id IN (SELECT transaction_id FROM transaction_tags WHERE tag = ?)",
+			strings.ToLower(strings.TrimSpace(filters.Tag)),
+		)
+	}
+
+	if filters.Counterparty != "" {
+		query = query.Where("counterparty_id = ?", filters.Counterparty)
+	}
+
+	if filters.Batch != "" {
+		query = query.Where("batch_id = ?", filters.Batch)
+	}
+
+	if filters.Reason != "" {
+		query = query.Where("id IN (SELECT transaction_id FROM transaction_flags WHERE reason = ?)", filters.Reason)
+	}
+
+	return query
+}
+
+// FindTags returns the tags of the given transactions keyed by transaction ID
+func (r *Repository) FindTags(ctx context.Context, transactionIDs []string) (map[string][]string, error) {
+	tags := make(map[string][]string)
+	if len(transactionIDs) == 0 {
+		return tags, nil
+	}
+
+	var rows []schemas.TransactionTag
+	err := db.Conn(ctx, r.DB).
+		Where("transaction_id IN ?", transactionIDs).
+		Order("tag ASC").
+		Find(&rows).Error
 	if err != nil {
 		return nil, err
 	}
 
-	// Apply sorting only if both sort field and order are provided
-	if sort.By != "" && sort.Order != "" {
-		sortOrder := strings.ToUpper(sort.Order)
-		query = query.Order(fmt.Sprintf("%s %s", sort.By, sortOrder))
+	for _, row := range rows {
+		tags[row.TransactionID] = append(tags[row.TransactionID], row.Tag)
 	}
 
-	// Apply pagination
-	offset := (page - 1) * pageSize
-	err = query.
-		Offset(offset).
-		Limit(pageSize).
-		Find(&transactions).Error
+	return tags, nil
+}
+
+// FindFlags returns the anomaly flags of the given transactions keyed by transaction ID
+func (r *Repository) FindFlags(ctx context.Context, transactionIDs []string) (map[string][]schemas.TransactionFlag, error) {
+	flags := make(map[string][]schemas.TransactionFlag)
+	if len(transactionIDs) == 0 {
+		return flags, nil
+	}
+
+	var rows []schemas.TransactionFlag
+	err := db.Conn(ctx, r.DB).
+		Where("transaction_id IN ?", transactionIDs).
+		Order("reason ASC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		flags[row.TransactionID] = append(flags[row.TransactionID], row)
+	}
+
+	return flags, nil
+}
+
+// FindSplits returns the splits of the given transactions keyed by transaction ID, in allocation order
+func (r *Repository) FindSplits(ctx context.Context, transactionIDs []string) (map[string][]schemas.TransactionSplit, error) {
+	splits := make(map[string][]schemas.TransactionSplit)
+	if len(transactionIDs) == 0 {
+		return splits, nil
+	}
+
+	var rows []schemas.TransactionSplit
+	err := db.Conn(ctx, r.DB).
+		Where("transaction_id IN ?", transactionIDs).
+		Order("position ASC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		splits[row.TransactionID] = append(splits[row.TransactionID], row)
+	}
+
+	return splits, nil
+}
+
+// externalIDChunkSize is how many external IDs are looked up per query
+const externalIDChunkSize = 500
+
+// FindExternalIDs returns which of the given external IDs are already stored on a transaction that is not deleted
+func (r *Repository) FindExternalIDs(ctx context.Context, externalIDs []string) (map[string]bool, error) {
+	existing := make(map[string]bool)
+	if len(externalIDs) == 0 {
+		return existing, nil
+	}
+
+	// Query in chunks to stay below the bound parameter limit on large statements
+	for start := 0; start < len(externalIDs); start += externalIDChunkSize {
+		end := min(start+externalIDChunkSize, len(externalIDs))
+
+		var ids []string
+		err := db.Conn(ctx, r.DB).
+			Model(&schemas.Transaction{}).
+			Where("external_id IN ?", externalIDs[start:end]).
+			Pluck("external_id", &ids).Error
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range ids {
+			existing[id] = true
+		}
+	}
+
+	return existing, nil
+}
+
+// toIssueTransactions converts transactions to the list format, including category, tags, flags and link
+func (r *Repository) toIssueTransactions(ctx context.Context, transactions []schemas.Transaction) ([]schemas.IssueTransaction, error) {
+	ids := make([]string, len(transactions))
+	for i, t := range transactions {
+		ids[i] = t.ID
+	}
+
+	tags, err := r.FindTags(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	flags, err := r.FindFlags(ctx, ids)
 	if err != nil {
 		return nil, err
 	}
 
-	// Convert to IssueTransaction format
 	issues := make([]schemas.IssueTransaction, len(transactions))
 	for i, t := range transactions {
 		issues[i] = schemas.IssueTransaction{
-			ID:          t.ID,
-			Timestamp:   t.Timestamp,
-			Name:        t.Name,
-			Type:        string(t.Type),
-			Amount:      t.Amount,
-			Status:      string(t.Status),
-			Description: t.Description,
-			CreatedAt:   t.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			ID:           t.ID,
+			Timestamp:    t.Timestamp,
+			Name:         t.Name,
+			Type:         string(t.Type),
+			Amount:       t.Amount,
+			Status:       string(t.Status),
+			Description:  t.Description,
+			CreatedAt:    t.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			Tags:         tags[t.ID],
+			Flags:        flags[t.ID],
+			Currency:     t.Currency,
+			RelationType: t.RelationType,
+		}
+		if t.CategoryID != nil {
+			issues[i].CategoryID = *t.CategoryID
+		}
+		if t.CounterpartyID != nil {
+			issues[i].CounterpartyID = *t.CounterpartyID
+		}
+		if t.BatchID != nil {
+			issues[i].BatchID = *t.BatchID
+		}
+		if t.ExternalID != nil {
+			issues[i].ExternalID = *t.ExternalID
+		}
+		if t.RelatedTransactionID != nil {
+			issues[i].RelatedTransactionID = *t.RelatedTransactionID
 		}
 	}
 
-	totalPages := int(math.Ceil(float64(total) / float64(pageSize)))
-
-	// Build pagination links
-	var nextLink *string
-	var prevLink *string
+	return issues, nil
+}
 
-	if page < totalPages {
-		nextURL := fmt.Sprintf("?page=%d&page_size=%d", page+1, pageSize)
-		nextLink = &nextURL
+// orderClause builds the ORDER BY clause for a sort
+// Sorting by age is sorting by timestamp in the opposite direction
+func orderClause(sort schemas.TransactionSort) string {
+	sortOrder := strings.ToUpper(sort.Order)
+	if strings.ToLower(sort.By) != "age" {
+		return fmt.Sprintf("%s %s", sort.By, sortOrder)
 	}
 
-	if page > 1 {
-		prevURL := fmt.Sprintf("?page=%d&page_size=%d", page-1, pageSize)
-		prevLink = &prevURL
+	if sortOrder == "ASC" {
+		return "timestamp DESC"
 	}
+	return "timestamp ASC"
+}
 
-	// Build filter metadata
-	filtersMeta := make(map[string]interface{})
-	if filters.Status != "" {
-		filtersMeta["status"] = filters.Status
+// issuesScope limits a query to issues: non-successful (FAILED and PENDING) transactions
+// Anomaly flags do not make a successful transaction an issue; those are listed with the reason filter instead
+func issuesScope(query *gorm.DB) *gorm.DB {
+	return query.Where("status IN (?, ?)", schemas.StatusFailed, schemas.StatusPending)
+}
+
+// FindIssues retrieves all non-successful transactions matching the filters, without pagination
+func (r *Repository) FindIssues(ctx context.Context, filters schemas.TransactionFilters) ([]schemas.Transaction, error) {
+	var transactions []schemas.Transaction
+
+	query := issuesScope(db.Conn(ctx, r.DB).
+		Select("id", "timestamp", "type", "amount", "status"))
+
+	err := applyFilters(query, filters).Find(&transactions).Error
+	return transactions, err
+}
+
+// GetIssuesWithFiltersAndSort retrieves transactions with filtering, sorting, and pagination
+func (r *Repository) GetIssuesWithFiltersAndSort(
+	ctx context.Context,
+	page, pageSize int,
+	filters schemas.TransactionFilters,
+	sort schemas.TransactionSort,
+) (*schemas.IssuesResponse, error) {
+	if page < 1 {
+		page = 1
 	}
-	if filters.Type != "" {
-		filtersMeta["type"] = filters.Type
+	if pageSize < 1 {
+		pageSize = 10
 	}
-	if filters.SearchQuery != "" {
-		filtersMeta["search"] = filters.SearchQuery
+	if pageSize > 100 {
+		pageSize = 100
 	}
-	if filters.Amount > 0 {
-		filtersMeta["amount"] = filters.Amount
+
+	var transactions []schemas.Transaction
+	var total int64
+
+	// Build query
+	query := issuesScope(db.Conn(ctx, r.DB))
+
+	// Apply filters
+	query = applyFilters(query, filters)
+
+	// Get total count
+	err := query.Model(&schemas.Transaction{}).Count(&total).Error
+	if err != nil {
+		return nil, err
 	}
-	if filters.StartDate != "" {
-		filtersMeta["start_date"] = filters.StartDate
+
+	// Apply sorting only if both sort field and order are provided
+	if sort.By != "" && sort.Order != "" {
+		query = query.Order(orderClause(sort))
 	}
-	if filters.EndDate != "" {
-		filtersMeta["end_date"] = filters.EndDate
+
+	// Apply pagination
+	offset := (page - 1) * pageSize
+	err = query.
+		Offset(offset).
+		Limit(pageSize).
+		Find(&transactions).Error
+	if err != nil {
+		return nil, err
 	}
 
+	// Convert to IssueTransaction format
+	issues, err := r.toIssueTransactions(ctx, transactions)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build filter metadata
+	filtersMeta := filters.Meta()
+
 	return &schemas.IssuesResponse{
 		Message: constants.MsgIssuesRetrieved,
 		Data:    issues,
 		Meta: schemas.ResponseMeta{
-			Pagination: schemas.PaginationMeta{
-				Total:       int(total),
-				Count:       len(issues),
-				PerPage:     pageSize,
-				CurrentPage: page,
-				TotalPages:  totalPages,
-				Links: schemas.PaginationLinks{
-					Next: nextLink,
-					Prev: prevLink,
-				},
-			},
-			Filters: filtersMeta,
+			Pagination: schemas.NewPaginationMeta(total, len(issues), page, pageSize),
+			Filters:    filtersMeta,
 			Sort: &schemas.SortMeta{
 				By:    sort.By,
 				Order: sort.Order,
@@ -247,33 +464,10 @@ func (r *Repository) GetAllWithFiltersAndSort(
 	var total int64
 
 	// Build query - fetch ALL transactions, not just issues
-	query := r.DB.WithContext(ctx)
+	query := db.Conn(ctx, r.DB)
 
 	// Apply filters
-	if filters.Status != "" {
-		query = query.Where("status = ?", strings.ToUpper(filters.Status))
-	}
-
-	if filters.Type != "" {
-		query = query.Where("type = ?", strings.ToUpper(filters.Type))
-	}
-
-	if filters.Amount > 0 {
-		query = query.Where("amount = ?", filters.Amount)
-	}
-
-	if filters.SearchQuery != "" {
-		searchQuery := "%" + filters.SearchQuery + "%"
-		query = query.Where("name LIKE ? OR description LIKE ?", searchQuery, searchQuery)
-	}
-
-	if filters.StartDate != "" && filters.EndDate != "" {
-		query = query.Where("DATE(created_at) BETWEEN ? AND ?", filters.StartDate, filters.EndDate)
-	} else if filters.StartDate != "" {
-		query = query.Where("DATE(created_at) >= ?", filters.StartDate)
-	} else if filters.EndDate != "" {
-		query = query.Where("DATE(created_at) <= ?", filters.EndDate)
-	}
+	query = applyFilters(query, filters)
 
 	// Get total count
 	err := query.Model(&schemas.Transaction{}).Count(&total).Error
@@ -283,8 +477,7 @@ func (r *Repository) GetAllWithFiltersAndSort(
 
 	// Apply sorting only if both sort field and order are provided
 	if sort.By != "" && sort.Order != "" {
-		sortOrder := strings.ToUpper(sort.Order)
-		query = query.Order(fmt.Sprintf("%s %s", sort.By, sortOrder))
+		query = query.Order(orderClause(sort))
 	}
 
 	// Apply pagination
@@ -298,73 +491,20 @@ func (r *Repository) GetAllWithFiltersAndSort(
 	}
 
 	// Convert to IssueTransaction format
-	issues := make([]schemas.IssueTransaction, len(transactions))
-	for i, t := range transactions {
-		issues[i] = schemas.IssueTransaction{
-			ID:          t.ID,
-			Timestamp:   t.Timestamp,
-			Name:        t.Name,
-			Type:        string(t.Type),
-			Amount:      t.Amount,
-			Status:      string(t.Status),
-			Description: t.Description,
-			CreatedAt:   t.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		}
-	}
-
-	totalPages := int(math.Ceil(float64(total) / float64(pageSize)))
-
-	// Build pagination links
-	var nextLink *string
-	var prevLink *string
-
-	if page < totalPages {
-		nextURL := fmt.Sprintf("?page=%d&page_size=%d", page+1, pageSize)
-		nextLink = &nextURL
-	}
-
-	if page > 1 {
-		prevURL := fmt.Sprintf("?page=%d&page_size=%d", page-1, pageSize)
-		prevLink = &prevURL
+	issues, err := r.toIssueTransactions(ctx, transactions)
+	if err != nil {
+		return nil, err
 	}
 
 	// Build filter metadata
-	filtersMeta := make(map[string]interface{})
-	if filters.Status != "" {
-		filtersMeta["status"] = filters.Status
-	}
-	if filters.Type != "" {
-		filtersMeta["type"] = filters.Type
-	}
-	if filters.SearchQuery != "" {
-		filtersMeta["search"] = filters.SearchQuery
-	}
-	if filters.Amount > 0 {
-		filtersMeta["amount"] = filters.Amount
-	}
-	if filters.StartDate != "" {
-		filtersMeta["start_date"] = filters.StartDate
-	}
-	if filters.EndDate != "" {
-		filtersMeta["end_date"] = filters.EndDate
-	}
+	filtersMeta := filters.Meta()
 
 	return &schemas.IssuesResponse{
 		Message: constants.MsgTransactionsRetrieved,
 		Data:    issues,
 		Meta: schemas.ResponseMeta{
-			Pagination: schemas.PaginationMeta{
-				Total:       int(total),
-				Count:       len(issues),
-				PerPage:     pageSize,
-				CurrentPage: page,
-				TotalPages:  totalPages,
-				Links: schemas.PaginationLinks{
-					Next: nextLink,
-					Prev: prevLink,
-				},
-			},
-			Filters: filtersMeta,
+			Pagination: schemas.NewPaginationMeta(total, len(issues), page, pageSize),
+			Filters:    filtersMeta,
 			Sort: &schemas.SortMeta{
 				By:    sort.By,
 				Order: sort.Order,
@@ -373,10 +513,58 @@ func (r *Repository) GetAllWithFiltersAndSort(
 	}, nil
 }
 
+// StreamWithFiltersAndSort passes every matching transaction to fn, reading them through a database cursor
+// Rows are scanned one at a time so large exports never hold the result set in memory
+func (r *Repository) StreamWithFiltersAndSort(
+	ctx context.Context,
+	filters schemas.TransactionFilters,
+	sort schemas.TransactionSort,
+	fn func(transaction schemas.Transaction) error,
+) error {
+	query := applyFilters(db.Conn(ctx, r.DB).Model(&schemas.Transaction{}), filters)
+	return r.stream(query, sort, fn)
+}
+
+// StreamIssuesWithFiltersAndSort passes every matching non-successful transaction to fn
+func (r *Repository) StreamIssuesWithFiltersAndSort(
+	ctx context.Context,
+	filters schemas.TransactionFilters,
+	sort schemas.TransactionSort,
+	fn func(transaction schemas.Transaction) error,
+) error {
+	query := applyFilters(issuesScope(db.Conn(ctx, r.DB).Model(&schemas.Transaction{})), filters)
+	return r.stream(query, sort, fn)
+}
+
+// stream sorts a query and scans its rows one at a time through a cursor
+func (r *Repository) stream(query *gorm.DB, sort schemas.TransactionSort, fn func(transaction schemas.Transaction) error) error {
+	if sort.By != "" && sort.Order != "" {
+		query = query.Order(orderClause(sort))
+	}
+
+	rows, err := query.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var transaction schemas.Transaction
+		if err := r.DB.ScanRows(rows, &transaction); err != nil {
+			return err
+		}
+		if err := fn(transaction); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
 // CountByStatus counts transactions with a specific status
 func (r *Repository) CountByStatus(ctx context.Context, status schemas.TransactionStatus) (int64, error) {
 	var count int64
-	err := r.DB.WithContext(ctx).
+	err := db.Conn(ctx, r.DB).
 		Model(&schemas.Transaction{}).
 		Where("status = ?", status).
 		Count(&count).Error
@@ -386,8 +574,16 @@ func (r *Repository) CountByStatus(ctx context.Context, status schemas.Transacti
 // Count returns the total number of transactions
 func (r *Repository) Count(ctx context.Context) (int64, error) {
 	var count int64
-	err := r.DB.WithContext(ctx).
+	err := db.Conn(ctx, r.DB).
 		Model(&schemas.Transaction{}).
 		Count(&count).Error
 	return count, err
 }
+
+// CountWithFilters returns the number of transactions matching the filters
+func (r *Repository) CountWithFilters(ctx context.Context, filters schemas.TransactionFilters) (int64, error) {
+	var count int64
+	err := applyFilters(db.Conn(ctx, r.DB).Model(&schemas.Transaction{}), filters).
+		Count(&count).Error
+	return count, err
+}
@@ -17,7 +17,7 @@ func setupTestDB(t *testing.T) *gorm.DB {
 	}
 
 	// Auto migrate the schema
-	if err := db.AutoMigrate(&schemas.Transaction{}); err != nil {
+	if err := db.AutoMigrate(&schemas.Transaction{}, &schemas.TransactionRevision{}, &schemas.ClearOperation{}, &schemas.Category{}, &schemas.TransactionTag{}, &schemas.TransactionFlag{}, &schemas.TransactionSplit{}, &schemas.LinkSuggestion{}); err != nil {
 		t.Fatalf("failed to migrate schema: %v", err)
 	}
 
@@ -258,6 +258,33 @@ func TestCountByStatus(t *testing.T) {
 	}
 }
 
+// TestFindExternalIDs tests that only external IDs of transactions that are not deleted are reported
+func TestFindExternalIDs(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	kept, deleted := "ofx:1", "ofx:2"
+	transactions := []schemas.Transaction{
+		{ID: "1", Status: schemas.StatusSuccess, ExternalID: &kept},
+		{ID: "2", Status: schemas.StatusSuccess, ExternalID: &deleted},
+		{ID: "3", Status: schemas.StatusSuccess},
+	}
+	if err := db.CreateInBatches(transactions, 100).Error; err != nil {
+		t.Fatalf("failed to insert test data: %v", err)
+	}
+	db.Delete(&schemas.Transaction{}, "id = ?", "2")
+
+	existing, err := repo.FindExternalIDs(ctx, []string{"ofx:1", "ofx:2", "ofx:3"})
+	if err != nil {
+		t.Fatalf("FindExternalIDs failed: %v", err)
+	}
+
+	if len(existing) != 1 || !existing["ofx:1"] {
+		t.Errorf("Expected only ofx:1, got %v", existing)
+	}
+}
+
 // TestPagination tests pagination logic
 func TestPagination(t *testing.T) {
 	db := setupTestDB(t)
@@ -325,3 +352,112 @@ func TestPagination(t *testing.T) {
 		t.Error("Expected next link for page 2")
 	}
 }
+
+// TestGetIssuesSortByAge tests that sorting by age orders by timestamp in reverse
+func TestGetIssuesSortByAge(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	transactions := []schemas.Transaction{
+		{ID: "1", Timestamp: 1000, Status: schemas.StatusPending},
+		{ID: "2", Timestamp: 3000, Status: schemas.StatusPending},
+		{ID: "3", Timestamp: 2000, Status: schemas.StatusFailed},
+	}
+
+	if err := db.CreateInBatches(transactions, 100).Error; err != nil {
+		t.Fatalf("failed to insert test data: %v", err)
+	}
+
+	sort := schemas.TransactionSort{By: "age", Order: "DESC"}
+	response, err := repo.GetIssuesWithFiltersAndSort(ctx, 1, 10, schemas.TransactionFilters{}, sort)
+	if err != nil {
+		t.Fatalf("GetIssuesWithFiltersAndSort failed: %v", err)
+	}
+
+	// Oldest first when sorting by age descending
+	expectedOrder := []string{"1", "3", "2"}
+	for i, id := range expectedOrder {
+		if response.Data[i].ID != id {
+			t.Errorf("Expected issue %s at position %d, got %s", id, i, response.Data[i].ID)
+		}
+	}
+}
+
+// TestGetAllFiltersByCategoryAndTag tests the category and tag filters and that tags are returned
+func TestGetAllFiltersByCategoryAndTag(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	food := "cat-food"
+	db.Create(&schemas.Category{ID: food, Name: "Food"})
+
+	transactions := []schemas.Transaction{
+		{ID: "1", Timestamp: 1000, Name: "A", Type: schemas.TypeDebit, Amount: 100, Status: schemas.StatusSuccess, CategoryID: &food},
+		{ID: "2", Timestamp: 2000, Name: "B", Type: schemas.TypeDebit, Amount: 200, Status: schemas.StatusSuccess},
+	}
+	if err := db.Create(&transactions).Error; err != nil {
+		t.Fatalf("failed to insert test data: %v", err)
+	}
+	db.Create(&[]schemas.TransactionTag{{TransactionID: "1", Tag: "lunch"}, {TransactionID: "2", Tag: "rent"}})
+
+	// Category matches by name, ignoring case
+	response, err := repo.GetAllWithFiltersAndSort(ctx, 1, 10, schemas.TransactionFilters{Category: "food"}, schemas.TransactionSort{})
+	if err != nil {
+		t.Fatalf("GetAllWithFiltersAndSort failed: %v", err)
+	}
+	if len(response.Data) != 1 || response.Data[0].ID != "1" || response.Data[0].CategoryID != food {
+		t.Errorf("Expected only transaction 1 for category, got %+v", response.Data)
+	}
+	if len(response.Data) == 1 && (len(response.Data[0].Tags) != 1 || response.Data[0].Tags[0] != "lunch") {
+		t.Errorf("Expected tags [lunch], got %v", response.Data[0].Tags)
+	}
+
+	response, err = repo.GetAllWithFiltersAndSort(ctx, 1, 10, schemas.TransactionFilters{Tag: "rent"}, schemas.TransactionSort{})
+	if err != nil {
+		t.Fatalf("GetAllWithFiltersAndSort failed: %v", err)
+	}
+	if len(response.Data) != 1 || response.Data[0].ID != "2" {
+		t.Errorf("Expected only transaction 2 for tag, got %+v", response.Data)
+	}
+}
+
+// TestFlaggedSuccessIsNotAnIssue tests that flags do not make a successful transaction an issue, and that the
+// reason filter lists flagged transactions of any status
+func TestFlaggedSuccessIsNotAnIssue(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	db.Create(&[]schemas.Transaction{
+		{ID: "1", Timestamp: 1000, Name: "BIG SPEND", Type: schemas.TypeDebit, Amount: 5000000, Status: schemas.StatusSuccess},
+		{ID: "2", Timestamp: 2000, Name: "SHOP", Type: schemas.TypeDebit, Amount: 1000, Status: schemas.StatusFailed},
+	})
+	db.Create(&[]schemas.TransactionFlag{{TransactionID: "1", Reason: schemas.FlagLargeDebit}, {TransactionID: "2", Reason: schemas.FlagLargeDebit}})
+	sort := schemas.TransactionSort{By: "timestamp", Order: "ASC"}
+
+	issues, err := repo.GetIssuesWithFiltersAndSort(ctx, 1, 10, schemas.TransactionFilters{}, sort)
+	if err != nil {
+		t.Fatalf("GetIssuesWithFiltersAndSort failed: %v", err)
+	}
+	if len(issues.Data) != 1 || issues.Data[0].ID != "2" || len(issues.Data[0].Flags) != 1 {
+		t.Errorf("Expected only the failed transaction, with its flag, got %+v", issues.Data)
+	}
+
+	found, err := repo.FindIssues(ctx, schemas.TransactionFilters{})
+	if err != nil {
+		t.Fatalf("FindIssues failed: %v", err)
+	}
+	if len(found) != 1 {
+		t.Errorf("Expected 1 issue for the summary, got %d", len(found))
+	}
+
+	flagged, err := repo.GetAllWithFiltersAndSort(ctx, 1, 10, schemas.TransactionFilters{Reason: schemas.FlagLargeDebit}, sort)
+	if err != nil {
+		t.Fatalf("GetAllWithFiltersAndSort failed: %v", err)
+	}
+	if flagged.Meta.Pagination.Total != 2 {
+		t.Errorf("Expected both flagged transactions, got %d", flagged.Meta.Pagination.Total)
+	}
+}
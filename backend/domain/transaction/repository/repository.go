@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
 	"gorm.io/gorm"
@@ -12,18 +13,39 @@ type IRepository interface {
 	// Commands
 	Create(ctx context.Context, transaction *schemas.Transaction) error
 	CreateBatch(ctx context.Context, transactions []schemas.Transaction) error
-	DeleteAll(ctx context.Context) error
+	Update(ctx context.Context, transaction *schemas.Transaction) error
+	Delete(ctx context.Context, id string) error
+	CreateRevision(ctx context.Context, revision *schemas.TransactionRevision) error
+	CreateTags(ctx context.Context, tags []schemas.TransactionTag) error
+	CreateUploadBatch(ctx context.Context, batch *schemas.UploadBatch) error
+	SoftDeleteAll(ctx context.Context, operation *schemas.ClearOperation) error
+	RestoreClear(ctx context.Context, operation *schemas.ClearOperation) (int64, error)
+	PurgeDeleted(ctx context.Context, purgedBy string, purgedAt time.Time) (int64, error)
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
 
 	// Queries
 	FindByID(ctx context.Context, id string) (*schemas.Transaction, error)
 	FindAll(ctx context.Context) ([]schemas.Transaction, error)
+	FindRevisions(ctx context.Context, transactionID string) ([]schemas.TransactionRevision, error)
+	FindTags(ctx context.Context, transactionIDs []string) (map[string][]string, error)
+	FindFlags(ctx context.Context, transactionIDs []string) (map[string][]schemas.TransactionFlag, error)
+	FindSplits(ctx context.Context, transactionIDs []string) (map[string][]schemas.TransactionSplit, error)
+	FindExternalIDs(ctx context.Context, externalIDs []string) (map[string]bool, error)
+	FindClearOperation(ctx context.Context, id string) (*schemas.ClearOperation, error)
+	FindLatestClearOperation(ctx context.Context) (*schemas.ClearOperation, error)
+	FindClearOperations(ctx context.Context, limit int) ([]schemas.ClearOperation, error)
+	FindUploadBatches(ctx context.Context, limit int) ([]schemas.UploadBatch, error)
 	FindByStatus(ctx context.Context, status schemas.TransactionStatus) ([]schemas.Transaction, error)
 	GetBalance(ctx context.Context) (int64, int64, error)
 	GetIssues(ctx context.Context, page int, pageSize int) (*schemas.IssuesResponse, error)
+	FindIssues(ctx context.Context, filters schemas.TransactionFilters) ([]schemas.Transaction, error)
 	GetIssuesWithFiltersAndSort(ctx context.Context, page int, pageSize int, filters schemas.TransactionFilters, sort schemas.TransactionSort) (*schemas.IssuesResponse, error)
 	GetAllWithFiltersAndSort(ctx context.Context, page int, pageSize int, filters schemas.TransactionFilters, sort schemas.TransactionSort) (*schemas.IssuesResponse, error)
+	StreamWithFiltersAndSort(ctx context.Context, filters schemas.TransactionFilters, sort schemas.TransactionSort, fn func(transaction schemas.Transaction) error) error
+	StreamIssuesWithFiltersAndSort(ctx context.Context, filters schemas.TransactionFilters, sort schemas.TransactionSort, fn func(transaction schemas.Transaction) error) error
 	CountByStatus(ctx context.Context, status schemas.TransactionStatus) (int64, error)
 	Count(ctx context.Context) (int64, error)
+	CountWithFilters(ctx context.Context, filters schemas.TransactionFilters) (int64, error)
 }
 
 // Repository implements IRepository
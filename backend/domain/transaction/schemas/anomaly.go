@@ -0,0 +1,107 @@
+package schemas
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Anomaly reason codes stored on flagged transactions
+const (
+	FlagAmountOutlier   = "AMOUNT_OUTLIER"
+	FlagFailedRateSpike = "FAILED_RATE_SPIKE"
+	FlagLargeDebit      = "LARGE_DEBIT"
+	FlagOddHours        = "ODD_HOURS"
+)
+
+// Outlier methods for judging an amount against a counterparty's history
+const (
+	OutlierMethodZScore = "zscore"
+	OutlierMethodIQR    = "iqr"
+)
+
+// TransactionFlag is an anomaly found in a transaction; flagged transactions are listed with the reason filter
+type TransactionFlag struct {
+	TransactionID string    `gorm:"primaryKey;type:text" json:"-"`
+	Reason        string    `gorm:"primaryKey;type:text;index" json:"reason"`
+	Detail        string    `gorm:"type:text" json:"detail"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for TransactionFlag
+func (TransactionFlag) TableName() string {
+	return "transaction_flags"
+}
+
+// ValidFlagReason reports whether a reason filter names a known anomaly reason
+func ValidFlagReason(reason string) bool {
+	switch reason {
+	case FlagAmountOutlier, FlagFailedRateSpike, FlagLargeDebit, FlagOddHours:
+		return true
+	}
+	return false
+}
+
+// AnomalyPolicy holds the configured thresholds of the anomaly detector
+type AnomalyPolicy struct {
+	OutlierMethod    string
+	ZScoreThreshold  float64
+	IQRMultiplier    float64
+	MinHistory       int
+	FailedRateDelta  float64
+	LargeDebitAmount int64
+	OddHoursStart    int
+	OddHoursEnd      int
+	Location         *time.Location
+}
+
+// NewAnomalyPolicy builds an anomaly policy from configuration values
+// largeDebitAmount is in cents and 0 disables the check; oddHours is "start-end" in local hours,
+// end exclusive and allowed to wrap past midnight ("22-5"), and empty disables the check
+func NewAnomalyPolicy(method string, zScore, iqrMultiplier float64, minHistory int, failedRateDelta float64, largeDebitAmount int64, oddHours, timezone string) (AnomalyPolicy, error) {
+	method = strings.ToLower(strings.TrimSpace(method))
+	if method != OutlierMethodZScore && method != OutlierMethodIQR {
+		return AnomalyPolicy{}, fmt.Errorf("invalid outlier method %q: use %s or %s", method, OutlierMethodZScore, OutlierMethodIQR)
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return AnomalyPolicy{}, fmt.Errorf("invalid anomaly timezone %q: %w", timezone, err)
+	}
+
+	policy := AnomalyPolicy{
+		OutlierMethod:    method,
+		ZScoreThreshold:  zScore,
+		IQRMultiplier:    iqrMultiplier,
+		MinHistory:       minHistory,
+		FailedRateDelta:  failedRateDelta,
+		LargeDebitAmount: largeDebitAmount,
+		OddHoursStart:    -1,
+		OddHoursEnd:      -1,
+		Location:         location,
+	}
+
+	if oddHours = strings.TrimSpace(oddHours); oddHours != "" {
+		start, end, ok := strings.Cut(oddHours, "-")
+		startHour, startErr := strconv.Atoi(strings.TrimSpace(start))
+		endHour, endErr := strconv.Atoi(strings.TrimSpace(end))
+		if !ok || startErr != nil || endErr != nil || startHour < 0 || startHour > 23 || endHour < 0 || endHour > 24 || startHour == endHour {
+			return AnomalyPolicy{}, fmt.Errorf("invalid odd hours %q: expected start-end, e.g. 0-5", oddHours)
+		}
+		policy.OddHoursStart, policy.OddHoursEnd = startHour, endHour
+	}
+
+	return policy, nil
+}
+
+// IsOddHour reports whether a local hour falls in the configured odd hours
+func (p AnomalyPolicy) IsOddHour(hour int) bool {
+	if p.OddHoursStart < 0 {
+		return false
+	}
+	if p.OddHoursStart < p.OddHoursEnd {
+		return hour >= p.OddHoursStart && hour < p.OddHoursEnd
+	}
+	return hour >= p.OddHoursStart || hour < p.OddHoursEnd
+}
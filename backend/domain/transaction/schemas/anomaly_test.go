@@ -0,0 +1,48 @@
+package schemas
+
+import "testing"
+
+// TestAnomalyPolicyOddHours tests odd hour windows, including one that wraps past midnight
+func TestAnomalyPolicyOddHours(t *testing.T) {
+	tests := []struct {
+		window string
+		odd    []int
+		normal []int
+	}{
+		{window: "0-5", odd: []int{0, 4}, normal: []int{5, 12, 23}},
+		{window: "22-5", odd: []int{22, 23, 0, 4}, normal: []int{5, 12, 21}},
+		{window: "", normal: []int{0, 3, 23}},
+	}
+
+	for _, tc := range tests {
+		policy, err := NewAnomalyPolicy(OutlierMethodZScore, 3, 1.5, 5, 0.25, 0, tc.window, "UTC")
+		if err != nil {
+			t.Fatalf("NewAnomalyPolicy(%q) failed: %v", tc.window, err)
+		}
+		for _, hour := range tc.odd {
+			if !policy.IsOddHour(hour) {
+				t.Errorf("Expected hour %d to be odd for %q", hour, tc.window)
+			}
+		}
+		for _, hour := range tc.normal {
+			if policy.IsOddHour(hour) {
+				t.Errorf("Expected hour %d not to be odd for %q", hour, tc.window)
+			}
+		}
+	}
+}
+
+// TestNewAnomalyPolicyInvalid tests rejection of malformed anomaly settings
+func TestNewAnomalyPolicyInvalid(t *testing.T) {
+	if _, err := NewAnomalyPolicy("median", 3, 1.5, 5, 0.25, 0, "0-5", "UTC"); err == nil {
+		t.Error("Expected error for unknown outlier method")
+	}
+	if _, err := NewAnomalyPolicy(OutlierMethodIQR, 3, 1.5, 5, 0.25, 0, "0-5", "Mars/Base"); err == nil {
+		t.Error("Expected error for unknown timezone")
+	}
+	for _, window := range []string{"5", "a-b", "3-3", "0-25", "-1-4"} {
+		if _, err := NewAnomalyPolicy(OutlierMethodIQR, 3, 1.5, 5, 0.25, 0, window, "UTC"); err == nil {
+			t.Errorf("Expected error for odd hours %q", window)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package schemas
+
+import "io"
+
+// Outcomes of one file of an uploaded archive
+const (
+	ArchiveFileStored  = "STORED"
+	ArchiveFileSkipped = "SKIPPED"
+	ArchiveFileFailed  = "FAILED"
+)
+
+// UploadFile is one file of an uploaded archive, stored as an upload batch of its own
+// Err is set when the file cannot be processed at all, such as when its type is not supported
+type UploadFile struct {
+	Filename string
+	Format   string
+	Open     func() (io.ReadCloser, error)
+	Err      error
+}
+
+// ArchiveFileResult is the outcome of one file of an uploaded archive
+// Skipped files held only transactions that were uploaded before
+type ArchiveFileResult struct {
+	Filename string          `json:"filename"`
+	Format   string          `json:"format,omitempty"`
+	Status   string          `json:"status"`
+	Error    string          `json:"error,omitempty"`
+	Result   *UploadResponse `json:"result,omitempty"`
+}
+
+// ArchiveUploadResponse summarises an uploaded zip archive file by file
+type ArchiveUploadResponse struct {
+	Message      string              `json:"message"`
+	TotalFiles   int                 `json:"total_files"`
+	StoredFiles  int                 `json:"stored_files"`
+	SkippedFiles int                 `json:"skipped_files"`
+	FailedFiles  int                 `json:"failed_files"`
+	TotalRecords int                 `json:"total_records"`
+	Files        []ArchiveFileResult `json:"files"`
+}
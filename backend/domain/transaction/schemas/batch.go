@@ -0,0 +1,61 @@
+package schemas
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// maxSourceLength is the longest upload source label accepted
+const maxSourceLength = 50
+
+var (
+	ErrBatchNotFound     = errors.New("upload batch not found")
+	ErrInvalidSource     = errors.New("invalid upload source")
+	ErrInvalidUploadFile = errors.New("invalid upload file")
+
+	sourcePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.-]*$`)
+)
+
+// UploadBatch records one uploaded file, so its rows can be told apart from other uploads
+// Bank statement uploads also keep a balance check per statement, and BalanceMismatch is set when any of them fails
+type UploadBatch struct {
+	ID              string             `gorm:"primaryKey;type:text" json:"id"`
+	Source          string             `gorm:"type:text;index" json:"source,omitempty"`
+	Filename        string             `gorm:"type:text" json:"filename,omitempty"`
+	TotalRecords    int                `json:"total_records"`
+	UploadedBy      string             `gorm:"type:text" json:"uploaded_by"`
+	CreatedAt       time.Time          `gorm:"index" json:"created_at"`
+	BalanceMismatch bool               `json:"balance_mismatch,omitempty"`
+	Balances        []StatementBalance `gorm:"foreignKey:BatchID" json:"statement_balances,omitempty"`
+}
+
+// TableName specifies the table name for UploadBatch
+func (UploadBatch) TableName() string {
+	return "upload_batches"
+}
+
+// UploadOptions describes where an uploaded file came from
+// Format selects the parser and defaults to CSV. Profile names an import profile whose source and parse options
+// apply wherever the upload leaves them empty
+type UploadOptions struct {
+	Source   string
+	Filename string
+	Format   string
+	Profile  string
+	ParseOptions
+}
+
+// NormalizeSource lowercases and checks an upload source label such as "bank" or "ledger"
+// An empty source is allowed
+func NormalizeSource(source string) (string, error) {
+	source = strings.ToLower(strings.TrimSpace(source))
+	if source == "" {
+		return "", nil
+	}
+	if len(source) > maxSourceLength || !sourcePattern.MatchString(source) {
+		return "", ErrInvalidSource
+	}
+	return source, nil
+}
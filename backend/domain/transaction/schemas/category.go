@@ -0,0 +1,110 @@
+package schemas
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	ErrCategoryNotFound = errors.New("category not found")
+	ErrInvalidCategory  = errors.New("invalid category")
+	ErrCategoryInUse    = errors.New("category is in use")
+	ErrInvalidTag       = errors.New("invalid tag")
+)
+
+const (
+	maxCategoryNameLength = 100
+	maxTagLength          = 50
+)
+
+var tagPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9 _:.-]*$`)
+
+// Category is a node in the category taxonomy
+// ParentID links a category to its parent, e.g. "restaurant" under "food"
+type Category struct {
+	ID          string    `gorm:"primaryKey;type:text" json:"id"`
+	Name        string    `gorm:"type:text;uniqueIndex" json:"name"`
+	ParentID    *string   `gorm:"type:text;index" json:"parent_id"`
+	Description string    `gorm:"type:text" json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name for Category
+func (Category) TableName() string {
+	return "categories"
+}
+
+// TransactionTag assigns one free-form tag to a transaction
+type TransactionTag struct {
+	TransactionID string    `gorm:"primaryKey;type:text" json:"transaction_id"`
+	Tag           string    `gorm:"primaryKey;type:text;index" json:"tag"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for TransactionTag
+func (TransactionTag) TableName() string {
+	return "transaction_tags"
+}
+
+// CategoryRequest represents a category create or update request
+type CategoryRequest struct {
+	Name        *string `json:"name"`
+	ParentID    *string `json:"parent_id"`
+	Description *string `json:"description"`
+}
+
+// CategoryAssignmentRequest assigns a category to one or more transactions
+// A null category_id removes the category
+type CategoryAssignmentRequest struct {
+	TransactionIDs []string `json:"transaction_ids"`
+	CategoryID     *string  `json:"category_id"`
+}
+
+// TagsRequest adds and removes tags on one or more transactions
+type TagsRequest struct {
+	TransactionIDs []string `json:"transaction_ids"`
+	Add            []string `json:"add"`
+	Remove         []string `json:"remove"`
+}
+
+// AssignmentResponse reports how many transactions a category or tag change touched
+type AssignmentResponse struct {
+	Message string `json:"message"`
+	Updated int    `json:"updated"`
+}
+
+// NormalizeCategoryName trims a category name and checks its length
+func NormalizeCategoryName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("%w: name cannot be empty", ErrInvalidCategory)
+	}
+	if len(name) > maxCategoryNameLength {
+		return "", fmt.Errorf("%w: name exceeds maximum length of %d characters", ErrInvalidCategory, maxCategoryNameLength)
+	}
+	return name, nil
+}
+
+// NormalizeTags lowercases, trims and de-duplicates tags
+func NormalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]bool)
+	normalized := make([]string, 0, len(tags))
+
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || len(tag) > maxTagLength || !tagPattern.MatchString(tag) {
+			return nil, fmt.Errorf("%w: %q must be 1-%d characters of letters, digits, space, _ : . or -", ErrInvalidTag, tag, maxTagLength)
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+
+	return normalized, nil
+}
@@ -0,0 +1,63 @@
+package schemas
+
+import (
+	"errors"
+	"time"
+)
+
+// PurgeConfirmation must be sent to confirm a purge
+const PurgeConfirmation = "PURGE"
+
+var (
+	ErrNothingToRestore     = errors.New("no clear operation to restore")
+	ErrRestoreWindowExpired = errors.New("clear operation is outside the retention window")
+	ErrPurgeNotConfirmed    = errors.New(`purge must be confirmed with "confirm": "` + PurgeConfirmation + `"`)
+)
+
+// ClearOperation records a soft-delete of all transactions so it can be restored
+type ClearOperation struct {
+	ID           string     `gorm:"primaryKey;type:text" json:"id"`
+	ClearedBy    string     `gorm:"type:text" json:"cleared_by"`
+	ClientIP     string     `gorm:"type:text" json:"client_ip"`
+	ClearedAt    time.Time  `gorm:"index" json:"cleared_at"`
+	AffectedRows int64      `json:"affected_rows"`
+	RestoredBy   string     `gorm:"type:text" json:"restored_by,omitempty"`
+	RestoredAt   *time.Time `json:"restored_at,omitempty"`
+	PurgedBy     string     `gorm:"type:text" json:"purged_by,omitempty"`
+	PurgedAt     *time.Time `json:"purged_at,omitempty"`
+}
+
+// TableName specifies the table name for ClearOperation
+func (ClearOperation) TableName() string {
+	return "clear_operations"
+}
+
+// ClearResponse represents the response after clearing transactions
+type ClearResponse struct {
+	Message         string          `json:"message"`
+	Operation       *ClearOperation `json:"operation"`
+	RestorableUntil string          `json:"restorable_until"`
+}
+
+// RestoreRequest selects the clear operation to undo; empty means the latest one
+type RestoreRequest struct {
+	ClearID string `json:"clear_id"`
+}
+
+// RestoreResponse represents the response after restoring a clear
+type RestoreResponse struct {
+	Message       string          `json:"message"`
+	Operation     *ClearOperation `json:"operation"`
+	RestoredCount int64           `json:"restored_count"`
+}
+
+// PurgeRequest confirms a hard delete of soft-deleted transactions
+type PurgeRequest struct {
+	Confirm string `json:"confirm"`
+}
+
+// PurgeResponse represents the response after purging
+type PurgeResponse struct {
+	Message     string `json:"message"`
+	PurgedCount int64  `json:"purged_count"`
+}
@@ -0,0 +1,91 @@
+package schemas
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrCounterpartyNotFound = errors.New("counterparty not found")
+	ErrInvalidCounterparty  = errors.New("invalid counterparty")
+)
+
+// Counterparty is a canonical party that transactions are made with
+type Counterparty struct {
+	ID        string              `gorm:"primaryKey;type:text" json:"id"`
+	Name      string              `gorm:"type:text;index" json:"name"`
+	Aliases   []CounterpartyAlias `gorm:"foreignKey:CounterpartyID" json:"aliases,omitempty"`
+	CreatedAt time.Time           `json:"created_at"`
+	UpdatedAt time.Time           `json:"updated_at"`
+}
+
+// TableName specifies the table name for Counterparty
+func (Counterparty) TableName() string {
+	return "counterparties"
+}
+
+// CounterpartyAlias maps a normalized transaction name to a counterparty
+// Name keeps the first spelling seen, NormalizedName is what uploads are matched on
+type CounterpartyAlias struct {
+	NormalizedName string    `gorm:"primaryKey;type:text" json:"normalized_name"`
+	CounterpartyID string    `gorm:"type:text;index" json:"counterparty_id"`
+	Name           string    `gorm:"type:text" json:"name"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for CounterpartyAlias
+func (CounterpartyAlias) TableName() string {
+	return "counterparty_aliases"
+}
+
+// CounterpartyRequest renames a counterparty
+type CounterpartyRequest struct {
+	Name string `json:"name"`
+}
+
+// CounterpartyAliasRequest adds an alias to a counterparty
+type CounterpartyAliasRequest struct {
+	Alias string `json:"alias"`
+}
+
+// CounterpartyMergeRequest merges another counterparty into this one
+type CounterpartyMergeRequest struct {
+	SourceID string `json:"source_id"`
+}
+
+// CounterpartyLinkResponse reports how many transactions were linked to counterparties
+type CounterpartyLinkResponse struct {
+	Message string `json:"message"`
+	Linked  int    `json:"linked"`
+	Created int    `json:"created"`
+}
+
+// CounterpartySummary holds the activity totals of a counterparty
+// Credit and debit totals only count SUCCESS transactions
+type CounterpartySummary struct {
+	Counterparty  *Counterparty `json:"counterparty"`
+	TotalCount    int64         `json:"total_count"`
+	SuccessCount  int64         `json:"success_count"`
+	FailedCount   int64         `json:"failed_count"`
+	PendingCount  int64         `json:"pending_count"`
+	FailureRate   float64       `json:"failure_rate"`
+	CreditTotal   int64         `json:"credit_total"`
+	DebitTotal    int64         `json:"debit_total"`
+	Net           int64         `json:"net"`
+	FirstActivity string        `json:"first_activity,omitempty"`
+	LastActivity  string        `json:"last_activity,omitempty"`
+}
+
+// CounterpartyListItem is a counterparty with its transaction count
+type CounterpartyListItem struct {
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	TransactionCount int64  `json:"transaction_count"`
+}
+
+// CounterpartiesResponse represents the counterparty list response
+type CounterpartiesResponse struct {
+	Message string                 `json:"message"`
+	Data    []CounterpartyListItem `json:"data"`
+	Meta    ResponseMeta           `json:"meta"`
+}
@@ -0,0 +1,17 @@
+package schemas
+
+import "errors"
+
+// ErrExportTooLarge is returned when more transactions match than one XLSX sheet holds
+var ErrExportTooLarge = errors.New("too many transactions for an xlsx export")
+
+// Formats of the transaction export
+const (
+	ExportFormatCSV  = "csv"
+	ExportFormatXLSX = "xlsx"
+)
+
+// ValidExportFormat reports whether format is a known export format
+func ValidExportFormat(format string) bool {
+	return format == ExportFormatCSV || format == ExportFormatXLSX
+}
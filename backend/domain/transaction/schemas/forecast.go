@@ -0,0 +1,49 @@
+package schemas
+
+import "errors"
+
+// Forecast flow sources
+const (
+	FlowSourcePending   = "pending"
+	FlowSourceRecurring = "recurring"
+)
+
+// Forecast horizon limits in days
+const (
+	ForecastDefaultDays = 30
+	ForecastMaxDays     = 365
+)
+
+var ErrInvalidForecastDays = errors.New("invalid forecast days")
+
+// ForecastFlow is a projected credit or debit with the probability that it succeeds
+type ForecastFlow struct {
+	Date           string  `json:"date"`
+	Source         string  `json:"source"`
+	TransactionID  string  `json:"transaction_id,omitempty"`
+	CounterpartyID string  `json:"counterparty_id,omitempty"`
+	Name           string  `json:"name"`
+	Type           string  `json:"type"`
+	Amount         int64   `json:"amount"`
+	Probability    float64 `json:"probability"`
+}
+
+// ForecastDay is the projected closing balance of one day with its low and high band
+type ForecastDay struct {
+	Date            string `json:"date"`
+	ExpectedInflow  int64  `json:"expected_inflow"`
+	ExpectedOutflow int64  `json:"expected_outflow"`
+	Low             int64  `json:"low"`
+	Expected        int64  `json:"expected"`
+	High            int64  `json:"high"`
+}
+
+// ForecastResponse represents the cash-flow forecast
+type ForecastResponse struct {
+	Message         string         `json:"message"`
+	Days            int            `json:"days"`
+	StartingBalance int64          `json:"starting_balance"`
+	Daily           []ForecastDay  `json:"daily"`
+	Flows           []ForecastFlow `json:"flows"`
+	GeneratedAt     string         `json:"generated_at"`
+}
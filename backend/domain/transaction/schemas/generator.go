@@ -0,0 +1,188 @@
+package schemas
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidGeneratorConfig = errors.New("invalid generator config")
+
+// Amount distributions of generated transactions
+const (
+	DistributionFixed     = "fixed"
+	DistributionUniform   = "uniform"
+	DistributionNormal    = "normal"
+	DistributionLogNormal = "lognormal"
+)
+
+// AmountDistribution draws the amounts of generated transactions, in whole currency units as written in upload files
+// The parameters depend on the kind: fixed:AMOUNT, uniform:MIN:MAX, normal:MEAN:STDDEV and lognormal:MEDIAN:SIGMA
+type AmountDistribution struct {
+	Kind string
+	A    float64
+	B    float64
+}
+
+// ParseAmountDistribution reads a distribution written as kind:param[:param], e.g. lognormal:150000:1
+func ParseAmountDistribution(value string) (AmountDistribution, error) {
+	parts := strings.Split(strings.ToLower(strings.TrimSpace(value)), ":")
+	params := make([]float64, len(parts)-1)
+	for i, part := range parts[1:] {
+		param, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil || math.IsNaN(param) || math.IsInf(param, 0) {
+			return AmountDistribution{}, fmt.Errorf("%w: invalid amount distribution %q", ErrInvalidGeneratorConfig, value)
+		}
+		params[i] = param
+	}
+
+	d := AmountDistribution{Kind: parts[0]}
+	var ok bool
+	switch d.Kind {
+	case DistributionFixed:
+		ok = len(params) == 1 && params[0] >= 1
+	case DistributionUniform:
+		ok = len(params) == 2 && params[0] >= 1 && params[1] >= params[0]
+	case DistributionNormal:
+		ok = len(params) == 2 && params[0] >= 1 && params[1] >= 0
+	case DistributionLogNormal:
+		ok = len(params) == 2 && params[0] >= 1 && params[1] >= 0
+	}
+	if !ok {
+		return AmountDistribution{}, fmt.Errorf("%w: invalid amount distribution %q, expected fixed:AMOUNT, uniform:MIN:MAX, normal:MEAN:STDDEV or lognormal:MEDIAN:SIGMA with amounts of at least 1",
+			ErrInvalidGeneratorConfig, value)
+	}
+	d.A = params[0]
+	if len(params) > 1 {
+		d.B = params[1]
+	}
+	return d, nil
+}
+
+// Sample draws an amount of at least 1 from the distribution
+func (d AmountDistribution) Sample(rng *rand.Rand) int64 {
+	var amount float64
+	switch d.Kind {
+	case DistributionUniform:
+		amount = d.A + rng.Float64()*(d.B-d.A)
+	case DistributionNormal:
+		amount = d.A + rng.NormFloat64()*d.B
+	case DistributionLogNormal:
+		amount = d.A * math.Exp(rng.NormFloat64()*d.B)
+	default:
+		amount = d.A
+	}
+	return int64(math.Max(1, math.Round(amount)))
+}
+
+// StatusWeights gives the relative share of each status among generated transactions
+type StatusWeights map[TransactionStatus]float64
+
+// ParseStatusWeights reads weights written as status=weight pairs, e.g. success=90,failed=5,pending=5
+// Statuses left out are not generated
+func ParseStatusWeights(value string) (StatusWeights, error) {
+	weights := StatusWeights{}
+	total := 0.0
+	for _, pair := range strings.Split(value, ",") {
+		status, weight, ok := strings.Cut(pair, "=")
+		status = strings.ToUpper(strings.TrimSpace(status))
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(weight), 64)
+		if !ok || err != nil || parsed < 0 || math.IsInf(parsed, 0) {
+			return nil, fmt.Errorf("%w: invalid status weight %q, expected status=weight", ErrInvalidGeneratorConfig, pair)
+		}
+		switch TransactionStatus(status) {
+		case StatusSuccess, StatusFailed, StatusPending:
+		default:
+			return nil, fmt.Errorf("%w: unknown status %q", ErrInvalidGeneratorConfig, status)
+		}
+		weights[TransactionStatus(status)] += parsed
+		total += parsed
+	}
+	if total <= 0 {
+		return nil, fmt.Errorf("%w: status weights must add up to more than 0", ErrInvalidGeneratorConfig)
+	}
+	return weights, nil
+}
+
+// Sample draws a status with probability proportional to its weight
+// Statuses are visited in a fixed order, so the same random source gives the same statuses
+func (w StatusWeights) Sample(rng *rand.Rand) TransactionStatus {
+	order := []TransactionStatus{StatusSuccess, StatusFailed, StatusPending}
+	total := 0.0
+	for _, status := range order {
+		total += w[status]
+	}
+
+	pick := rng.Float64() * total
+	for _, status := range order {
+		if pick < w[status] {
+			return status
+		}
+		pick -= w[status]
+	}
+	for i := len(order) - 1; i >= 0; i-- {
+		if w[order[i]] > 0 {
+			return order[i]
+		}
+	}
+	return StatusSuccess
+}
+
+// GeneratorConfig describes a synthetic dataset; the same config, seed included, always gives the same rows
+// Rows counts every row, duplicates and invalid rows included. DuplicateRate is the share of rows repeating an earlier
+// row exactly and InvalidRate the share of rows that fail upload validation
+type GeneratorConfig struct {
+	Seed           int64
+	Rows           int
+	Counterparties int
+	Start          time.Time
+	End            time.Time
+	CreditRatio    float64
+	CreditAmount   AmountDistribution
+	DebitAmount    AmountDistribution
+	Statuses       StatusWeights
+	DuplicateRate  float64
+	InvalidRate    float64
+}
+
+// Validate checks that the config describes a dataset that can be generated
+func (c GeneratorConfig) Validate() error {
+	switch {
+	case c.Rows < 1:
+		return fmt.Errorf("%w: rows must be at least 1", ErrInvalidGeneratorConfig)
+	case c.Counterparties < 1:
+		return fmt.Errorf("%w: counterparties must be at least 1", ErrInvalidGeneratorConfig)
+	case c.Start.Year() < 2000:
+		return fmt.Errorf("%w: start must be in 2000 or later", ErrInvalidGeneratorConfig)
+	case !c.End.After(c.Start):
+		return fmt.Errorf("%w: end must be after start", ErrInvalidGeneratorConfig)
+	case c.CreditRatio < 0 || c.CreditRatio > 1:
+		return fmt.Errorf("%w: credit ratio must be between 0 and 1", ErrInvalidGeneratorConfig)
+	case c.DuplicateRate < 0 || c.InvalidRate < 0 || c.DuplicateRate+c.InvalidRate > 1:
+		return fmt.Errorf("%w: duplicate and invalid rates must be between 0 and 1 together", ErrInvalidGeneratorConfig)
+	case c.CreditAmount.Kind == "" || c.DebitAmount.Kind == "":
+		return fmt.Errorf("%w: credit and debit amount distributions are required", ErrInvalidGeneratorConfig)
+	case len(c.Statuses) == 0:
+		return fmt.Errorf("%w: status weights are required", ErrInvalidGeneratorConfig)
+	}
+	return nil
+}
+
+// GeneratorSummary counts the rows of a generated dataset
+type GeneratorSummary struct {
+	Seed       int64          `json:"seed"`
+	Rows       int            `json:"rows"`
+	Valid      int            `json:"valid_rows"`
+	Duplicates int            `json:"duplicate_rows"`
+	Invalid    int            `json:"invalid_rows"`
+	Credits    int            `json:"credits"`
+	Debits     int            `json:"debits"`
+	Statuses   map[string]int `json:"statuses"`
+	Start      time.Time      `json:"start"`
+	End        time.Time      `json:"end"`
+	BatchID    string         `json:"batch_id,omitempty"`
+}
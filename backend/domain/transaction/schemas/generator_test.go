@@ -0,0 +1,73 @@
+package schemas
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+// TestParseAmountDistribution tests parsing and sampling of amount distributions
+func TestParseAmountDistribution(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	fixed, err := ParseAmountDistribution("fixed:250")
+	if err != nil {
+		t.Fatalf("ParseAmountDistribution failed: %v", err)
+	}
+	if amount := fixed.Sample(rng); amount != 250 {
+		t.Errorf("Expected 250, got %d", amount)
+	}
+
+	uniform, err := ParseAmountDistribution(" Uniform:100:200 ")
+	if err != nil {
+		t.Fatalf("ParseAmountDistribution failed: %v", err)
+	}
+	for i := 0; i < 100; i++ {
+		if amount := uniform.Sample(rng); amount < 100 || amount > 200 {
+			t.Fatalf("Expected an amount between 100 and 200, got %d", amount)
+		}
+	}
+
+	// Amounts never drop below 1, however wide the distribution
+	normal, err := ParseAmountDistribution("normal:1:1000")
+	if err != nil {
+		t.Fatalf("ParseAmountDistribution failed: %v", err)
+	}
+	for i := 0; i < 100; i++ {
+		if amount := normal.Sample(rng); amount < 1 {
+			t.Fatalf("Expected an amount of at least 1, got %d", amount)
+		}
+	}
+
+	for _, value := range []string{"", "fixed", "fixed:0", "uniform:200:100", "lognormal:100", "normal:100:-1", "pareto:1:2", "fixed:abc", "fixed:NaN"} {
+		if _, err := ParseAmountDistribution(value); !errors.Is(err, ErrInvalidGeneratorConfig) {
+			t.Errorf("Expected ErrInvalidGeneratorConfig for %q, got %v", value, err)
+		}
+	}
+}
+
+// TestParseStatusWeights tests parsing and sampling of status weights
+func TestParseStatusWeights(t *testing.T) {
+	weights, err := ParseStatusWeights("success=3, pending=1")
+	if err != nil {
+		t.Fatalf("ParseStatusWeights failed: %v", err)
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	counts := map[TransactionStatus]int{}
+	for i := 0; i < 4000; i++ {
+		counts[weights.Sample(rng)]++
+	}
+	if counts[StatusFailed] != 0 {
+		t.Errorf("Expected no failed transactions, got %d", counts[StatusFailed])
+	}
+	if counts[StatusSuccess] < 2800 || counts[StatusSuccess] > 3200 {
+		t.Errorf("Expected about 3000 successful transactions, got %d", counts[StatusSuccess])
+	}
+
+	for _, value := range []string{"", "success", "success=-1", "success=0", "cancelled=1", "success=x"} {
+		if _, err := ParseStatusWeights(value); !errors.Is(err, ErrInvalidGeneratorConfig) {
+			t.Errorf("Expected ErrInvalidGeneratorConfig for %q, got %v", value, err)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package schemas
+
+import "time"
+
+// InboxStatus is the outcome of ingesting a file dropped in the inbox directory
+type InboxStatus string
+
+const (
+	InboxStatusProcessed InboxStatus = "PROCESSED"
+	InboxStatusFailed    InboxStatus = "FAILED"
+)
+
+// InboxFile is a file waiting in the inbox directory
+type InboxFile struct {
+	Name    string
+	Size    int64
+	ModTime time.Time
+}
+
+// InboxResult is written as a JSON sidecar next to an ingested file in processed/ or failed/
+// Result holds the same data as the response of POST /api/upload for the file
+type InboxResult struct {
+	Filename   string      `json:"filename"`
+	Status     InboxStatus `json:"status"`
+	Result     interface{} `json:"result,omitempty"`
+	Error      string      `json:"error,omitempty"`
+	StartedAt  time.Time   `json:"started_at"`
+	FinishedAt time.Time   `json:"finished_at"`
+}
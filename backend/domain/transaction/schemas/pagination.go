@@ -0,0 +1,27 @@
+package schemas
+
+import "fmt"
+
+// NewPaginationMeta builds the pagination metadata of a list page, with links to the pages either side of it
+func NewPaginationMeta(total int64, count int, page int, pageSize int) PaginationMeta {
+	totalPages := int((total + int64(pageSize) - 1) / int64(pageSize))
+
+	var links PaginationLinks
+	if page < totalPages {
+		next := fmt.Sprintf("?page=%d&page_size=%d", page+1, pageSize)
+		links.Next = &next
+	}
+	if page > 1 {
+		prev := fmt.Sprintf("?page=%d&page_size=%d", page-1, pageSize)
+		links.Prev = &prev
+	}
+
+	return PaginationMeta{
+		Total:       int(total),
+		Count:       count,
+		PerPage:     pageSize,
+		CurrentPage: page,
+		TotalPages:  totalPages,
+		Links:       links,
+	}
+}
@@ -0,0 +1,42 @@
+package schemas
+
+import "testing"
+
+// TestNewPaginationMeta tests the page count and the links either side of a page
+func TestNewPaginationMeta(t *testing.T) {
+	tests := []struct {
+		name       string
+		total      int64
+		page       int
+		totalPages int
+		next       string
+		prev       string
+	}{
+		{name: "empty", total: 0, page: 1, totalPages: 0},
+		{name: "first page", total: 25, page: 1, totalPages: 3, next: "?page=2&page_size=10"},
+		{name: "middle page", total: 25, page: 2, totalPages: 3, next: "?page=3&page_size=10", prev: "?page=1&page_size=10"},
+		{name: "last full page", total: 30, page: 3, totalPages: 3, prev: "?page=2&page_size=10"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			meta := NewPaginationMeta(tc.total, 10, tc.page, 10)
+			if meta.TotalPages != tc.totalPages {
+				t.Errorf("Expected %d pages, got %d", tc.totalPages, meta.TotalPages)
+			}
+			if link := deref(meta.Links.Next); link != tc.next {
+				t.Errorf("Expected next link %q, got %q", tc.next, link)
+			}
+			if link := deref(meta.Links.Prev); link != tc.prev {
+				t.Errorf("Expected prev link %q, got %q", tc.prev, link)
+			}
+		})
+	}
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,137 @@
+package schemas
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/textenc"
+)
+
+var (
+	ErrImportProfileNotFound = errors.New("import profile not found")
+	ErrImportProfileExists   = errors.New("import profile already exists")
+	ErrInvalidImportProfile  = errors.New("invalid import profile")
+	ErrInvalidParseOptions   = errors.New("invalid parse options")
+)
+
+// maxProfileNameLength is the longest import profile name accepted
+const maxProfileNameLength = 100
+
+// Decimal separators of uploaded amounts
+const (
+	DecimalPoint = "."
+	DecimalComma = ","
+)
+
+// delimiters maps the accepted CSV delimiter names to the delimiter
+var delimiters = map[string]string{
+	",":         ",",
+	";":         ";",
+	"|":         "|",
+	"\t":        "\t",
+	"comma":     ",",
+	"semicolon": ";",
+	"pipe":      "|",
+	"tab":       "\t",
+}
+
+// ParseOptions controls how an uploaded text file is read
+// Empty fields, or "auto", are detected: the encoding from the content, the delimiter from the first lines
+// and the decimal separator from the amounts (a comma in 1.234,50, a point in 1234.50)
+type ParseOptions struct {
+	Encoding         string `gorm:"type:text" json:"encoding,omitempty"`
+	Delimiter        string `gorm:"type:text" json:"delimiter,omitempty"`
+	DecimalSeparator string `gorm:"type:text" json:"decimal_separator,omitempty"`
+}
+
+// Normalize checks the options and returns them in canonical form
+func (o ParseOptions) Normalize() (ParseOptions, error) {
+	encoding, err := textenc.Canonical(o.Encoding)
+	if err != nil {
+		return o, fmt.Errorf("%w: %v", ErrInvalidParseOptions, err)
+	}
+
+	// A tab is a delimiter of its own, not space to trim
+	delimiter := o.Delimiter
+	if delimiter != "\t" {
+		delimiter = strings.ToLower(strings.TrimSpace(delimiter))
+	}
+	switch delimiter {
+	case "", "auto":
+		delimiter = ""
+	default:
+		canonical, ok := delimiters[delimiter]
+		if !ok {
+			return o, fmt.Errorf("%w: unsupported delimiter %q", ErrInvalidParseOptions, o.Delimiter)
+		}
+		delimiter = canonical
+	}
+
+	decimal := strings.ToLower(strings.TrimSpace(o.DecimalSeparator))
+	switch decimal {
+	case "", "auto":
+		decimal = ""
+	case DecimalPoint, "point":
+		decimal = DecimalPoint
+	case DecimalComma, "comma":
+		decimal = DecimalComma
+	default:
+		return o, fmt.Errorf("%w: unsupported decimal separator %q", ErrInvalidParseOptions, o.DecimalSeparator)
+	}
+
+	return ParseOptions{Encoding: encoding, Delimiter: delimiter, DecimalSeparator: decimal}, nil
+}
+
+// Merge returns the options with the fields that are set in override replacing their own
+func (o ParseOptions) Merge(override ParseOptions) ParseOptions {
+	if override.Encoding != "" {
+		o.Encoding = override.Encoding
+	}
+	if override.Delimiter != "" {
+		o.Delimiter = override.Delimiter
+	}
+	if override.DecimalSeparator != "" {
+		o.DecimalSeparator = override.DecimalSeparator
+	}
+	return o
+}
+
+// ImportProfile is a named set of upload settings, such as those of one bank portal's exports
+// Settings given with an upload take precedence over those of its profile
+type ImportProfile struct {
+	ID           string `gorm:"primaryKey;type:text" json:"id"`
+	Name         string `gorm:"type:text;uniqueIndex" json:"name"`
+	Source       string `gorm:"type:text" json:"source,omitempty"`
+	ParseOptions `gorm:"embedded"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name for ImportProfile
+func (ImportProfile) TableName() string {
+	return "import_profiles"
+}
+
+// ImportProfileRequest represents an import profile create or update request
+// Fields left out of an update keep their current values
+type ImportProfileRequest struct {
+	Name             *string `json:"name"`
+	Source           *string `json:"source"`
+	Encoding         *string `json:"encoding"`
+	Delimiter        *string `json:"delimiter"`
+	DecimalSeparator *string `json:"decimal_separator"`
+}
+
+// NormalizeProfileName trims an import profile name and checks its length
+func NormalizeProfileName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("%w: name is required", ErrInvalidImportProfile)
+	}
+	if len(name) > maxProfileNameLength {
+		return "", fmt.Errorf("%w: name is too long (max %d characters)", ErrInvalidImportProfile, maxProfileNameLength)
+	}
+	return name, nil
+}
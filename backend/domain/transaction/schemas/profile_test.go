@@ -0,0 +1,53 @@
+package schemas
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestParseOptionsNormalize tests option aliases, detection and invalid values
+func TestParseOptionsNormalize(t *testing.T) {
+	tests := []struct {
+		name      string
+		options   ParseOptions
+		expected  ParseOptions
+		shouldErr bool
+	}{
+		{"empty", ParseOptions{}, ParseOptions{}, false},
+		{"auto", ParseOptions{Encoding: "auto", Delimiter: "AUTO", DecimalSeparator: "auto"}, ParseOptions{}, false},
+		{"names", ParseOptions{Encoding: "CP1252", Delimiter: "semicolon", DecimalSeparator: "comma"}, ParseOptions{Encoding: "windows-1252", Delimiter: ";", DecimalSeparator: ","}, false},
+		{"literal tab", ParseOptions{Delimiter: "\t"}, ParseOptions{Delimiter: "\t"}, false},
+		{"unknown encoding", ParseOptions{Encoding: "ebcdic"}, ParseOptions{}, true},
+		{"unknown delimiter", ParseOptions{Delimiter: ":"}, ParseOptions{}, true},
+		{"unknown decimal separator", ParseOptions{DecimalSeparator: "'"}, ParseOptions{}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			options, err := tc.options.Normalize()
+			if tc.shouldErr {
+				if !errors.Is(err, ErrInvalidParseOptions) {
+					t.Errorf("Expected ErrInvalidParseOptions, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if options != tc.expected {
+				t.Errorf("Expected %+v, got %+v", tc.expected, options)
+			}
+		})
+	}
+}
+
+// TestParseOptionsMerge tests that set fields of the override win
+func TestParseOptionsMerge(t *testing.T) {
+	profile := ParseOptions{Encoding: "windows-1252", Delimiter: ";", DecimalSeparator: ","}
+	merged := profile.Merge(ParseOptions{DecimalSeparator: "."})
+
+	expected := ParseOptions{Encoding: "windows-1252", Delimiter: ";", DecimalSeparator: "."}
+	if merged != expected {
+		t.Errorf("Expected %+v, got %+v", expected, merged)
+	}
+}
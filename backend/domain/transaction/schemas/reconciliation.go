@@ -0,0 +1,90 @@
+package schemas
+
+import (
+	"errors"
+	"time"
+)
+
+// Reconciliation item results
+const (
+	ReconcileMatched        = "matched"
+	ReconcileAmountMismatch = "amount_mismatch"
+	ReconcileUnmatchedLeft  = "unmatched_left"
+	ReconcileUnmatchedRight = "unmatched_right"
+)
+
+var (
+	ErrReconciliationNotFound = errors.New("reconciliation not found")
+	ErrInvalidReconciliation  = errors.New("invalid reconciliation")
+)
+
+// Reconciliation is a stored run matching the rows of two upload batches,
+// such as the internal payout ledger (left) against the bank statement (right)
+type Reconciliation struct {
+	ID                   string    `gorm:"primaryKey;type:text" json:"id"`
+	LeftBatchID          string    `gorm:"type:text;index" json:"left_batch_id"`
+	RightBatchID         string    `gorm:"type:text;index" json:"right_batch_id"`
+	TimeToleranceSeconds int64     `json:"time_tolerance_seconds"`
+	NameThreshold        float64   `json:"name_threshold"`
+	MatchedCount         int       `json:"matched_count"`
+	AmountMismatchCount  int       `json:"amount_mismatch_count"`
+	UnmatchedLeftCount   int       `json:"unmatched_left_count"`
+	UnmatchedRightCount  int       `json:"unmatched_right_count"`
+	CreatedBy            string    `gorm:"type:text" json:"created_by"`
+	CreatedAt            time.Time `gorm:"index" json:"created_at"`
+}
+
+// TableName specifies the table name for Reconciliation
+func (Reconciliation) TableName() string {
+	return "reconciliations"
+}
+
+// ReconciliationItem is one row of a reconciliation result
+// Unmatched items have only one side; differences are right minus left
+type ReconciliationItem struct {
+	ID                 uint    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ReconciliationID   string  `gorm:"type:text;index" json:"reconciliation_id"`
+	Result             string  `gorm:"type:text;index" json:"result"`
+	LeftTransactionID  *string `gorm:"type:text" json:"left_transaction_id"`
+	RightTransactionID *string `gorm:"type:text" json:"right_transaction_id"`
+	AmountDifference   int64   `json:"amount_difference"`
+	TimeDifference     int64   `json:"time_difference"`
+	NameScore          float64 `json:"name_score"`
+}
+
+// TableName specifies the table name for ReconciliationItem
+func (ReconciliationItem) TableName() string {
+	return "reconciliation_items"
+}
+
+// ReconciliationRequest starts a reconciliation between two upload batches
+// Tolerance and threshold fall back to the configured defaults when left out
+type ReconciliationRequest struct {
+	LeftBatchID          string   `json:"left_batch_id"`
+	RightBatchID         string   `json:"right_batch_id"`
+	TimeToleranceSeconds *int64   `json:"time_tolerance_seconds"`
+	NameThreshold        *float64 `json:"name_threshold"`
+}
+
+// ReconciliationItemDetail is a reconciliation item with both transactions filled in
+type ReconciliationItemDetail struct {
+	ReconciliationItem
+	Left  *Transaction `json:"left"`
+	Right *Transaction `json:"right"`
+}
+
+// ReconciliationItemsResponse represents the reconciliation item list response
+type ReconciliationItemsResponse struct {
+	Message string                     `json:"message"`
+	Data    []ReconciliationItemDetail `json:"data"`
+	Meta    ResponseMeta               `json:"meta"`
+}
+
+// ValidReconcileResult reports whether a result filter names a known result
+func ValidReconcileResult(result string) bool {
+	switch result {
+	case ReconcileMatched, ReconcileAmountMismatch, ReconcileUnmatchedLeft, ReconcileUnmatchedRight:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+package schemas
+
+import "time"
+
+// Recurring periods detected from the intervals between transactions
+const (
+	PeriodWeekly  = "weekly"
+	PeriodMonthly = "monthly"
+)
+
+// Recurring series statuses
+const (
+	RecurringOnTrack = "on_track"
+	RecurringLate    = "late"
+	RecurringMissed  = "missed"
+)
+
+// RecurringOccurrence is a payment of a series that came in later than expected
+type RecurringOccurrence struct {
+	TransactionID string  `json:"transaction_id"`
+	Timestamp     int64   `json:"timestamp"`
+	Amount        int64   `json:"amount"`
+	ExpectedAt    int64   `json:"expected_at"`
+	DaysLate      float64 `json:"days_late"`
+}
+
+// RecurringSeries is a group of transactions with the same counterparty, type and a similar amount
+// that repeat weekly or monthly
+type RecurringSeries struct {
+	CounterpartyID    string                `json:"counterparty_id,omitempty"`
+	Name              string                `json:"name"`
+	Type              string                `json:"type"`
+	Period            string                `json:"period"`
+	Occurrences       int                   `json:"occurrences"`
+	ExpectedAmount    int64                 `json:"expected_amount"`
+	MinAmount         int64                 `json:"min_amount"`
+	MaxAmount         int64                 `json:"max_amount"`
+	FirstSeen         int64                 `json:"first_seen"`
+	LastSeen          int64                 `json:"last_seen"`
+	LastTransactionID string                `json:"last_transaction_id"`
+	ExpectedNextAt    int64                 `json:"expected_next_at"`
+	ExpectedNextDate  string                `json:"expected_next_date"`
+	Status            string                `json:"status"`
+	MissedCount       int                   `json:"missed_count"`
+	LateOccurrences   []RecurringOccurrence `json:"late_occurrences"`
+}
+
+// RecurringFilters limits the series returned by the recurring analysis
+type RecurringFilters struct {
+	Type   string
+	Period string
+	Status string
+}
+
+// RecurringResponse represents the recurring transaction analysis
+type RecurringResponse struct {
+	Message     string                 `json:"message"`
+	AsOf        string                 `json:"as_of"`
+	Filters     map[string]interface{} `json:"filters,omitempty"`
+	Series      []RecurringSeries      `json:"series"`
+	GeneratedAt string                 `json:"generated_at"`
+}
+
+// ValidRecurringPeriod reports whether a period filter names a known period
+func ValidRecurringPeriod(period string) bool {
+	return period == PeriodWeekly || period == PeriodMonthly
+}
+
+// ValidRecurringStatus reports whether a status filter names a known series status
+func ValidRecurringStatus(status string) bool {
+	switch status {
+	case RecurringOnTrack, RecurringLate, RecurringMissed:
+		return true
+	}
+	return false
+}
+
+// AddRecurringPeriods moves a time forward by n periods
+// Monthly periods keep the day of month, clamped to the last day of shorter months
+func AddRecurringPeriods(t time.Time, period string, n int) time.Time {
+	if period == PeriodWeekly {
+		return t.AddDate(0, 0, 7*n)
+	}
+
+	firstOfMonth := time.Date(t.Year(), t.Month()+time.Month(n), 1, t.Hour(), t.Minute(), t.Second(), 0, t.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	dayOfMonth := t.Day()
+	if dayOfMonth > lastDay {
+		dayOfMonth = lastDay
+	}
+	return firstOfMonth.AddDate(0, 0, dayOfMonth-1)
+}
@@ -0,0 +1,96 @@
+package schemas
+
+import (
+	"errors"
+	"time"
+)
+
+// Relation types between a transaction and the original transaction it pays back
+const (
+	RelationRefund        = "refund"
+	RelationPartialRefund = "partial_refund"
+	RelationReversal      = "reversal"
+	RelationChargeback    = "chargeback"
+)
+
+// Link suggestion statuses
+const (
+	SuggestionPending   = "pending"
+	SuggestionConfirmed = "confirmed"
+	SuggestionRejected  = "rejected"
+)
+
+var (
+	ErrSuggestionNotFound = errors.New("link suggestion not found")
+	ErrSuggestionResolved = errors.New("link suggestion already resolved")
+	ErrInvalidLink        = errors.New("invalid link")
+)
+
+// LinkSuggestion proposes that a CREDIT pays back an earlier DEBIT of the same counterparty
+// A rejected suggestion is kept so the same pair is not suggested again
+type LinkSuggestion struct {
+	ID                   string     `gorm:"primaryKey;type:text" json:"id"`
+	TransactionID        string     `gorm:"type:text;uniqueIndex:idx_link_suggestion_pair" json:"transaction_id"`
+	RelatedTransactionID string     `gorm:"type:text;uniqueIndex:idx_link_suggestion_pair" json:"related_transaction_id"`
+	RelationType         string     `gorm:"type:text" json:"relation_type"`
+	Score                float64    `json:"score"`
+	Status               string     `gorm:"type:text;index" json:"status"`
+	CreatedAt            time.Time  `json:"created_at"`
+	ResolvedBy           string     `gorm:"type:text" json:"resolved_by,omitempty"`
+	ResolvedAt           *time.Time `json:"resolved_at,omitempty"`
+}
+
+// TableName specifies the table name for LinkSuggestion
+func (LinkSuggestion) TableName() string {
+	return "link_suggestions"
+}
+
+// LinkRequest links a transaction to the original transaction it pays back
+type LinkRequest struct {
+	TransactionID        string `json:"transaction_id"`
+	RelatedTransactionID string `json:"related_transaction_id"`
+	RelationType         string `json:"relation_type"`
+}
+
+// ConfirmSuggestionRequest confirms a suggestion, optionally with another relation type
+type ConfirmSuggestionRequest struct {
+	RelationType string `json:"relation_type"`
+}
+
+// LinkSuggestionDetail is a suggestion with both transactions filled in
+type LinkSuggestionDetail struct {
+	LinkSuggestion
+	Transaction        *Transaction `json:"transaction"`
+	RelatedTransaction *Transaction `json:"related_transaction"`
+}
+
+// LinkSuggestionsResponse represents the link suggestion list response
+type LinkSuggestionsResponse struct {
+	Message string                 `json:"message"`
+	Data    []LinkSuggestionDetail `json:"data"`
+	Meta    ResponseMeta           `json:"meta"`
+}
+
+// SuggestLinksResponse reports how many new suggestions a scan created
+type SuggestLinksResponse struct {
+	Message   string `json:"message"`
+	Suggested int    `json:"suggested"`
+}
+
+// ValidRelationType reports whether a relation type is known
+func ValidRelationType(relationType string) bool {
+	switch relationType {
+	case RelationRefund, RelationPartialRefund, RelationReversal, RelationChargeback:
+		return true
+	}
+	return false
+}
+
+// ValidSuggestionStatus reports whether a status filter names a known suggestion status
+func ValidSuggestionStatus(status string) bool {
+	switch status {
+	case SuggestionPending, SuggestionConfirmed, SuggestionRejected:
+		return true
+	}
+	return false
+}
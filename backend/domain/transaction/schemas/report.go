@@ -0,0 +1,26 @@
+package schemas
+
+// CategoryReportRow holds the totals of one category, split by transaction type
+// Transactions without a category are reported under an empty category ID
+type CategoryReportRow struct {
+	CategoryID   string `json:"category_id"`
+	CategoryName string `json:"category_name"`
+	CreditCount  int64  `json:"credit_count"`
+	CreditTotal  int64  `json:"credit_total"`
+	DebitCount   int64  `json:"debit_count"`
+	DebitTotal   int64  `json:"debit_total"`
+	Net          int64  `json:"net"`
+}
+
+// CategoryReportResponse represents the totals per category report
+type CategoryReportResponse struct {
+	Message      string              `json:"message"`
+	Status       string              `json:"status"`
+	StartDate    string              `json:"start_date,omitempty"`
+	EndDate      string              `json:"end_date,omitempty"`
+	NetOfRefunds bool                `json:"net_of_refunds"`
+	Categories   []CategoryReportRow `json:"categories"`
+	CreditTotal  int64               `json:"credit_total"`
+	DebitTotal   int64               `json:"debit_total"`
+	GeneratedAt  string              `json:"generated_at"`
+}
@@ -0,0 +1,78 @@
+package schemas
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var (
+	ErrUploadSessionNotFound  = errors.New("upload session not found")
+	ErrUploadSessionExpired   = errors.New("upload session expired")
+	ErrInvalidUploadSession   = errors.New("invalid upload session")
+	ErrUploadOffsetMismatch   = errors.New("upload offset does not match")
+	ErrUploadChecksumMismatch = errors.New("upload checksum does not match")
+	ErrUploadIncomplete       = errors.New("upload is incomplete")
+	ErrUploadComplete         = errors.New("upload is complete")
+	ErrUploadSessionConsumed  = errors.New("upload is being stored or has been stored")
+)
+
+// UploadSession is a resumable upload whose chunks are staged on disk until the whole file has arrived
+// Offset is how many bytes have been received; the file is stored once it reaches Size
+// ConsumedAt is set while the complete file is stored, and Result is kept once it has been until the session expires
+type UploadSession struct {
+	ID           string `gorm:"primaryKey;type:text" json:"id"`
+	Filename     string `gorm:"type:text" json:"filename"`
+	Format       string `gorm:"type:text" json:"format"`
+	Size         int64  `json:"size"`
+	Offset       int64  `json:"offset"`
+	Checksum     string `gorm:"type:text" json:"checksum,omitempty"`
+	Source       string `gorm:"type:text" json:"source,omitempty"`
+	Profile      string `gorm:"type:text" json:"profile,omitempty"`
+	ParseOptions `gorm:"embedded"`
+	CreatedBy    string          `gorm:"type:text" json:"created_by"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+	ExpiresAt    time.Time       `gorm:"index" json:"expires_at"`
+	ConsumedAt   *time.Time      `json:"consumed_at,omitempty"`
+	Result       json.RawMessage `gorm:"type:text" json:"result,omitempty"`
+}
+
+// TableName specifies the table name for UploadSession
+func (UploadSession) TableName() string {
+	return "upload_sessions"
+}
+
+// Complete reports whether the whole file has been received
+func (s UploadSession) Complete() bool {
+	return s.Offset == s.Size
+}
+
+// Consumed reports whether the complete file has been taken for storing, so no chunk may change it
+func (s UploadSession) Consumed() bool {
+	return s.ConsumedAt != nil
+}
+
+// UploadOptions returns the options the file is stored with once complete
+func (s UploadSession) UploadOptions() UploadOptions {
+	return UploadOptions{
+		Source:       s.Source,
+		Filename:     s.Filename,
+		Format:       s.Format,
+		Profile:      s.Profile,
+		ParseOptions: s.ParseOptions,
+	}
+}
+
+// UploadSessionRequest starts a resumable upload
+// Checksum, when given, is the hex SHA-256 of the whole file and is verified before the file is stored
+type UploadSessionRequest struct {
+	Filename         string `json:"filename"`
+	Size             int64  `json:"size"`
+	Checksum         string `json:"checksum"`
+	Source           string `json:"source"`
+	Profile          string `json:"profile"`
+	Encoding         string `json:"encoding"`
+	Delimiter        string `json:"delimiter"`
+	DecimalSeparator string `json:"decimal_separator"`
+}
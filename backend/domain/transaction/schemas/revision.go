@@ -0,0 +1,27 @@
+package schemas
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	RevisionActionCreate = "create"
+	RevisionActionUpdate = "update"
+	RevisionActionDelete = "delete"
+)
+
+// TransactionRevision keeps the values of a transaction before and after a manual change
+type TransactionRevision struct {
+	ID            string          `gorm:"primaryKey;type:text" json:"id"`
+	TransactionID string          `gorm:"type:text;index" json:"transaction_id"`
+	Action        string          `gorm:"type:text" json:"action"`
+	Before        json.RawMessage `gorm:"type:text" json:"before,omitempty"`
+	After         json.RawMessage `gorm:"type:text" json:"after,omitempty"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+// TableName specifies the table name for TransactionRevision
+func (TransactionRevision) TableName() string {
+	return "transaction_revisions"
+}
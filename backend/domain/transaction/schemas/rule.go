@@ -0,0 +1,321 @@
+package schemas
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrRuleNotFound = errors.New("rule not found")
+	ErrInvalidRule  = errors.New("invalid rule")
+)
+
+// Rule match modes
+const (
+	RuleMatchAll = "all"
+	RuleMatchAny = "any"
+)
+
+// Rule condition fields
+const (
+	RuleFieldName        = "name"
+	RuleFieldDescription = "description"
+	RuleFieldType        = "type"
+	RuleFieldStatus      = "status"
+	RuleFieldAmount      = "amount"
+)
+
+// Rule condition operators
+const (
+	RuleOpEquals     = "equals"
+	RuleOpContains   = "contains"
+	RuleOpStartsWith = "starts_with"
+	RuleOpEndsWith   = "ends_with"
+	RuleOpRegex      = "regex"
+	RuleOpGreater    = "gt"
+	RuleOpGreaterEq  = "gte"
+	RuleOpLess       = "lt"
+	RuleOpLessEq     = "lte"
+)
+
+const maxRuleNameLength = 100
+
+var (
+	ruleTextFields = map[string]bool{RuleFieldName: true, RuleFieldDescription: true, RuleFieldType: true, RuleFieldStatus: true}
+	ruleTextOps    = map[string]bool{RuleOpEquals: true, RuleOpContains: true, RuleOpStartsWith: true, RuleOpEndsWith: true, RuleOpRegex: true}
+	ruleNumericOps = map[string]bool{RuleOpEquals: true, RuleOpGreater: true, RuleOpGreaterEq: true, RuleOpLess: true, RuleOpLessEq: true}
+)
+
+// RuleCondition compares one transaction field with a value
+// Text comparisons ignore case; amount values use the same units as the CSV upload
+type RuleCondition struct {
+	Field    string `json:"field"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+// Rule assigns a category and tags to transactions that match its conditions
+// Rules run in ascending priority; the first matching rule with a category wins,
+// and the tags of every matching rule are added
+type Rule struct {
+	ID         string          `gorm:"primaryKey;type:text" json:"id"`
+	Name       string          `gorm:"type:text" json:"name"`
+	Priority   int             `gorm:"index" json:"priority"`
+	Enabled    bool            `json:"enabled"`
+	Match      string          `gorm:"type:text" json:"match"`
+	Conditions []RuleCondition `gorm:"serializer:json" json:"conditions"`
+	CategoryID *string         `gorm:"type:text;index" json:"category_id"`
+	Tags       []string        `gorm:"serializer:json" json:"tags"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+}
+
+// TableName specifies the table name for Rule
+func (Rule) TableName() string {
+	return "rules"
+}
+
+// RuleRequest represents a rule create, update or test request
+// Fields left out of an update keep their current values
+type RuleRequest struct {
+	Name       *string          `json:"name"`
+	Priority   *int             `json:"priority"`
+	Enabled    *bool            `json:"enabled"`
+	Match      *string          `json:"match"`
+	Conditions *[]RuleCondition `json:"conditions"`
+	CategoryID *string          `json:"category_id"`
+	Tags       *[]string        `json:"tags"`
+}
+
+// RuleApplyRequest controls how a rule is applied to existing transactions
+// Without overwrite, transactions that already have a category keep it
+type RuleApplyRequest struct {
+	Overwrite bool `json:"overwrite"`
+}
+
+// RuleApplyResponse reports what applying a rule to existing transactions changed
+type RuleApplyResponse struct {
+	Message     string `json:"message"`
+	Matched     int    `json:"matched"`
+	Categorized int    `json:"categorized"`
+	Tagged      int    `json:"tagged"`
+}
+
+// RuleTestResponse lists the existing transactions a rule would match
+// Only the first matches are returned; Matched is the full count
+type RuleTestResponse struct {
+	Message string        `json:"message"`
+	Matched int           `json:"matched"`
+	Scanned int           `json:"scanned"`
+	Data    []Transaction `json:"data"`
+}
+
+// compiledCondition is a validated condition ready to evaluate
+type compiledCondition struct {
+	RuleCondition
+	pattern *regexp.Regexp
+	amount  int64
+}
+
+// CompiledRule is a validated rule ready to evaluate against transactions
+type CompiledRule struct {
+	Rule
+	conditions []compiledCondition
+}
+
+// Compile validates a rule and prepares its conditions for evaluation
+func (r Rule) Compile() (*CompiledRule, error) {
+	if strings.TrimSpace(r.Name) == "" {
+		return nil, fmt.Errorf("%w: name cannot be empty", ErrInvalidRule)
+	}
+	if len(r.Name) > maxRuleNameLength {
+		return nil, fmt.Errorf("%w: name exceeds maximum length of %d characters", ErrInvalidRule, maxRuleNameLength)
+	}
+	if r.Match != RuleMatchAll && r.Match != RuleMatchAny {
+		return nil, fmt.Errorf("%w: match must be either %s or %s", ErrInvalidRule, RuleMatchAll, RuleMatchAny)
+	}
+	if len(r.Conditions) == 0 {
+		return nil, fmt.Errorf("%w: at least one condition is required", ErrInvalidRule)
+	}
+	if r.CategoryID == nil && len(r.Tags) == 0 {
+		return nil, fmt.Errorf("%w: a rule must set a category or tags", ErrInvalidRule)
+	}
+
+	compiled := &CompiledRule{Rule: r}
+	for i, condition := range r.Conditions {
+		c, err := compileCondition(condition)
+		if err != nil {
+			return nil, fmt.Errorf("%w: condition %d: %v", ErrInvalidRule, i+1, err)
+		}
+		compiled.conditions = append(compiled.conditions, c)
+	}
+
+	return compiled, nil
+}
+
+// compileCondition checks the field, operator and value of a condition
+func compileCondition(condition RuleCondition) (compiledCondition, error) {
+	c := compiledCondition{RuleCondition: condition}
+	c.Field = strings.ToLower(strings.TrimSpace(condition.Field))
+	c.Operator = strings.ToLower(strings.TrimSpace(condition.Operator))
+
+	switch {
+	case c.Field == RuleFieldAmount:
+		if !ruleNumericOps[c.Operator] {
+			return c, fmt.Errorf("operator %q is not supported for amount", c.Operator)
+		}
+		value, err := strconv.ParseFloat(strings.TrimSpace(c.Value), 64)
+		if err != nil {
+			return c, fmt.Errorf("amount value %q is not a number", c.Value)
+		}
+		c.amount = int64(math.Round(value * 100))
+
+	case ruleTextFields[c.Field]:
+		if !ruleTextOps[c.Operator] {
+			return c, fmt.Errorf("operator %q is not supported for %s", c.Operator, c.Field)
+		}
+		if c.Value == "" {
+			return c, fmt.Errorf("value cannot be empty")
+		}
+		if c.Operator == RuleOpRegex {
+			pattern, err := regexp.Compile("(?i)" + c.Value)
+			if err != nil {
+				return c, fmt.Errorf("invalid regex: %v", err)
+			}
+			c.pattern = pattern
+		}
+
+	default:
+		return c, fmt.Errorf("unknown field %q", condition.Field)
+	}
+
+	return c, nil
+}
+
+// matches reports whether the transaction satisfies the condition
+func (c compiledCondition) matches(t *Transaction) bool {
+	if c.Field == RuleFieldAmount {
+		switch c.Operator {
+		case RuleOpEquals:
+			return t.Amount == c.amount
+		case RuleOpGreater:
+			return t.Amount > c.amount
+		case RuleOpGreaterEq:
+			return t.Amount >= c.amount
+		case RuleOpLess:
+			return t.Amount < c.amount
+		case RuleOpLessEq:
+			return t.Amount <= c.amount
+		}
+		return false
+	}
+
+	var field string
+	switch c.Field {
+	case RuleFieldName:
+		field = t.Name
+	case RuleFieldDescription:
+		field = t.Description
+	case RuleFieldType:
+		field = string(t.Type)
+	case RuleFieldStatus:
+		field = string(t.Status)
+	}
+
+	if c.pattern != nil {
+		return c.pattern.MatchString(field)
+	}
+
+	field = strings.ToLower(field)
+	value := strings.ToLower(c.Value)
+	switch c.Operator {
+	case RuleOpEquals:
+		return field == value
+	case RuleOpContains:
+		return strings.Contains(field, value)
+	case RuleOpStartsWith:
+		return strings.HasPrefix(field, value)
+	case RuleOpEndsWith:
+		return strings.HasSuffix(field, value)
+	}
+	return false
+}
+
+// Matches reports whether the transaction satisfies the rule's conditions
+func (r *CompiledRule) Matches(t *Transaction) bool {
+	for _, condition := range r.conditions {
+		matched := condition.matches(t)
+		if r.Match == RuleMatchAny && matched {
+			return true
+		}
+		if r.Match == RuleMatchAll && !matched {
+			return false
+		}
+	}
+	return r.Match == RuleMatchAll
+}
+
+// RuleSet evaluates rules in priority order
+type RuleSet struct {
+	rules []*CompiledRule
+}
+
+// NewRuleSet compiles the enabled rules and orders them by priority
+func NewRuleSet(rules []Rule) (*RuleSet, error) {
+	set := &RuleSet{}
+	for _, rule := range rules {
+		if !rule.Enabled {
+			continue
+		}
+		compiled, err := rule.Compile()
+		if err != nil {
+			return nil, fmt.Errorf("rule %s: %w", rule.ID, err)
+		}
+		set.rules = append(set.rules, compiled)
+	}
+
+	sort.SliceStable(set.rules, func(i, j int) bool {
+		return set.rules[i].Priority < set.rules[j].Priority
+	})
+
+	return set, nil
+}
+
+// Len returns the number of enabled rules in the set
+func (s *RuleSet) Len() int {
+	return len(s.rules)
+}
+
+// Apply evaluates every rule against the transaction
+// The category is only set when the transaction has none; the returned tags
+// are the de-duplicated tags of all matching rules
+func (s *RuleSet) Apply(t *Transaction) []string {
+	var tags []string
+	seen := make(map[string]bool)
+
+	for _, rule := range s.rules {
+		if !rule.Matches(t) {
+			continue
+		}
+
+		if t.CategoryID == nil && rule.CategoryID != nil {
+			categoryID := *rule.CategoryID
+			t.CategoryID = &categoryID
+		}
+
+		for _, tag := range rule.Tags {
+			if !seen[tag] {
+				seen[tag] = true
+				tags = append(tags, tag)
+			}
+		}
+	}
+
+	return tags
+}
@@ -0,0 +1,117 @@
+package schemas
+
+import (
+	"errors"
+	"testing"
+)
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+// TestRuleCompileValidation tests that invalid rules are rejected
+func TestRuleCompileValidation(t *testing.T) {
+	valid := Rule{
+		Name:       "shopping",
+		Match:      RuleMatchAll,
+		Conditions: []RuleCondition{{Field: "name", Operator: "contains", Value: "E-COMMERCE"}},
+		CategoryID: stringPtr("shopping"),
+	}
+	if _, err := valid.Compile(); err != nil {
+		t.Fatalf("Expected valid rule, got %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(r *Rule)
+	}{
+		{"missing name", func(r *Rule) { r.Name = " " }},
+		{"bad match", func(r *Rule) { r.Match = "some" }},
+		{"no conditions", func(r *Rule) { r.Conditions = nil }},
+		{"no action", func(r *Rule) { r.CategoryID = nil }},
+		{"unknown field", func(r *Rule) { r.Conditions = []RuleCondition{{Field: "foo", Operator: "equals", Value: "x"}} }},
+		{"text operator on amount", func(r *Rule) { r.Conditions = []RuleCondition{{Field: "amount", Operator: "contains", Value: "1"}} }},
+		{"non-numeric amount", func(r *Rule) { r.Conditions = []RuleCondition{{Field: "amount", Operator: "gt", Value: "abc"}} }},
+		{"bad regex", func(r *Rule) { r.Conditions = []RuleCondition{{Field: "name", Operator: "regex", Value: "("}} }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := valid
+			tt.modify(&rule)
+			if _, err := rule.Compile(); !errors.Is(err, ErrInvalidRule) {
+				t.Errorf("Expected invalid rule error, got %v", err)
+			}
+		})
+	}
+}
+
+// TestRuleMatches tests all and any matching across field types
+func TestRuleMatches(t *testing.T) {
+	transaction := &Transaction{Name: "E-COMMERCE A", Type: TypeDebit, Amount: 15050, Description: "clothes"}
+
+	rule := Rule{
+		Name:  "shopping",
+		Match: RuleMatchAll,
+		Conditions: []RuleCondition{
+			{Field: "name", Operator: "contains", Value: "e-commerce"},
+			{Field: "type", Operator: "equals", Value: "DEBIT"},
+			{Field: "amount", Operator: "gte", Value: "150.50"},
+		},
+		Tags: []string{"online"},
+	}
+	compiled, err := rule.Compile()
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+	if !compiled.Matches(transaction) {
+		t.Error("Expected all conditions to match")
+	}
+
+	rule.Conditions = append(rule.Conditions, RuleCondition{Field: "description", Operator: "regex", Value: "^salary$"})
+	compiled, _ = rule.Compile()
+	if compiled.Matches(transaction) {
+		t.Error("Expected all-match to fail when one condition fails")
+	}
+
+	rule.Match = RuleMatchAny
+	compiled, _ = rule.Compile()
+	if !compiled.Matches(transaction) {
+		t.Error("Expected any-match to succeed")
+	}
+}
+
+// TestRuleSetApply tests priority order, existing categories and tag merging
+func TestRuleSetApply(t *testing.T) {
+	rules := []Rule{
+		{ID: "low", Name: "low", Priority: 10, Enabled: true, Match: RuleMatchAll, CategoryID: stringPtr("general"), Tags: []string{"a", "b"},
+			Conditions: []RuleCondition{{Field: "type", Operator: "equals", Value: "DEBIT"}}},
+		{ID: "high", Name: "high", Priority: 1, Enabled: true, Match: RuleMatchAll, CategoryID: stringPtr("food"), Tags: []string{"b"},
+			Conditions: []RuleCondition{{Field: "description", Operator: "equals", Value: "restaurant"}}},
+		{ID: "off", Name: "off", Priority: 0, Enabled: false, Match: RuleMatchAll, CategoryID: stringPtr("never"),
+			Conditions: []RuleCondition{{Field: "type", Operator: "equals", Value: "DEBIT"}}},
+	}
+
+	set, err := NewRuleSet(rules)
+	if err != nil {
+		t.Fatalf("NewRuleSet failed: %v", err)
+	}
+	if set.Len() != 2 {
+		t.Errorf("Expected disabled rule to be skipped, got %d rules", set.Len())
+	}
+
+	transaction := &Transaction{Type: TypeDebit, Description: "restaurant"}
+	tags := set.Apply(transaction)
+	if transaction.CategoryID == nil || *transaction.CategoryID != "food" {
+		t.Errorf("Expected highest priority category food, got %v", transaction.CategoryID)
+	}
+	if len(tags) != 2 || tags[0] != "b" || tags[1] != "a" {
+		t.Errorf("Expected tags [b a], got %v", tags)
+	}
+
+	categorized := &Transaction{Type: TypeDebit, CategoryID: stringPtr("manual")}
+	set.Apply(categorized)
+	if *categorized.CategoryID != "manual" {
+		t.Errorf("Expected existing category to be kept, got %s", *categorized.CategoryID)
+	}
+}
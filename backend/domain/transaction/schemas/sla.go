@@ -0,0 +1,156 @@
+package schemas
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	AgeBucketUnderOneDay   = "under_1d"
+	AgeBucketOneToThreeDay = "1d_to_3d"
+	AgeBucketOverThreeDays = "over_3d"
+)
+
+// SLAAmountBand applies a threshold to transactions at or above MinAmount (in cents)
+type SLAAmountBand struct {
+	MinAmount int64 `json:"min_amount"`
+	Hours     int   `json:"hours"`
+}
+
+// SLAPolicy holds the configured SLA thresholds for pending transactions
+type SLAPolicy struct {
+	DefaultHours int
+	TypeHours    map[TransactionType]int
+	AmountBands  []SLAAmountBand
+}
+
+// NewSLAPolicy builds an SLA policy from configuration values
+// A per-type value of 0 means "use the default"
+func NewSLAPolicy(defaultHours, creditHours, debitHours int, amountBands string) (SLAPolicy, error) {
+	bands, err := ParseSLAAmountBands(amountBands)
+	if err != nil {
+		return SLAPolicy{}, err
+	}
+
+	typeHours := make(map[TransactionType]int)
+	if creditHours > 0 {
+		typeHours[TypeCredit] = creditHours
+	}
+	if debitHours > 0 {
+		typeHours[TypeDebit] = debitHours
+	}
+
+	return SLAPolicy{
+		DefaultHours: defaultHours,
+		TypeHours:    typeHours,
+		AmountBands:  bands,
+	}, nil
+}
+
+// ParseSLAAmountBands parses "min_amount:hours" pairs separated by commas
+func ParseSLAAmountBands(value string) ([]SLAAmountBand, error) {
+	var bands []SLAAmountBand
+
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		pieces := strings.Split(part, ":")
+		if len(pieces) != 2 {
+			return nil, fmt.Errorf("invalid SLA amount band %q: expected min_amount:hours", part)
+		}
+
+		minAmount, err := strconv.ParseInt(strings.TrimSpace(pieces[0]), 10, 64)
+		if err != nil || minAmount < 0 {
+			return nil, fmt.Errorf("invalid SLA amount band %q: min_amount must be a non-negative integer", part)
+		}
+
+		hours, err := strconv.Atoi(strings.TrimSpace(pieces[1]))
+		if err != nil || hours <= 0 {
+			return nil, fmt.Errorf("invalid SLA amount band %q: hours must be a positive integer", part)
+		}
+
+		bands = append(bands, SLAAmountBand{MinAmount: minAmount, Hours: hours})
+	}
+
+	sort.Slice(bands, func(i, j int) bool {
+		return bands[i].MinAmount < bands[j].MinAmount
+	})
+
+	return bands, nil
+}
+
+// ThresholdHours returns the strictest threshold that applies to a transaction
+func (p SLAPolicy) ThresholdHours(txType TransactionType, amount int64) int {
+	hours := p.DefaultHours
+
+	if typeHours, ok := p.TypeHours[txType]; ok && (hours <= 0 || typeHours < hours) {
+		hours = typeHours
+	}
+
+	for _, band := range p.AmountBands {
+		if amount >= band.MinAmount && (hours <= 0 || band.Hours < hours) {
+			hours = band.Hours
+		}
+	}
+
+	return hours
+}
+
+// AgeBucket returns the age bucket for the given age
+func AgeBucket(age time.Duration) string {
+	switch {
+	case age < 24*time.Hour:
+		return AgeBucketUnderOneDay
+	case age <= 72*time.Hour:
+		return AgeBucketOneToThreeDay
+	default:
+		return AgeBucketOverThreeDays
+	}
+}
+
+// ApplyAge fills in the age and SLA fields of an issue relative to now
+// Only PENDING transactions can breach their SLA; FAILED ones are final
+func (p SLAPolicy) ApplyAge(issue *IssueTransaction, now time.Time) {
+	age := now.Sub(time.Unix(issue.Timestamp, 0))
+	if age < 0 {
+		age = 0
+	}
+
+	issue.AgeSeconds = int64(age.Seconds())
+	issue.AgeBucket = AgeBucket(age)
+
+	if issue.Status != string(StatusPending) {
+		return
+	}
+
+	hours := p.ThresholdHours(TransactionType(issue.Type), issue.Amount)
+	if hours > 0 {
+		issue.SLAHours = hours
+		issue.SLABreached = age > time.Duration(hours)*time.Hour
+	}
+}
+
+// IssueAgeBucket holds counts and totals for one age bucket
+type IssueAgeBucket struct {
+	Bucket      string `json:"bucket"`
+	Count       int    `json:"count"`
+	TotalAmount int64  `json:"total_amount"`
+	SLABreaches int    `json:"sla_breaches"`
+}
+
+// IssuesSummaryResponse represents the ageing summary for the issues summary endpoint
+type IssuesSummaryResponse struct {
+	Message     string                 `json:"message"`
+	TotalCount  int                    `json:"total_count"`
+	TotalAmount int64                  `json:"total_amount"`
+	SLABreaches int                    `json:"sla_breaches"`
+	Buckets     []IssueAgeBucket       `json:"buckets"`
+	Filters     map[string]interface{} `json:"filters,omitempty"`
+	GeneratedAt string                 `json:"generated_at"`
+}
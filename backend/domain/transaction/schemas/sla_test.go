@@ -0,0 +1,77 @@
+package schemas
+
+import (
+	"testing"
+	"time"
+)
+
+// TestSLAPolicyThresholdHours tests that the strictest applicable threshold wins
+func TestSLAPolicyThresholdHours(t *testing.T) {
+	policy, err := NewSLAPolicy(72, 48, 0, "100000000:24, 1000000000:4")
+	if err != nil {
+		t.Fatalf("NewSLAPolicy failed: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		txType   TransactionType
+		amount   int64
+		expected int
+	}{
+		{name: "debit uses default", txType: TypeDebit, amount: 1000, expected: 72},
+		{name: "credit uses type override", txType: TypeCredit, amount: 1000, expected: 48},
+		{name: "large debit uses amount band", txType: TypeDebit, amount: 100000000, expected: 24},
+		{name: "very large credit uses highest band", txType: TypeCredit, amount: 5000000000, expected: 4},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := policy.ThresholdHours(tc.txType, tc.amount); got != tc.expected {
+				t.Errorf("Expected %d hours, got %d", tc.expected, got)
+			}
+		})
+	}
+}
+
+// TestParseSLAAmountBandsInvalid tests rejection of malformed amount bands
+func TestParseSLAAmountBandsInvalid(t *testing.T) {
+	for _, value := range []string{"100", "abc:4", "100:0", "-1:4"} {
+		if _, err := ParseSLAAmountBands(value); err == nil {
+			t.Errorf("Expected error for amount bands %q", value)
+		}
+	}
+}
+
+// TestApplyAge tests age bucketing and SLA breach detection
+func TestApplyAge(t *testing.T) {
+	policy, _ := NewSLAPolicy(24, 0, 0, "")
+	now := time.Unix(1700000000, 0)
+
+	pending := IssueTransaction{
+		Timestamp: now.Add(-50 * time.Hour).Unix(),
+		Type:      string(TypeDebit),
+		Status:    string(StatusPending),
+	}
+	policy.ApplyAge(&pending, now)
+
+	if pending.AgeBucket != AgeBucketOneToThreeDay {
+		t.Errorf("Expected bucket %s, got %s", AgeBucketOneToThreeDay, pending.AgeBucket)
+	}
+	if !pending.SLABreached {
+		t.Error("Expected pending transaction older than SLA to be breached")
+	}
+
+	failed := IssueTransaction{
+		Timestamp: now.Add(-100 * time.Hour).Unix(),
+		Type:      string(TypeDebit),
+		Status:    string(StatusFailed),
+	}
+	policy.ApplyAge(&failed, now)
+
+	if failed.AgeBucket != AgeBucketOverThreeDays {
+		t.Errorf("Expected bucket %s, got %s", AgeBucketOverThreeDays, failed.AgeBucket)
+	}
+	if failed.SLABreached {
+		t.Error("Failed transactions should not breach SLA")
+	}
+}
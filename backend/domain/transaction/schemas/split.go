@@ -0,0 +1,86 @@
+package schemas
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Split limits
+const (
+	MaxSplits          = 50
+	maxSplitNoteLength = 255
+)
+
+// Expansions of the transaction list
+const ExpandSplits = "splits"
+
+var ErrInvalidSplit = errors.New("invalid split")
+
+// TransactionSplit allocates part of a transaction's amount to a category
+// The splits of a transaction sum to its amount and replace it in category reports
+type TransactionSplit struct {
+	ID            string    `gorm:"primaryKey;type:text" json:"id"`
+	TransactionID string    `gorm:"type:text;index" json:"transaction_id"`
+	Position      int       `json:"position"`
+	Amount        int64     `json:"amount"`
+	CategoryID    *string   `gorm:"type:text;index" json:"category_id"`
+	Note          string    `gorm:"type:text" json:"note"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for TransactionSplit
+func (TransactionSplit) TableName() string {
+	return "transaction_splits"
+}
+
+// SplitAllocation is one allocation of a split request; the amount uses the CSV units
+type SplitAllocation struct {
+	Amount     json.Number `json:"amount"`
+	CategoryID *string     `json:"category_id"`
+	Note       string      `json:"note"`
+}
+
+// SplitRequest replaces the splits of a transaction; an empty list removes them
+type SplitRequest struct {
+	Splits []SplitAllocation `json:"splits"`
+}
+
+// SplitResponse lists the splits of a transaction
+type SplitResponse struct {
+	Message       string             `json:"message"`
+	TransactionID string             `json:"transaction_id"`
+	Amount        int64              `json:"amount"`
+	Splits        []TransactionSplit `json:"splits"`
+}
+
+// TransactionExpand selects related data included in the transaction list
+type TransactionExpand struct {
+	Splits bool
+}
+
+// ParseExpand parses a comma separated expand query parameter
+func ParseExpand(value string) (TransactionExpand, error) {
+	var expand TransactionExpand
+	for _, part := range strings.Split(value, ",") {
+		switch strings.ToLower(strings.TrimSpace(part)) {
+		case "":
+		case ExpandSplits:
+			expand.Splits = true
+		default:
+			return expand, fmt.Errorf("unknown expand %q, expected %s", strings.TrimSpace(part), ExpandSplits)
+		}
+	}
+	return expand, nil
+}
+
+// NormalizeSplitNote trims a split note and checks its length
+func NormalizeSplitNote(note string) (string, error) {
+	note = strings.TrimSpace(note)
+	if len(note) > maxSplitNoteLength {
+		return "", fmt.Errorf("%w: note exceeds maximum length of %d characters", ErrInvalidSplit, maxSplitNoteLength)
+	}
+	return note, nil
+}
@@ -0,0 +1,30 @@
+package schemas
+
+import "time"
+
+// StatementBalance checks the opening and closing balances of one bank statement against its booked entries
+// Balances are in cents and signed, so an overdrawn account has a negative balance
+type StatementBalance struct {
+	ID          string    `gorm:"primaryKey;type:text" json:"id"`
+	BatchID     string    `gorm:"type:text;index" json:"batch_id"`
+	StatementID string    `gorm:"type:text" json:"statement_id,omitempty"`
+	Account     string    `gorm:"type:text" json:"account,omitempty"`
+	Currency    string    `gorm:"type:text" json:"currency,omitempty"`
+	Opening     int64     `json:"opening_balance"`
+	Closing     int64     `json:"closing_balance"`
+	Computed    int64     `json:"computed_closing_balance"`
+	Mismatch    bool      `json:"mismatch"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for StatementBalance
+func (StatementBalance) TableName() string {
+	return "statement_balances"
+}
+
+// Statement is the content of a bank statement file
+// A file may hold several statements, each with its own balance check
+type Statement struct {
+	Transactions []Transaction
+	Balances     []StatementBalance
+}
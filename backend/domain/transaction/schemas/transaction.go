@@ -1,6 +1,9 @@
 package schemas
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"time"
 
 	"gorm.io/gorm"
@@ -20,18 +23,34 @@ const (
 	StatusPending TransactionStatus = "PENDING"
 )
 
+var (
+	ErrTransactionNotFound = errors.New("transaction not found")
+	ErrInvalidTransaction  = errors.New("invalid transaction")
+)
+
 // Transaction represents a bank transaction
 type Transaction struct {
-	ID          string                `gorm:"primaryKey;type:text" json:"id"`
-	Timestamp   int64                 `gorm:"index" json:"timestamp"`
-	Name        string                `json:"name"`
-	Type        TransactionType       `gorm:"type:text" json:"type"`
-	Amount      int64                 `json:"amount"`
-	Status      TransactionStatus     `gorm:"type:text;index" json:"status"`
-	Description string                `json:"description"`
-	CreatedAt   time.Time             `json:"created_at"`
-	UpdatedAt   time.Time             `json:"updated_at"`
-	DeletedAt   gorm.DeletedAt        `gorm:"index" json:"-"`
+	ID                   string             `gorm:"primaryKey;type:text" json:"id"`
+	Timestamp            int64              `gorm:"index" json:"timestamp"`
+	Name                 string             `json:"name"`
+	Type                 TransactionType    `gorm:"type:text" json:"type"`
+	Amount               int64              `json:"amount"`
+	Status               TransactionStatus  `gorm:"type:text;index" json:"status"`
+	Description          string             `json:"description"`
+	CreatedAt            time.Time          `json:"created_at"`
+	UpdatedAt            time.Time          `json:"updated_at"`
+	DeletedAt            gorm.DeletedAt     `gorm:"index" json:"-"`
+	ClearID              string             `gorm:"type:text;index" json:"-"`
+	CategoryID           *string            `gorm:"type:text;index" json:"category_id"`
+	Tags                 []string           `gorm:"-" json:"tags,omitempty"`
+	Flags                []TransactionFlag  `gorm:"-" json:"flags,omitempty"`
+	Splits               []TransactionSplit `gorm:"-" json:"splits,omitempty"`
+	CounterpartyID       *string            `gorm:"type:text;index" json:"counterparty_id"`
+	BatchID              *string            `gorm:"type:text;index" json:"batch_id"`
+	ExternalID           *string            `gorm:"type:text;index" json:"external_id,omitempty"`
+	Currency             string             `gorm:"type:text" json:"currency,omitempty"`
+	RelatedTransactionID *string            `gorm:"type:text;index" json:"related_transaction_id"`
+	RelationType         string             `gorm:"type:text" json:"relation_type,omitempty"`
 }
 
 // TableName specifies the table name for Transaction
@@ -46,11 +65,31 @@ type UploadRequest struct {
 
 // UploadResponse represents the response after upload
 type UploadResponse struct {
-	Message        string `json:"message"`
-	TotalRecords   int    `json:"total_records"`
-	SuccessRecords int    `json:"success_records"`
-	FailedRecords  int    `json:"failed_records"`
-	PendingRecords int    `json:"pending_records"`
+	Message            string             `json:"message"`
+	TotalRecords       int                `json:"total_records"`
+	SuccessRecords     int                `json:"success_records"`
+	FailedRecords      int                `json:"failed_records"`
+	PendingRecords     int                `json:"pending_records"`
+	CategorizedRecords int                `json:"categorized_records"`
+	NewCounterparties  int                `json:"new_counterparties"`
+	FlaggedRecords     int                `json:"flagged_records"`
+	SuggestedLinks     int                `json:"suggested_links"`
+	SkippedDuplicates  int                `json:"skipped_duplicates"`
+	BatchID            string             `json:"batch_id"`
+	BalanceMismatch    bool               `json:"balance_mismatch,omitempty"`
+	StatementBalances  []StatementBalance `json:"statement_balances,omitempty"`
+}
+
+// TransactionRequest represents a manual create or correction request
+// Amount uses the same units as the CSV upload and is stored in cents
+// Fields left out of a PATCH request keep their current values
+type TransactionRequest struct {
+	Timestamp   *json.Number `json:"timestamp"`
+	Name        *string      `json:"name"`
+	Type        *string      `json:"type"`
+	Amount      *json.Number `json:"amount"`
+	Status      *string      `json:"status"`
+	Description *string      `json:"description"`
 }
 
 // BalanceResponse represents the balance calculation response
@@ -60,16 +99,30 @@ type BalanceResponse struct {
 	Debits  int64 `json:"debits"`
 }
 
-// IssueTransaction represents a non-successful transaction for issues endpoint
+// IssueTransaction represents a transaction in list format for the issues and transactions endpoints
 type IssueTransaction struct {
-	ID          string `json:"id"`
-	Timestamp   int64  `json:"timestamp"`
-	Name        string `json:"name"`
-	Type        string `json:"type"`
-	Amount      int64  `json:"amount"`
-	Status      string `json:"status"`
-	Description string `json:"description"`
-	CreatedAt   string `json:"created_at"`
+	ID                   string             `json:"id"`
+	Timestamp            int64              `json:"timestamp"`
+	Name                 string             `json:"name"`
+	Type                 string             `json:"type"`
+	Amount               int64              `json:"amount"`
+	Status               string             `json:"status"`
+	Description          string             `json:"description"`
+	CreatedAt            string             `json:"created_at"`
+	AgeSeconds           int64              `json:"age_seconds,omitempty"`
+	AgeBucket            string             `json:"age_bucket,omitempty"`
+	SLAHours             int                `json:"sla_hours,omitempty"`
+	SLABreached          bool               `json:"sla_breached,omitempty"`
+	CategoryID           string             `json:"category_id,omitempty"`
+	Tags                 []string           `json:"tags,omitempty"`
+	CounterpartyID       string             `json:"counterparty_id,omitempty"`
+	BatchID              string             `json:"batch_id,omitempty"`
+	ExternalID           string             `json:"external_id,omitempty"`
+	Currency             string             `json:"currency,omitempty"`
+	Flags                []TransactionFlag  `json:"flags,omitempty"`
+	Splits               []TransactionSplit `json:"splits,omitempty"`
+	RelatedTransactionID string             `json:"related_transaction_id,omitempty"`
+	RelationType         string             `json:"relation_type,omitempty"`
 }
 
 // PaginationLinks represents pagination navigation links
@@ -124,16 +177,69 @@ type SuccessResponse struct {
 
 // TransactionFilters represents filtering options
 type TransactionFilters struct {
-	Status      string
-	Type        string
-	SearchQuery string
-	Amount      int64
-	StartDate   string
-	EndDate     string
+	Status       string
+	Type         string
+	SearchQuery  string
+	Amount       int64
+	StartDate    string
+	EndDate      string
+	Category     string
+	Tag          string
+	Counterparty string
+	Batch        string
+	Reason       string
+}
+
+// Meta returns the applied filters as response metadata
+func (f TransactionFilters) Meta() map[string]interface{} {
+	meta := make(map[string]interface{})
+	if f.Status != "" {
+		meta["status"] = f.Status
+	}
+	if f.Type != "" {
+		meta["type"] = f.Type
+	}
+	if f.SearchQuery != "" {
+		meta["search"] = f.SearchQuery
+	}
+	if f.Amount > 0 {
+		meta["amount"] = f.Amount
+	}
+	if f.StartDate != "" {
+		meta["start_date"] = f.StartDate
+	}
+	if f.EndDate != "" {
+		meta["end_date"] = f.EndDate
+	}
+	if f.Category != "" {
+		meta["category"] = f.Category
+	}
+	if f.Tag != "" {
+		meta["tag"] = f.Tag
+	}
+	if f.Counterparty != "" {
+		meta["counterparty"] = f.Counterparty
+	}
+	if f.Batch != "" {
+		meta["batch"] = f.Batch
+	}
+	if f.Reason != "" {
+		meta["reason"] = f.Reason
+	}
+	return meta
 }
 
 // TransactionSort represents sorting options
 type TransactionSort struct {
-	By    string // timestamp, amount, name, status, type, description, created_at
+	By    string // timestamp, amount, name, status, type, description, created_at (issues also accept age)
 	Order string // ASC or DESC
 }
+
+// FormatAmount turns cents into the decimal amount used by the upload CSV, such as 2500.50
+func FormatAmount(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
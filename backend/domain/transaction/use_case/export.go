@@ -0,0 +1,280 @@
+package use_case
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/xlsx"
+)
+
+// exportHeader is the header row of a transaction CSV export, the same columns as the upload CSV
+var exportHeader = []string{"timestamp", "name", "type", "amount", "status", "description"}
+
+// exportFlushSize is how many rows are buffered before an export is flushed to the client
+// It is also the batch size used to load the flags of the issues sheet
+const exportFlushSize = 500
+
+// Sheet names of the XLSX export
+const (
+	sheetTransactions = "Transactions"
+	sheetIssues       = "Issues"
+	sheetSummary      = "Summary"
+)
+
+// exportTotal counts the rows and amount of one group in the summary sheet
+type exportTotal struct {
+	count  int64
+	amount int64
+}
+
+// CheckExport makes sure an export in format can be written before any of it is sent
+// An XLSX export of more transactions than one sheet holds, below its header row, returns ErrExportTooLarge
+func (uc *UseCase) CheckExport(ctx context.Context, format string, filters schemas.TransactionFilters) error {
+	if format != schemas.ExportFormatXLSX {
+		return nil
+	}
+
+	count, err := uc.Repository.CountWithFilters(ctx, filters)
+	if err != nil {
+		return err
+	}
+	if limit := int64(xlsx.MaxRows - 1); count > limit {
+		return fmt.Errorf("%w: %d match, one sheet holds %d; export as csv or narrow the filters", schemas.ErrExportTooLarge, count, limit)
+	}
+	return nil
+}
+
+// ExportCSV writes every transaction matching the filters to w in the upload CSV layout
+// Rows are streamed from the database and amounts are converted back from cents. With escapeFormulas, names and
+// descriptions a spreadsheet would run as a formula are prefixed with a quote; without it the file uploads unchanged
+func (uc *UseCase) ExportCSV(ctx context.Context, filters schemas.TransactionFilters, sort schemas.TransactionSort, escapeFormulas bool, w io.Writer) error {
+	text := func(value string) string { return value }
+	if escapeFormulas {
+		text = escapeFormula
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(exportHeader); err != nil {
+		return err
+	}
+
+	rows := 0
+	err := uc.Repository.StreamWithFiltersAndSort(ctx, filters, sort, func(t schemas.Transaction) error {
+		record := []string{
+			strconv.FormatInt(t.Timestamp, 10),
+			text(t.Name),
+			string(t.Type),
+			schemas.FormatAmount(t.Amount),
+			string(t.Status),
+			text(t.Description),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+
+		rows++
+		if rows%exportFlushSize == 0 {
+			writer.Flush()
+			return writer.Error()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// ExportXLSX writes a workbook with the matching transactions, the matching issues and a summary sheet
+// Timestamps are written as date cells and amounts as numbers in the upload units
+func (uc *UseCase) ExportXLSX(ctx context.Context, filters schemas.TransactionFilters, sort schemas.TransactionSort, w io.Writer) error {
+	balance, err := uc.GetBalance(ctx)
+	if err != nil {
+		return err
+	}
+
+	workbook := xlsx.NewWriter(w)
+
+	byStatus := make(map[string]*exportTotal)
+	byType := make(map[string]*exportTotal)
+	if err := workbook.AddSheet(sheetTransactions); err != nil {
+		return err
+	}
+	if err := workbook.WriteRow(headerCells(exportHeader)...); err != nil {
+		return err
+	}
+	err = uc.Repository.StreamWithFiltersAndSort(ctx, filters, sort, func(t schemas.Transaction) error {
+		addTotal(byStatus, string(t.Status), t.Amount)
+		addTotal(byType, string(t.Type), t.Amount)
+		return workbook.WriteRow(transactionCells(t)...)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := workbook.AddSheet(sheetIssues); err != nil {
+		return err
+	}
+	if err := uc.writeIssues(ctx, workbook, filters, sort); err != nil {
+		return err
+	}
+
+	if err := workbook.AddSheet(sheetSummary); err != nil {
+		return err
+	}
+	if err := writeSummary(workbook, uc.Now(), balance, byStatus, byType); err != nil {
+		return err
+	}
+
+	return workbook.Close()
+}
+
+// writeIssues writes the issues sheet, loading the flags of each batch of issues at once
+func (uc *UseCase) writeIssues(ctx context.Context, workbook *xlsx.Writer, filters schemas.TransactionFilters, sort schemas.TransactionSort) error {
+	if err := workbook.WriteRow(headerCells(append(exportHeader, "flags"))...); err != nil {
+		return err
+	}
+
+	batch := make([]schemas.Transaction, 0, exportFlushSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		ids := make([]string, len(batch))
+		for i, t := range batch {
+			ids[i] = t.ID
+		}
+		flags, err := uc.Repository.FindFlags(ctx, ids)
+		if err != nil {
+			return err
+		}
+
+		for _, t := range batch {
+			reasons := make([]string, len(flags[t.ID]))
+			for i, flag := range flags[t.ID] {
+				reasons[i] = flag.Reason
+			}
+			if err := workbook.WriteRow(append(transactionCells(t), xlsx.String(strings.Join(reasons, ", ")))...); err != nil {
+				return err
+			}
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	err := uc.Repository.StreamIssuesWithFiltersAndSort(ctx, filters, sort, func(t schemas.Transaction) error {
+		batch = append(batch, t)
+		if len(batch) == exportFlushSize {
+			return flush()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return flush()
+}
+
+// writeSummary writes the balance figures and the totals of the exported transactions by status and type
+func writeSummary(
+	workbook *xlsx.Writer,
+	generatedAt time.Time,
+	balance *schemas.BalanceResponse,
+	byStatus map[string]*exportTotal,
+	byType map[string]*exportTotal,
+) error {
+	rows := [][]xlsx.Cell{
+		{xlsx.Bold("generated_at"), xlsx.Time(generatedAt)},
+		{},
+		{xlsx.Bold("balance"), xlsx.Bold("amount")},
+		{xlsx.String("balance"), amountCell(balance.Balance)},
+		{xlsx.String("credits"), amountCell(balance.Credits)},
+		{xlsx.String("debits"), amountCell(balance.Debits)},
+		{},
+		{xlsx.Bold("status"), xlsx.Bold("count"), xlsx.Bold("amount")},
+	}
+
+	var count int64
+	for _, status := range []schemas.TransactionStatus{schemas.StatusSuccess, schemas.StatusFailed, schemas.StatusPending} {
+		total := totalOf(byStatus, string(status))
+		count += total.count
+		rows = append(rows, []xlsx.Cell{xlsx.String(string(status)), xlsx.Number(float64(total.count)), amountCell(total.amount)})
+	}
+	rows = append(rows, []xlsx.Cell{xlsx.Bold("total"), xlsx.Number(float64(count))}, []xlsx.Cell{})
+
+	rows = append(rows, []xlsx.Cell{xlsx.Bold("type"), xlsx.Bold("count"), xlsx.Bold("amount")})
+	for _, transactionType := range []schemas.TransactionType{schemas.TypeCredit, schemas.TypeDebit} {
+		total := totalOf(byType, string(transactionType))
+		rows = append(rows, []xlsx.Cell{xlsx.String(string(transactionType)), xlsx.Number(float64(total.count)), amountCell(total.amount)})
+	}
+
+	for _, row := range rows {
+		if err := workbook.WriteRow(row...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addTotal adds one transaction to the total of its group
+func addTotal(totals map[string]*exportTotal, key string, amount int64) {
+	total, ok := totals[key]
+	if !ok {
+		total = &exportTotal{}
+		totals[key] = total
+	}
+	total.count++
+	total.amount += amount
+}
+
+// totalOf returns the total of a group, zero when no transaction was exported for it
+func totalOf(totals map[string]*exportTotal, key string) exportTotal {
+	if total, ok := totals[key]; ok {
+		return *total
+	}
+	return exportTotal{}
+}
+
+// headerCells returns a bold header row
+func headerCells(names []string) []xlsx.Cell {
+	cells := make([]xlsx.Cell, len(names))
+	for i, name := range names {
+		cells[i] = xlsx.Bold(name)
+	}
+	return cells
+}
+
+// transactionCells returns the export columns of a transaction as typed cells
+func transactionCells(t schemas.Transaction) []xlsx.Cell {
+	return []xlsx.Cell{
+		xlsx.Time(time.Unix(t.Timestamp, 0)),
+		xlsx.String(t.Name),
+		xlsx.String(string(t.Type)),
+		amountCell(t.Amount),
+		xlsx.String(string(t.Status)),
+		xlsx.String(t.Description),
+	}
+}
+
+// escapeFormula prefixes text that a spreadsheet would read as a formula with a quote, so opening an
+// export never runs one; uploads keep the quote, so it is only added on request. XLSX cells are typed as text and
+// need no escaping
+func escapeFormula(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// amountCell turns cents into a numeric cell in the upload units
+func amountCell(cents int64) xlsx.Cell {
+	return xlsx.Decimal(float64(cents) / 100)
+}
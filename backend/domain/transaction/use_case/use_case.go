@@ -2,9 +2,23 @@ package use_case
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
 
+	auditSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
 	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/repository"
 	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // IUseCase defines the contract for transaction use case operations
@@ -12,18 +26,33 @@ type IUseCase interface {
 	GetBalance(ctx context.Context) (*schemas.BalanceResponse, error)
 	GetIssues(ctx context.Context, page int, pageSize int) (*schemas.IssuesResponse, error)
 	GetIssuesWithFiltersAndSort(ctx context.Context, page int, pageSize int, filters schemas.TransactionFilters, sort schemas.TransactionSort) (*schemas.IssuesResponse, error)
-	GetAllWithFiltersAndSort(ctx context.Context, page int, pageSize int, filters schemas.TransactionFilters, sort schemas.TransactionSort) (*schemas.IssuesResponse, error)
+	GetIssuesSummary(ctx context.Context, filters schemas.TransactionFilters) (*schemas.IssuesSummaryResponse, error)
+	GetAllWithFiltersAndSort(ctx context.Context, page int, pageSize int, filters schemas.TransactionFilters, sort schemas.TransactionSort, expand schemas.TransactionExpand) (*schemas.IssuesResponse, error)
+	ExportCSV(ctx context.Context, filters schemas.TransactionFilters, sort schemas.TransactionSort, escapeFormulas bool, w io.Writer) error
+	ExportXLSX(ctx context.Context, filters schemas.TransactionFilters, sort schemas.TransactionSort, w io.Writer) error
+	CheckExport(ctx context.Context, format string, filters schemas.TransactionFilters) error
+	GetTransaction(ctx context.Context, id string) (*schemas.Transaction, error)
+	GetTransactionRevisions(ctx context.Context, id string) ([]schemas.TransactionRevision, error)
+	CreateTransaction(ctx context.Context, req schemas.TransactionRequest, fieldValidator *validator.FieldValidator) (*schemas.Transaction, error)
+	UpdateTransaction(ctx context.Context, id string, req schemas.TransactionRequest, partial bool, fieldValidator *validator.FieldValidator) (*schemas.Transaction, error)
+	DeleteTransaction(ctx context.Context, id string) error
 }
 
 // UseCase implements IUseCase
 type UseCase struct {
 	Repository repository.IRepository
+	SLAPolicy  schemas.SLAPolicy
+	Audit      auditUseCase.IUseCase
+	Now        func() time.Time
 }
 
 // NewUseCase creates a new transaction use case instance
-func NewUseCase(repo repository.IRepository) IUseCase {
+func NewUseCase(repo repository.IRepository, slaPolicy schemas.SLAPolicy, audit auditUseCase.IUseCase) IUseCase {
 	return &UseCase{
 		Repository: repo,
+		SLAPolicy:  slaPolicy,
+		Audit:      audit,
+		Now:        time.Now,
 	}
 }
 
@@ -46,15 +75,355 @@ func (uc *UseCase) GetBalance(ctx context.Context) (*schemas.BalanceResponse, er
 
 // GetIssues retrieves non-successful transactions
 func (uc *UseCase) GetIssues(ctx context.Context, page int, pageSize int) (*schemas.IssuesResponse, error) {
-	return uc.Repository.GetIssues(ctx, page, pageSize)
+	response, err := uc.Repository.GetIssues(ctx, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.applyAges(response)
+	return response, nil
 }
 
 // GetIssuesWithFiltersAndSort retrieves issues with filtering and sorting
 func (uc *UseCase) GetIssuesWithFiltersAndSort(ctx context.Context, page int, pageSize int, filters schemas.TransactionFilters, sort schemas.TransactionSort) (*schemas.IssuesResponse, error) {
-	return uc.Repository.GetIssuesWithFiltersAndSort(ctx, page, pageSize, filters, sort)
+	response, err := uc.Repository.GetIssuesWithFiltersAndSort(ctx, page, pageSize, filters, sort)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.applyAges(response)
+	return response, nil
+}
+
+// GetIssuesSummary buckets issues by age and counts SLA breaches
+func (uc *UseCase) GetIssuesSummary(ctx context.Context, filters schemas.TransactionFilters) (*schemas.IssuesSummaryResponse, error) {
+	transactions, err := uc.Repository.FindIssues(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.Now()
+	buckets := []schemas.IssueAgeBucket{
+		{Bucket: schemas.AgeBucketUnderOneDay},
+		{Bucket: schemas.AgeBucketOneToThreeDay},
+		{Bucket: schemas.AgeBucketOverThreeDays},
+	}
+	bucketIndex := map[string]int{
+		schemas.AgeBucketUnderOneDay:   0,
+		schemas.AgeBucketOneToThreeDay: 1,
+		schemas.AgeBucketOverThreeDays: 2,
+	}
+
+	summary := &schemas.IssuesSummaryResponse{
+		Message:     constants.MsgIssuesSummaryRetrieved,
+		Filters:     filters.Meta(),
+		GeneratedAt: now.UTC().Format(time.RFC3339),
+	}
+
+	for _, t := range transactions {
+		issue := schemas.IssueTransaction{
+			Timestamp: t.Timestamp,
+			Type:      string(t.Type),
+			Amount:    t.Amount,
+			Status:    string(t.Status),
+		}
+		uc.SLAPolicy.ApplyAge(&issue, now)
+
+		bucket := &buckets[bucketIndex[issue.AgeBucket]]
+		bucket.Count++
+		bucket.TotalAmount += issue.Amount
+		summary.TotalCount++
+		summary.TotalAmount += issue.Amount
+
+		if issue.SLABreached {
+			bucket.SLABreaches++
+			summary.SLABreaches++
+		}
+	}
+
+	summary.Buckets = buckets
+	return summary, nil
+}
+
+// applyAges fills in age and SLA fields on every issue in the response
+func (uc *UseCase) applyAges(response *schemas.IssuesResponse) {
+	now := uc.Now()
+	for i := range response.Data {
+		uc.SLAPolicy.ApplyAge(&response.Data[i], now)
+	}
 }
 
 // GetAllWithFiltersAndSort retrieves all transactions with filtering and sorting
-func (uc *UseCase) GetAllWithFiltersAndSort(ctx context.Context, page int, pageSize int, filters schemas.TransactionFilters, sort schemas.TransactionSort) (*schemas.IssuesResponse, error) {
-	return uc.Repository.GetAllWithFiltersAndSort(ctx, page, pageSize, filters, sort)
+// expand selects related data to include with each transaction
+func (uc *UseCase) GetAllWithFiltersAndSort(ctx context.Context, page int, pageSize int, filters schemas.TransactionFilters, sort schemas.TransactionSort, expand schemas.TransactionExpand) (*schemas.IssuesResponse, error) {
+	response, err := uc.Repository.GetAllWithFiltersAndSort(ctx, page, pageSize, filters, sort)
+	if err != nil || !expand.Splits {
+		return response, err
+	}
+
+	ids := make([]string, len(response.Data))
+	for i, t := range response.Data {
+		ids[i] = t.ID
+	}
+	splits, err := uc.Repository.FindSplits(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range response.Data {
+		response.Data[i].Splits = splits[response.Data[i].ID]
+	}
+
+	return response, nil
+}
+
+// GetTransaction retrieves a single transaction by ID
+func (uc *UseCase) GetTransaction(ctx context.Context, id string) (*schemas.Transaction, error) {
+	transaction, err := uc.Repository.FindByID(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, schemas.ErrTransactionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := uc.Repository.FindTags(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+	transaction.Tags = tags[id]
+
+	flags, err := uc.Repository.FindFlags(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+	transaction.Flags = flags[id]
+
+	splits, err := uc.Repository.FindSplits(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+	transaction.Splits = splits[id]
+
+	return transaction, nil
+}
+
+// GetTransactionRevisions retrieves the revision history of a transaction
+func (uc *UseCase) GetTransactionRevisions(ctx context.Context, id string) ([]schemas.TransactionRevision, error) {
+	return uc.Repository.FindRevisions(ctx, id)
+}
+
+// CreateTransaction validates and stores a single manually entered transaction
+// The row, its revision and the audit event are written in one database transaction
+func (uc *UseCase) CreateTransaction(ctx context.Context, req schemas.TransactionRequest, fieldValidator *validator.FieldValidator) (*schemas.Transaction, error) {
+	transaction := &schemas.Transaction{ID: uuid.New().String()}
+	if err := applyRequest(transaction, req, false, fieldValidator); err != nil {
+		return nil, err
+	}
+
+	err := uc.Repository.Transaction(ctx, func(ctx context.Context) error {
+		if err := uc.Repository.Create(ctx, transaction); err != nil {
+			return err
+		}
+
+		if err := uc.recordRevision(ctx, transaction.ID, schemas.RevisionActionCreate, nil, transaction); err != nil {
+			return err
+		}
+
+		return uc.recordAudit(ctx, auditSchemas.ActionTransactionCreate, nil, transaction)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// UpdateTransaction corrects the fields of a transaction, keeping the original values as a revision
+// With partial set, only the fields present in the request are changed (PATCH)
+func (uc *UseCase) UpdateTransaction(ctx context.Context, id string, req schemas.TransactionRequest, partial bool, fieldValidator *validator.FieldValidator) (*schemas.Transaction, error) {
+	var transaction *schemas.Transaction
+	err := uc.Repository.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		transaction, err = uc.GetTransaction(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		before := *transaction
+		if err := applyRequest(transaction, req, partial, fieldValidator); err != nil {
+			return err
+		}
+
+		// The splits must keep summing to the amount
+		if transaction.Amount != before.Amount && len(before.Splits) > 0 {
+			return fmt.Errorf("%w: amount cannot change while the transaction is split; remove or replace its splits first", schemas.ErrInvalidTransaction)
+		}
+
+		if err := uc.Repository.Update(ctx, transaction); err != nil {
+			return err
+		}
+
+		if err := uc.recordRevision(ctx, transaction.ID, schemas.RevisionActionUpdate, &before, transaction); err != nil {
+			return err
+		}
+
+		action := auditSchemas.ActionTransactionUpdate
+		if before.Status != transaction.Status {
+			action = auditSchemas.ActionTransactionStatus
+		}
+		return uc.recordAudit(ctx, action, &before, transaction)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// DeleteTransaction soft-deletes a transaction, keeping its last values as a revision
+func (uc *UseCase) DeleteTransaction(ctx context.Context, id string) error {
+	return uc.Repository.Transaction(ctx, func(ctx context.Context) error {
+		transaction, err := uc.GetTransaction(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.Repository.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		if err := uc.recordRevision(ctx, id, schemas.RevisionActionDelete, transaction, nil); err != nil {
+			return err
+		}
+
+		return uc.recordAudit(ctx, auditSchemas.ActionTransactionDelete, transaction, nil)
+	})
+}
+
+// recordAudit appends an audit event for a change to a single transaction
+// A status change is recorded under its own action so it can be filtered on
+func (uc *UseCase) recordAudit(ctx context.Context, action string, before, after *schemas.Transaction) error {
+	entry := auditSchemas.AuditEntry{
+		Action:     action,
+		TargetType: auditSchemas.TargetTransaction,
+	}
+
+	if before != nil {
+		entry.TargetID = before.ID
+		entry.Before = before
+	}
+
+	if after != nil {
+		entry.TargetID = after.ID
+		entry.After = after
+	}
+
+	if action == auditSchemas.ActionTransactionStatus {
+		entry.Detail = map[string]interface{}{
+			"from": before.Status,
+			"to":   after.Status,
+		}
+	}
+
+	return uc.Audit.Record(ctx, entry)
+}
+
+// recordRevision stores the before and after values of a manual change
+func (uc *UseCase) recordRevision(ctx context.Context, transactionID string, action string, before, after *schemas.Transaction) error {
+	revision := &schemas.TransactionRevision{
+		ID:            uuid.New().String(),
+		TransactionID: transactionID,
+		Action:        action,
+	}
+
+	if before != nil {
+		data, err := json.Marshal(before)
+		if err != nil {
+			return err
+		}
+		revision.Before = data
+	}
+
+	if after != nil {
+		data, err := json.Marshal(after)
+		if err != nil {
+			return err
+		}
+		revision.After = data
+	}
+
+	return uc.Repository.CreateRevision(ctx, revision)
+}
+
+// applyRequest validates the request fields with the CSV field rules and copies them onto the transaction
+// Unless partial is set, every required field must be present
+func applyRequest(transaction *schemas.Transaction, req schemas.TransactionRequest, partial bool, fieldValidator *validator.FieldValidator) error {
+	if !partial {
+		var missing []string
+		if req.Timestamp == nil {
+			missing = append(missing, "timestamp")
+		}
+		if req.Name == nil {
+			missing = append(missing, "name")
+		}
+		if req.Type == nil {
+			missing = append(missing, "type")
+		}
+		if req.Amount == nil {
+			missing = append(missing, "amount")
+		}
+		if req.Status == nil {
+			missing = append(missing, "status")
+		}
+		if len(missing) > 0 {
+			return fmt.Errorf("%w: missing required fields: %s", schemas.ErrInvalidTransaction, strings.Join(missing, ", "))
+		}
+	}
+
+	if req.Timestamp != nil {
+		timestamp, err := fieldValidator.ParseTimestamp(req.Timestamp.String())
+		if err != nil {
+			return fmt.Errorf("%w: timestamp: %v", schemas.ErrInvalidTransaction, err)
+		}
+		transaction.Timestamp = timestamp
+	}
+
+	if req.Name != nil {
+		if err := fieldValidator.ValidateName(*req.Name); err != nil {
+			return fmt.Errorf("%w: name: %v", schemas.ErrInvalidTransaction, err)
+		}
+		transaction.Name = strings.TrimSpace(*req.Name)
+	}
+
+	if req.Type != nil {
+		if err := fieldValidator.ValidateTransactionType(*req.Type); err != nil {
+			return fmt.Errorf("%w: type: %v", schemas.ErrInvalidTransaction, err)
+		}
+		transaction.Type = schemas.TransactionType(strings.ToUpper(strings.TrimSpace(*req.Type)))
+	}
+
+	if req.Amount != nil {
+		if err := fieldValidator.ValidateAmount(req.Amount.String()); err != nil {
+			return fmt.Errorf("%w: amount: %v", schemas.ErrInvalidTransaction, err)
+		}
+		// Convert to cents, same as the CSV upload
+		amountFloat, _ := strconv.ParseFloat(strings.TrimSpace(req.Amount.String()), 64)
+		transaction.Amount = int64(math.Round(amountFloat * 100))
+	}
+
+	if req.Status != nil {
+		if err := fieldValidator.ValidateStatus(*req.Status); err != nil {
+			return fmt.Errorf("%w: status: %v", schemas.ErrInvalidTransaction, err)
+		}
+		transaction.Status = schemas.TransactionStatus(strings.ToUpper(strings.TrimSpace(*req.Status)))
+	}
+
+	if req.Description != nil {
+		if err := fieldValidator.ValidateDescription(*req.Description); err != nil {
+			return fmt.Errorf("%w: description: %v", schemas.ErrInvalidTransaction, err)
+		}
+		transaction.Description = strings.TrimSpace(*req.Description)
+	}
+
+	return nil
 }
@@ -0,0 +1,374 @@
+package use_case
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	auditRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/repository"
+	auditSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/xlsx"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupTestUseCase creates a use case backed by an in-memory SQLite database
+func setupTestUseCase(t *testing.T) (IUseCase, *gorm.DB) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to setup test database: %v", err)
+	}
+
+	if err := db.AutoMigrate(&schemas.Transaction{}, &schemas.TransactionRevision{}, &schemas.TransactionTag{}, &schemas.TransactionFlag{}, &schemas.TransactionSplit{}, &schemas.LinkSuggestion{}, &auditSchemas.AuditEvent{}); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	policy, _ := schemas.NewSLAPolicy(72, 0, 0, "")
+	audit := auditUseCase.NewUseCase(auditRepo.NewRepository(db))
+	return NewUseCase(repository.NewRepository(db), policy, audit), db
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func numberPtr(s string) *json.Number {
+	n := json.Number(s)
+	return &n
+}
+
+// TestCreateTransaction tests manual creation with CSV field rules
+func TestCreateTransaction(t *testing.T) {
+	uc, _ := setupTestUseCase(t)
+	ctx := context.Background()
+	fieldValidator := validator.NewFieldValidator()
+
+	req := schemas.TransactionRequest{
+		Timestamp: numberPtr("1624507883"),
+		Name:      stringPtr(" JOHN DOE "),
+		Type:      stringPtr("debit"),
+		Amount:    numberPtr("2500"),
+		Status:    stringPtr("success"),
+	}
+
+	transaction, err := uc.CreateTransaction(ctx, req, fieldValidator)
+	if err != nil {
+		t.Fatalf("CreateTransaction failed: %v", err)
+	}
+
+	if transaction.Name != "JOHN DOE" || transaction.Type != schemas.TypeDebit || transaction.Amount != 250000 {
+		t.Errorf("Unexpected transaction: %+v", transaction)
+	}
+
+	// Missing fields are rejected
+	_, err = uc.CreateTransaction(ctx, schemas.TransactionRequest{Name: stringPtr("JOHN DOE")}, fieldValidator)
+	if !errors.Is(err, schemas.ErrInvalidTransaction) {
+		t.Errorf("Expected invalid transaction error, got %v", err)
+	}
+}
+
+// TestCreateTransactionRollsBack tests that the row and its revision are not kept when the audit event fails
+func TestCreateTransactionRollsBack(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	ctx := context.Background()
+
+	if err := db.Migrator().DropTable(&auditSchemas.AuditEvent{}); err != nil {
+		t.Fatalf("failed to drop audit table: %v", err)
+	}
+
+	req := schemas.TransactionRequest{
+		Timestamp: numberPtr("1624507883"),
+		Name:      stringPtr("JOHN DOE"),
+		Type:      stringPtr("DEBIT"),
+		Amount:    numberPtr("2500"),
+		Status:    stringPtr("SUCCESS"),
+	}
+	if _, err := uc.CreateTransaction(ctx, req, validator.NewFieldValidator()); err == nil {
+		t.Fatal("Expected CreateTransaction to fail without an audit log")
+	}
+
+	var transactions, revisions int64
+	db.Unscoped().Model(&schemas.Transaction{}).Count(&transactions)
+	db.Model(&schemas.TransactionRevision{}).Count(&revisions)
+	if transactions != 0 || revisions != 0 {
+		t.Errorf("Expected nothing stored, got %d transactions and %d revisions", transactions, revisions)
+	}
+}
+
+// TestUpdateTransactionKeepsRevision tests that corrections keep the original values
+func TestUpdateTransactionKeepsRevision(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	ctx := context.Background()
+	fieldValidator := validator.NewFieldValidator()
+
+	original := schemas.Transaction{ID: "1", Timestamp: 1000, Name: "JOHN DOE", Type: schemas.TypeDebit, Amount: 100, Status: schemas.StatusPending}
+	if err := db.Create(&original).Error; err != nil {
+		t.Fatalf("failed to insert test data: %v", err)
+	}
+
+	updated, err := uc.UpdateTransaction(ctx, "1", schemas.TransactionRequest{Status: stringPtr("SUCCESS")}, true, fieldValidator)
+	if err != nil {
+		t.Fatalf("UpdateTransaction failed: %v", err)
+	}
+
+	if updated.Status != schemas.StatusSuccess || updated.Name != "JOHN DOE" {
+		t.Errorf("Unexpected transaction after patch: %+v", updated)
+	}
+
+	revisions, err := uc.GetTransactionRevisions(ctx, "1")
+	if err != nil {
+		t.Fatalf("GetTransactionRevisions failed: %v", err)
+	}
+
+	if len(revisions) != 1 {
+		t.Fatalf("Expected 1 revision, got %d", len(revisions))
+	}
+
+	var before schemas.Transaction
+	if err := json.Unmarshal(revisions[0].Before, &before); err != nil {
+		t.Fatalf("failed to decode revision: %v", err)
+	}
+	if before.Status != schemas.StatusPending {
+		t.Errorf("Expected original status PENDING in revision, got %s", before.Status)
+	}
+
+	var event auditSchemas.AuditEvent
+	if err := db.Where("target_id = ?", "1").First(&event).Error; err != nil {
+		t.Fatalf("Expected audit event for the update: %v", err)
+	}
+	if event.Action != auditSchemas.ActionTransactionStatus || event.BeforeHash == "" || event.AfterHash == "" {
+		t.Errorf("Unexpected audit event: %+v", event)
+	}
+
+	// PUT without every field is rejected
+	_, err = uc.UpdateTransaction(ctx, "1", schemas.TransactionRequest{Status: stringPtr("FAILED")}, false, fieldValidator)
+	if !errors.Is(err, schemas.ErrInvalidTransaction) {
+		t.Errorf("Expected invalid transaction error, got %v", err)
+	}
+}
+
+// TestSplitTransactionAmountIsLocked tests that a split transaction keeps its amount and lists its splits
+func TestSplitTransactionAmountIsLocked(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	ctx := context.Background()
+	fieldValidator := validator.NewFieldValidator()
+
+	db.Create(&schemas.Transaction{ID: "1", Timestamp: 1000, Name: "OFFICE", Type: schemas.TypeDebit, Amount: 1000, Status: schemas.StatusSuccess})
+	db.Create(&[]schemas.TransactionSplit{
+		{ID: "a", TransactionID: "1", Position: 1, Amount: 600},
+		{ID: "b", TransactionID: "1", Position: 2, Amount: 400},
+	})
+
+	_, err := uc.UpdateTransaction(ctx, "1", schemas.TransactionRequest{Amount: numberPtr("20")}, true, fieldValidator)
+	if !errors.Is(err, schemas.ErrInvalidTransaction) {
+		t.Errorf("Expected invalid transaction error, got %v", err)
+	}
+
+	if _, err := uc.UpdateTransaction(ctx, "1", schemas.TransactionRequest{Name: stringPtr("OFFICE SUPPLIES")}, true, fieldValidator); err != nil {
+		t.Errorf("Expected other fields to stay editable, got %v", err)
+	}
+
+	response, err := uc.GetAllWithFiltersAndSort(ctx, 1, 10, schemas.TransactionFilters{}, schemas.TransactionSort{}, schemas.TransactionExpand{Splits: true})
+	if err != nil {
+		t.Fatalf("GetAllWithFiltersAndSort failed: %v", err)
+	}
+	if len(response.Data) != 1 || len(response.Data[0].Splits) != 2 || response.Data[0].Splits[0].ID != "a" {
+		t.Errorf("Expected both splits in order, got %+v", response.Data)
+	}
+}
+
+// TestExportCSV tests that the export applies filters and sort and uses the upload CSV layout
+func TestExportCSV(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	ctx := context.Background()
+
+	db.Create(&[]schemas.Transaction{
+		{ID: "1", Timestamp: 1000, Name: "JOHN DOE", Type: schemas.TypeDebit, Amount: 250050, Status: schemas.StatusSuccess, Description: "restaurant, dinner"},
+		{ID: "2", Timestamp: 2000, Name: "SHOP", Type: schemas.TypeDebit, Amount: 1000, Status: schemas.StatusFailed},
+		{ID: "3", Timestamp: 3000, Name: "SALARY", Type: schemas.TypeCredit, Amount: 500000, Status: schemas.StatusSuccess},
+	})
+
+	var buf bytes.Buffer
+	filters := schemas.TransactionFilters{Status: "SUCCESS"}
+	sort := schemas.TransactionSort{By: "amount", Order: "DESC"}
+	if err := uc.ExportCSV(ctx, filters, sort, false, &buf); err != nil {
+		t.Fatalf("ExportCSV failed: %v", err)
+	}
+
+	expected := "timestamp,name,type,amount,status,description\n" +
+		"3000,SALARY,CREDIT,5000.00,SUCCESS,\n" +
+		"1000,JOHN DOE,DEBIT,2500.50,SUCCESS,\"restaurant, dinner\"\n"
+	if buf.String() != expected {
+		t.Errorf("Unexpected export:\n%s", buf.String())
+	}
+}
+
+// TestExportCSVEscapesFormulas tests that text starting like a formula is exported with a quote prefix only on request
+func TestExportCSVEscapesFormulas(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+
+	db.Create(&[]schemas.Transaction{
+		{ID: "1", Timestamp: 1000, Name: "=HYPERLINK(\"http://x\")", Type: schemas.TypeDebit, Amount: 100, Status: schemas.StatusSuccess, Description: "@SUM(A1)"},
+		{ID: "2", Timestamp: 2000, Name: "+62 SHOP", Type: schemas.TypeDebit, Amount: 100, Status: schemas.StatusSuccess, Description: "-refund a=b"},
+	})
+
+	sort := schemas.TransactionSort{By: "timestamp", Order: "ASC"}
+	var buf bytes.Buffer
+	if err := uc.ExportCSV(context.Background(), schemas.TransactionFilters{}, sort, true, &buf); err != nil {
+		t.Fatalf("ExportCSV failed: %v", err)
+	}
+
+	expected := "timestamp,name,type,amount,status,description\n" +
+		"1000,\"'=HYPERLINK(\"\"http://x\"\")\",DEBIT,1.00,SUCCESS,'@SUM(A1)\n" +
+		"2000,'+62 SHOP,DEBIT,1.00,SUCCESS,'-refund a=b\n"
+	if buf.String() != expected {
+		t.Errorf("Unexpected export:\n%s", buf.String())
+	}
+
+	// Without escaping the text is exported as stored, so the file uploads unchanged
+	buf.Reset()
+	if err := uc.ExportCSV(context.Background(), schemas.TransactionFilters{}, sort, false, &buf); err != nil {
+		t.Fatalf("ExportCSV failed: %v", err)
+	}
+
+	expected = "timestamp,name,type,amount,status,description\n" +
+		"1000,\"=HYPERLINK(\"\"http://x\"\")\",DEBIT,1.00,SUCCESS,@SUM(A1)\n" +
+		"2000,+62 SHOP,DEBIT,1.00,SUCCESS,-refund a=b\n"
+	if buf.String() != expected {
+		t.Errorf("Unexpected unescaped export:\n%s", buf.String())
+	}
+}
+
+// TestExportCSVCancelled tests that an export stops once its context is cancelled
+func TestExportCSVCancelled(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+
+	db.Create(&[]schemas.Transaction{
+		{ID: "1", Timestamp: 1000, Name: "SHOP", Type: schemas.TypeDebit, Amount: 100, Status: schemas.StatusSuccess},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := uc.ExportCSV(ctx, schemas.TransactionFilters{}, schemas.TransactionSort{}, false, io.Discard); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+// TestCheckExport tests that an XLSX export of more rows than a sheet holds is refused before it starts
+func TestCheckExport(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	ctx := context.Background()
+
+	// One more transaction than fits below the header row, inserted in one statement
+	err := db.Exec(`INSERT INTO transactions (id, timestamp, name, type, amount, status)
+		WITH RECURSIVE n(i) AS (SELECT 1 UNION ALL SELECT i + 1 FROM n WHERE i < ?)
+		SELECT i, i, 'SHOP', 'DEBIT', 100, 'SUCCESS' FROM n`, xlsx.MaxRows).Error
+	if err != nil {
+		t.Fatalf("failed to insert test data: %v", err)
+	}
+
+	if err := uc.CheckExport(ctx, schemas.ExportFormatXLSX, schemas.TransactionFilters{}); !errors.Is(err, schemas.ErrExportTooLarge) {
+		t.Errorf("Expected ErrExportTooLarge, got %v", err)
+	}
+	if err := uc.CheckExport(ctx, schemas.ExportFormatCSV, schemas.TransactionFilters{}); err != nil {
+		t.Errorf("Expected a CSV export of any size to be allowed, got %v", err)
+	}
+
+	// Filters narrow the rows counted
+	if err := uc.CheckExport(ctx, schemas.ExportFormatXLSX, schemas.TransactionFilters{SearchQuery: "missing"}); err != nil {
+		t.Errorf("Expected a narrowed XLSX export to be allowed, got %v", err)
+	}
+}
+
+// TestExportXLSX tests the transactions, issues and summary sheets of the workbook export
+func TestExportXLSX(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	ctx := context.Background()
+
+	db.Create(&[]schemas.Transaction{
+		{ID: "1", Timestamp: 86400, Name: "JOHN DOE", Type: schemas.TypeDebit, Amount: 250050, Status: schemas.StatusSuccess},
+		{ID: "2", Timestamp: 2000, Name: "SHOP", Type: schemas.TypeDebit, Amount: 1000, Status: schemas.StatusFailed},
+		{ID: "3", Timestamp: 3000, Name: "SALARY", Type: schemas.TypeCredit, Amount: 500000, Status: schemas.StatusSuccess},
+	})
+	db.Create(&[]schemas.TransactionFlag{{TransactionID: "2", Reason: schemas.FlagOddHours}, {TransactionID: "3", Reason: schemas.FlagLargeDebit}})
+
+	var buf bytes.Buffer
+	if err := uc.ExportXLSX(ctx, schemas.TransactionFilters{}, schemas.TransactionSort{By: "timestamp", Order: "ASC"}, &buf); err != nil {
+		t.Fatalf("ExportXLSX failed: %v", err)
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("export is not a workbook: %v", err)
+	}
+	sheet := func(name string) string {
+		file, err := reader.Open(name)
+		if err != nil {
+			t.Fatalf("missing sheet %s: %v", name, err)
+		}
+		defer file.Close()
+		content, _ := io.ReadAll(file)
+		return string(content)
+	}
+
+	transactions := sheet("xl/worksheets/sheet1.xml")
+	if strings.Count(transactions, "<row ") != 4 || !strings.Contains(transactions, `<c r="A4" s="3"><v>25570</v></c><c r="B4" t="inlineStr"><is><t xml:space="preserve">JOHN DOE</t></is></c>`) {
+		t.Errorf("Unexpected transactions sheet:\n%s", transactions)
+	}
+	if !strings.Contains(transactions, `<c r="D4" s="2"><v>2500.5</v></c>`) {
+		t.Errorf("Expected amount in upload units:\n%s", transactions)
+	}
+
+	issues := sheet("xl/worksheets/sheet2.xml")
+	if strings.Count(issues, "<row ") != 2 || !strings.Contains(issues, string(schemas.FlagOddHours)) || strings.Contains(issues, string(schemas.FlagLargeDebit)) {
+		t.Errorf("Expected only the failed transaction, with its flag, as issues:\n%s", issues)
+	}
+
+	summary := sheet("xl/worksheets/sheet3.xml")
+	for _, expected := range []string{
+		`<c r="A4" t="inlineStr"><is><t xml:space="preserve">balance</t></is></c><c r="B4" s="2"><v>2499.5</v></c>`,
+		`<c r="A9" t="inlineStr"><is><t xml:space="preserve">SUCCESS</t></is></c><c r="B9"><v>2</v></c><c r="C9" s="2"><v>7500.5</v></c>`,
+		`<c r="A15" t="inlineStr"><is><t xml:space="preserve">CREDIT</t></is></c><c r="B15"><v>1</v></c><c r="C15" s="2"><v>5000</v></c>`,
+	} {
+		if !strings.Contains(summary, expected) {
+			t.Errorf("Expected %s in summary:\n%s", expected, summary)
+		}
+	}
+}
+
+// TestDeleteTransactionSoftDeletes tests that deleted transactions are kept with DeletedAt set
+func TestDeleteTransactionSoftDeletes(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	ctx := context.Background()
+
+	if err := db.Create(&schemas.Transaction{ID: "1", Status: schemas.StatusSuccess}).Error; err != nil {
+		t.Fatalf("failed to insert test data: %v", err)
+	}
+
+	if err := uc.DeleteTransaction(ctx, "1"); err != nil {
+		t.Fatalf("DeleteTransaction failed: %v", err)
+	}
+
+	if _, err := uc.GetTransaction(ctx, "1"); !errors.Is(err, schemas.ErrTransactionNotFound) {
+		t.Errorf("Expected not found after delete, got %v", err)
+	}
+
+	var count int64
+	db.Unscoped().Model(&schemas.Transaction{}).Where("id = ? AND deleted_at IS NOT NULL", "1").Count(&count)
+	if count != 1 {
+		t.Errorf("Expected soft-deleted row to remain, got %d", count)
+	}
+
+	if err := uc.DeleteTransaction(ctx, "1"); !errors.Is(err, schemas.ErrTransactionNotFound) {
+		t.Errorf("Expected not found on second delete, got %v", err)
+	}
+}
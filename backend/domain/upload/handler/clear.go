@@ -9,14 +9,14 @@ import (
 	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
 )
 
-// Clear deletes all transactions
+// Clear soft-deletes all transactions; they can be restored within the retention window
 func (h *Handler) Clear(c *fiber.Ctx) error {
 	l := h.Logger.With(
 		logger.String("context", ContextName),
 		logger.String("method", "Clear"),
 	)
 
-	err := h.UseCase.Clear(c.Context())
+	response, err := h.UseCase.Clear(c.Context())
 	if err != nil {
 		l.Error("Failed to clear transactions", logger.Error(err))
 		return c.Status(http.StatusInternalServerError).JSON(schemas.ErrorResponse{
@@ -26,13 +26,38 @@ func (h *Handler) Clear(c *fiber.Ctx) error {
 		})
 	}
 
-	l.Info("All transactions deleted")
+	l.Info("All transactions cleared",
+		logger.String("clear_id", response.Operation.ID),
+		logger.String("cleared_by", response.Operation.ClearedBy),
+		logger.Int64("affected_rows", response.Operation.AffectedRows),
+	)
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
+
+// GetClearHistory returns the most recent clear operations
+func (h *Handler) GetClearHistory(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "GetClearHistory"),
+	)
+
+	operations, err := h.UseCase.GetClearHistory(c.Context())
+	if err != nil {
+		l.Error("Failed to retrieve clear history", logger.Error(err))
+		return c.Status(http.StatusInternalServerError).JSON(schemas.ErrorResponse{
+			Status:  http.StatusInternalServerError,
+			Message: constants.MsgFailedToRetrieveClears,
+			Error:   err.Error(),
+		})
+	}
 
 	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
 		Status: http.StatusOK,
-		Data: fiber.Map{
-			"message": constants.MsgAllTransactionsDeleted,
-		},
+		Data:   operations,
 	})
 }
 
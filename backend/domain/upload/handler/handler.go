@@ -1,9 +1,14 @@
 package handler
 
 import (
-	transactionRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/repository"
-	uploadRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/upload/repository"
+	"context"
+	"time"
+
+	resumableUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/resumable/use_case"
 	uploadUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/upload/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/wiring"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/archive"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/config"
 	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/deps"
 	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
 	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
@@ -11,28 +16,33 @@ import (
 
 const ContextName = "Domain.Upload.Handler"
 
+// sessionCleanupInterval is how often expired resumable upload sessions are removed
+const sessionCleanupInterval = time.Hour
+
 // Handler defines the upload handlers
 type Handler struct {
 	Logger         *logger.Logger
 	UseCase        uploadUseCase.IUseCase
+	Sessions       resumableUseCase.IUseCase
 	CSVValidator   *validator.CSVValidator
 	FieldValidator *validator.FieldValidator
+	ArchiveLimits  archive.Limits
 }
 
 // NewHandler creates a new upload handler instance with all dependencies
 func NewHandler(d *deps.App) *Handler {
-	// Initialize repositories
-	uploadRepository := uploadRepo.NewRepository()
-	transactionRepository := transactionRepo.NewRepository(d.DB.GetDB())
-	
-	// Initialize use case
-	useCase := uploadUseCase.NewUseCase(uploadRepository, transactionRepository)
-	
+	cfg := config.GetConfig()
 	return &Handler{
 		Logger:         d.Logger,
-		UseCase:        useCase,
+		UseCase:        wiring.Uploads(d),
+		Sessions:       wiring.Sessions(d),
 		CSVValidator:   validator.NewCSVValidator(),
-		FieldValidator: validator.NewFieldValidator(),
+		FieldValidator: d.FieldValidator,
+		ArchiveLimits: archive.Limits{
+			MaxFiles:     cfg.UploadArchiveMaxFiles,
+			MaxFileSize:  cfg.UploadArchiveMaxFileBytes,
+			MaxTotalSize: cfg.UploadArchiveMaxBytes,
+		},
 	}
 }
 
@@ -43,7 +53,53 @@ func RegisterApi(d *deps.App) *Handler {
 	api := d.Fiber.Group("/api")
 	
 	api.Post("/upload", handler.Upload)
+	api.Post("/uploads", handler.CreateUploadSession)
+	api.Get("/uploads/:id", handler.GetUploadSession)
+	api.Patch("/uploads/:id", handler.UploadChunk)
+	api.Delete("/uploads/:id", handler.DeleteUploadSession)
 	api.Delete("/clear", handler.Clear)
+	api.Get("/clears", handler.GetClearHistory)
+	api.Get("/batches", handler.GetBatches)
+	api.Post("/transactions/restore", handler.Restore)
+	api.Post("/transactions/purge", handler.Purge)
+	
+	d.Go(func(ctx context.Context) {
+		handler.cleanupSessions(ctx, d.Logger)
+	})
+	if cfg := config.GetConfig(); cfg.InboxDir != "" {
+		d.Go(func(ctx context.Context) {
+			handler.watchInbox(ctx, d.Logger, cfg)
+		})
+	}
 	
 	return handler
 }
+
+// cleanupSessions removes expired resumable upload sessions and their staged chunks, at startup and then periodically
+// It returns when ctx is cancelled
+func (h *Handler) cleanupSessions(ctx context.Context, log *logger.Logger) {
+	l := log.With(
+		logger.String("context", ContextName),
+		logger.String("method", "cleanupSessions"),
+	)
+
+	ticker := time.NewTicker(sessionCleanupInterval)
+	defer ticker.Stop()
+	for {
+		removed, err := h.Sessions.CleanupExpired(ctx)
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+			l.Error("Failed to clean up upload sessions", logger.Error(err))
+		case removed > 0:
+			l.Info("Expired upload sessions removed", logger.Int("removed", removed))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
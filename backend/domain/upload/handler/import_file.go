@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+)
+
+// ImportFile parses and stores a local file, checking it like a multipart upload without a content type
+// opts gives the source, import profile and parse options; the filename and format are taken from filename.
+// It returns the upload result, or an archive upload result for a zip archive
+func (h *Handler) ImportFile(ctx context.Context, filename string, src *os.File, size int64, opts schemas.UploadOptions) (interface{}, error) {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "ImportFile"),
+	)
+	return h.importFile(ctx, l, filename, src, size, opts)
+}
+
+// importFile checks and stores a local file for ImportFile and the inbox
+func (h *Handler) importFile(ctx context.Context, l *logger.Logger, filename string, src *os.File, size int64, opts schemas.UploadOptions) (interface{}, error) {
+	if err := h.CSVValidator.ValidateFileName(filename); err != nil {
+		return nil, fmt.Errorf("%s: %w", constants.MsgInvalidFilename, err)
+	}
+
+	format, err := h.CSVValidator.DetectFormat(filename, "")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", constants.MsgInvalidFileType, err)
+	}
+
+	if err := h.CSVValidator.ValidateFileSize(size); err != nil {
+		return nil, fmt.Errorf("%s: %w", constants.MsgInvalidFile, err)
+	}
+
+	opts.Filename = filename
+	opts.Format = format
+	return h.storeUpload(ctx, l, src, size, opts)
+}
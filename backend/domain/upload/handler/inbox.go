@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"context"
+	"os"
+	"time"
+
+	inboxRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/inbox/repository"
+	inboxUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/inbox/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/config"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+)
+
+// defaultInboxPollInterval is used when INBOX_POLL_SECONDS is not positive
+const defaultInboxPollInterval = 10 * time.Second
+
+// watchInbox ingests files dropped in the inbox directory like uploads, moving each to processed/ or failed/ with its result
+// It polls the directory, which also works on network and SFTP mounts where file change notifications are unreliable
+// It returns when ctx is cancelled, after finishing the poll in progress so no file is left half stored
+func (h *Handler) watchInbox(ctx context.Context, log *logger.Logger, cfg *config.GlobalConfig) {
+	l := log.With(
+		logger.String("context", ContextName),
+		logger.String("method", "watchInbox"),
+	)
+
+	interval := time.Duration(cfg.InboxPollSeconds) * time.Second
+	if interval <= 0 {
+		l.Warn("Invalid inbox poll interval, using default", logger.Int("poll_seconds", cfg.InboxPollSeconds))
+		interval = defaultInboxPollInterval
+	}
+
+	inbox := inboxUseCase.NewUseCase(inboxRepo.NewRepository(cfg.InboxDir), h.inboxStore(l, cfg.InboxSource, cfg.InboxProfile))
+	results, err := inbox.Recover(context.WithoutCancel(ctx))
+	logInboxResults(l, results)
+	if err != nil {
+		l.Error("Failed to prepare inbox directory, inbox disabled", logger.Error(err), logger.String("dir", cfg.InboxDir))
+		return
+	}
+
+	l.Info("Watching inbox directory", logger.String("dir", cfg.InboxDir), logger.Int64("poll_seconds", int64(interval/time.Second)))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		results, err := inbox.Poll(context.WithoutCancel(ctx))
+		logInboxResults(l, results)
+		if err != nil {
+			l.Error("Failed to poll inbox directory", logger.Error(err), logger.String("dir", cfg.InboxDir))
+		}
+	}
+}
+
+// inboxStore returns the function the inbox stores files with, labelling each with the configured source and import profile
+func (h *Handler) inboxStore(l *logger.Logger, source, profile string) inboxUseCase.StoreFunc {
+	return func(ctx context.Context, filename string, src *os.File, size int64) (interface{}, error) {
+		return h.importFile(ctx, l, filename, src, size, schemas.UploadOptions{Source: source, Profile: profile})
+	}
+}
+
+// logInboxResults logs the outcome of each ingested file
+func logInboxResults(l *logger.Logger, results []schemas.InboxResult) {
+	for _, result := range results {
+		if result.Status == schemas.InboxStatusFailed {
+			l.Warn("Inbox file failed", logger.String("filename", result.Filename), logger.String("error", result.Error))
+			continue
+		}
+		l.Info("Inbox file processed", logger.String("filename", result.Filename))
+	}
+}
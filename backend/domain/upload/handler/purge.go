@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Purge permanently deletes all soft-deleted transactions
+// The request body must contain {"confirm": "PURGE"}
+func (h *Handler) Purge(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "Purge"),
+	)
+
+	var req schemas.PurgeRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.Warn("Invalid request body", logger.Error(err))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgPurgeNotConfirmed,
+			Error:   err.Error(),
+		})
+	}
+
+	response, err := h.UseCase.Purge(c.Context(), req.Confirm)
+	if err != nil {
+		if errors.Is(err, schemas.ErrPurgeNotConfirmed) {
+			l.Warn("Purge not confirmed")
+			return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+				Status:  http.StatusBadRequest,
+				Message: constants.MsgPurgeNotConfirmed,
+				Error:   err.Error(),
+			})
+		}
+
+		l.Error("Failed to purge transactions", logger.Error(err))
+		return c.Status(http.StatusInternalServerError).JSON(schemas.ErrorResponse{
+			Status:  http.StatusInternalServerError,
+			Message: constants.MsgFailedToPurge,
+			Error:   err.Error(),
+		})
+	}
+
+	l.Info("Deleted transactions purged", logger.Int64("purged_count", response.PurgedCount))
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Restore undoes a clear within the retention window
+func (h *Handler) Restore(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "Restore"),
+	)
+
+	// Body is optional; without clear_id the latest clear is restored
+	var req schemas.RestoreRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			l.Warn("Invalid request body", logger.Error(err))
+			return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+				Status:  http.StatusBadRequest,
+				Message: constants.MsgInvalidRequestBody,
+				Error:   err.Error(),
+			})
+		}
+	}
+
+	response, err := h.UseCase.Restore(c.Context(), req.ClearID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		message := constants.MsgFailedToRestore
+		switch {
+		case errors.Is(err, schemas.ErrNothingToRestore):
+			status = http.StatusNotFound
+			message = constants.MsgNothingToRestore
+		case errors.Is(err, schemas.ErrRestoreWindowExpired):
+			status = http.StatusConflict
+			message = constants.MsgRestoreWindowExpired
+		}
+
+		l.Warn("Failed to restore transactions", logger.Error(err))
+		return c.Status(status).JSON(schemas.ErrorResponse{
+			Status:  status,
+			Message: message,
+			Error:   err.Error(),
+		})
+	}
+
+	l.Info("Cleared transactions restored",
+		logger.String("clear_id", response.Operation.ID),
+		logger.Int64("restored_count", response.RestoredCount),
+	)
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
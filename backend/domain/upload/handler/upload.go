@@ -1,21 +1,34 @@
 package handler
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime/multipart"
 	"net/http"
 
 	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
 	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
 	"github.com/gofiber/fiber/v2"
 	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
 )
 
-// Upload handles CSV file uploads
+// Upload handles transaction file and bank statement uploads
+// The format is taken from the file extension, or from the content type of the file part when the extension is unknown.
+// A .gz file is decompressed and stored like the file inside it, and each file of a .zip archive is stored as its own batch.
+// A JSON array or NDJSON document may also be sent as the request body, with its content type
 func (h *Handler) Upload(c *fiber.Ctx) error {
 	l := h.Logger.With(
 		logger.String("context", ContextName),
 		logger.String("method", "Upload"),
 	)
 
+	if format, ok := h.CSVValidator.DetectBodyFormat(c.Get(fiber.HeaderContentType)); ok {
+		return h.uploadBody(c, l, format)
+	}
+
 	// Parse multipart form
 	file, err := c.FormFile("file")
 	if err != nil {
@@ -37,8 +50,9 @@ func (h *Handler) Upload(c *fiber.Ctx) error {
 		})
 	}
 
-	// Validate file extension
-	if err := h.CSVValidator.ValidateFileExtension(file.Filename); err != nil {
+	// Detect file format from extension or content type
+	format, err := h.CSVValidator.DetectFormat(file.Filename, file.Header.Get(fiber.HeaderContentType))
+	if err != nil {
 		l.Warn("Invalid file type", logger.Error(err), logger.String("filename", file.Filename))
 		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
 			Status:  http.StatusBadRequest,
@@ -69,27 +83,165 @@ func (h *Handler) Upload(c *fiber.Ctx) error {
 	}
 	defer src.Close()
 
-	// Parse and store CSV with field validation
-	response, err := h.UseCase.ParseAndStoreWithValidation(c.Context(), src, h.FieldValidator)
-	if err != nil {
-		l.Error("Failed to process CSV", logger.Error(err))
+	opts := schemas.UploadOptions{
+		Source:   c.FormValue("source"),
+		Filename: file.Filename,
+		Format:   format,
+		Profile:  c.FormValue("profile"),
+		ParseOptions: schemas.ParseOptions{
+			Encoding:         c.FormValue("encoding"),
+			Delimiter:        c.FormValue("delimiter"),
+			DecimalSeparator: c.FormValue("decimal_separator"),
+		},
+	}
+
+	return h.storeFile(c, l, src, file.Size, opts)
+}
+
+// uploadBody stores a JSON array or NDJSON document sent as the request body
+// There are no form fields, so the filename, source, import profile and parse options are read from the query string
+func (h *Handler) uploadBody(c *fiber.Ctx, l *logger.Logger, format string) error {
+	filename := c.Query("filename")
+	if filename != "" {
+		if err := h.CSVValidator.ValidateFileName(filename); err != nil {
+			l.Warn("Invalid filename", logger.Error(err), logger.String("filename", filename))
+			return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+				Status:  http.StatusBadRequest,
+				Message: constants.MsgInvalidFilename,
+				Error:   err.Error(),
+			})
+		}
+	}
+
+	body := c.Body()
+	if err := h.CSVValidator.ValidateFileSize(int64(len(body))); err != nil {
+		l.Warn("Invalid request body", logger.Error(err), logger.Int("size", len(body)))
 		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
 			Status:  http.StatusBadRequest,
-			Message: constants.MsgUploadFailed,
+			Message: constants.MsgInvalidFile,
 			Error:   err.Error(),
 		})
 	}
 
-	l.Info("CSV uploaded successfully",
+	opts := schemas.UploadOptions{
+		Source:   c.Query("source"),
+		Filename: filename,
+		Format:   format,
+		Profile:  c.Query("profile"),
+		ParseOptions: schemas.ParseOptions{
+			Encoding:         c.Query("encoding"),
+			DecimalSeparator: c.Query("decimal_separator"),
+		},
+	}
+
+	return h.storeFile(c, l, bodyFile{bytes.NewReader(body)}, int64(len(body)), opts)
+}
+
+// bodyFile reads a request body as an uploaded file
+type bodyFile struct {
+	*bytes.Reader
+}
+
+// Close does nothing; the body is released with the request
+func (bodyFile) Close() error {
+	return nil
+}
+
+// storeFile parses and stores an uploaded file and responds with the result, for multipart and completed resumable uploads alike
+func (h *Handler) storeFile(c *fiber.Ctx, l *logger.Logger, src multipart.File, size int64, opts schemas.UploadOptions) error {
+	response, err := h.storeUpload(c.Context(), l, src, size, opts)
+	if err != nil {
+		errResp := uploadErrorResponse(err)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
+
+// storeUpload parses and stores a file and returns the upload result, an archive upload result for a zip archive
+// A zip archive is stored file by file and a gzip file as the file inside it
+func (h *Handler) storeUpload(ctx context.Context, l *logger.Logger, src multipart.File, size int64, opts schemas.UploadOptions) (interface{}, error) {
+	// A zip archive is stored file by file
+	if opts.Format == validator.FormatZIP {
+		return h.uploadArchive(ctx, l, src, size, opts)
+	}
+
+	// A gzip file holds a single file, read within the decompressed size limits
+	var reader io.Reader = src
+	if opts.Format == validator.FormatGzip {
+		decompressed, err := h.gunzip(src, opts.Filename, &opts)
+		if err != nil {
+			l.Warn("Invalid archive", logger.Error(err), logger.String("filename", opts.Filename))
+			return nil, archiveError{err}
+		}
+		defer decompressed.Close()
+		reader = decompressed
+	}
+
+	// Parse and store file with field validation
+	response, err := h.UseCase.ParseAndStoreWithValidation(ctx, reader, h.FieldValidator, opts)
+	if err != nil {
+		l.Error("Failed to process file", logger.Error(err), logger.String("format", opts.Format))
+		return nil, err
+	}
+
+	if response.BalanceMismatch {
+		l.Warn("Statement balance mismatch",
+			logger.String("batch_id", response.BatchID),
+			logger.String("filename", opts.Filename),
+		)
+	}
+
+	l.Info("File uploaded successfully",
+		logger.String("batch_id", response.BatchID),
+		logger.String("format", opts.Format),
 		logger.Int("total_records", response.TotalRecords),
 		logger.Int("success_records", response.SuccessRecords),
 		logger.Int("failed_records", response.FailedRecords),
 		logger.Int("pending_records", response.PendingRecords),
 	)
 
-	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
-		Status: http.StatusOK,
-		Data:   response,
-	})
+	return response, nil
+}
+
+// archiveError marks an archive that could not be read, as opposed to a file in it that could not be stored
+type archiveError struct {
+	err error
 }
 
+func (e archiveError) Error() string {
+	return e.err.Error()
+}
+
+func (e archiveError) Unwrap() error {
+	return e.err
+}
+
+// uploadErrorResponse maps upload errors to an error response
+// Files, archives and options the client can fix are a 400; anything else failed on our side and is a 500
+func uploadErrorResponse(err error) schemas.ErrorResponse {
+	status := http.StatusBadRequest
+	message := constants.MsgUploadFailed
+
+	switch {
+	case errors.As(err, new(archiveError)):
+		message = constants.MsgInvalidArchive
+	case errors.Is(err, schemas.ErrImportProfileNotFound):
+		status, message = http.StatusNotFound, constants.MsgImportProfileNotFound
+	case errors.Is(err, schemas.ErrInvalidParseOptions):
+		message = constants.MsgInvalidParseOptions
+	case errors.Is(err, schemas.ErrInvalidUploadFile), errors.Is(err, schemas.ErrInvalidSource):
+		message = constants.MsgInvalidFile
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	return schemas.ErrorResponse{
+		Status:  status,
+		Message: message,
+		Error:   err.Error(),
+	}
+}
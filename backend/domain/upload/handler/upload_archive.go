@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime/multipart"
+	"path"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/archive"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+)
+
+// uploadArchive stores each file of an uploaded zip archive as its own upload batch and returns a result per file
+// Files of an unsupported type, or archives nested in the archive, fail on their own without stopping the others
+func (h *Handler) uploadArchive(ctx context.Context, l *logger.Logger, src multipart.File, size int64, opts schemas.UploadOptions) (*schemas.ArchiveUploadResponse, error) {
+	files, err := archive.Zip(src, size, h.ArchiveLimits)
+	if err == nil && len(files) == 0 {
+		err = errors.New(constants.MsgEmptyArchive)
+	}
+	if err != nil {
+		l.Warn("Invalid archive", logger.Error(err), logger.String("filename", opts.Filename))
+		return nil, archiveError{err}
+	}
+
+	uploads := make([]schemas.UploadFile, len(files))
+	for i, file := range files {
+		format, err := h.CSVValidator.DetectFormat(path.Base(file.Name), "")
+		if err == nil && validator.IsArchiveFormat(format) {
+			format, err = "", errors.New(constants.MsgNestedArchive)
+		}
+		uploads[i] = schemas.UploadFile{
+			Filename: file.Name,
+			Format:   format,
+			Open:     file.Open,
+			Err:      err,
+		}
+	}
+
+	response, err := h.UseCase.ParseAndStoreArchive(ctx, uploads, h.FieldValidator, opts)
+	if err != nil {
+		l.Error("Failed to process archive", logger.Error(err), logger.String("filename", opts.Filename))
+		return nil, err
+	}
+
+	l.Info("Archive uploaded",
+		logger.String("filename", opts.Filename),
+		logger.Int("total_files", response.TotalFiles),
+		logger.Int("stored_files", response.StoredFiles),
+		logger.Int("skipped_files", response.SkippedFiles),
+		logger.Int("failed_files", response.FailedFiles),
+		logger.Int("total_records", response.TotalRecords),
+	)
+
+	return response, nil
+}
+
+// gunzip decompresses an uploaded gzip file and sets the upload format to that of the file inside it
+func (h *Handler) gunzip(src io.Reader, filename string, opts *schemas.UploadOptions) (io.ReadCloser, error) {
+	reader, storedName, err := archive.Gunzip(src, h.ArchiveLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	format, err := h.CSVValidator.DetectCompressedFormat(filename, storedName)
+	if err != nil {
+		reader.Close()
+		return nil, err
+	}
+	opts.Format = format
+	return reader, nil
+}
@@ -0,0 +1,300 @@
+package handler
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// Headers of the resumable upload protocol, named after their tus counterparts
+const (
+	headerUploadOffset   = "Upload-Offset"
+	headerUploadLength   = "Upload-Length"
+	headerUploadChecksum = "Upload-Checksum"
+)
+
+// CreateUploadSession starts a resumable upload of a file sent in chunks with UploadChunk
+// The file name, format and size are checked as for a multipart upload before any chunk is sent
+func (h *Handler) CreateUploadSession(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "CreateUploadSession"),
+	)
+
+	var req schemas.UploadSessionRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.Warn("Invalid request body", logger.Error(err))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidUploadSession,
+			Error:   err.Error(),
+		})
+	}
+
+	if err := h.CSVValidator.ValidateFileName(req.Filename); err != nil {
+		l.Warn("Invalid filename", logger.Error(err), logger.String("filename", req.Filename))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidFilename,
+			Error:   err.Error(),
+		})
+	}
+
+	format, err := h.CSVValidator.DetectFormat(req.Filename, "")
+	if err != nil {
+		l.Warn("Invalid file type", logger.Error(err), logger.String("filename", req.Filename))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidFileType,
+			Error:   err.Error(),
+		})
+	}
+
+	if err := h.CSVValidator.ValidateFileSize(req.Size); err != nil {
+		l.Warn("Invalid file", logger.Error(err), logger.Int64("size", req.Size))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidFile,
+			Error:   err.Error(),
+		})
+	}
+
+	session, err := h.Sessions.CreateSession(c.Context(), req, format)
+	if err != nil {
+		l.Warn("Failed to create upload session", logger.Error(err))
+		errResp := sessionErrorResponse(err, constants.MsgFailedToCreateUploadSession)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Upload session created",
+		logger.String("id", session.ID),
+		logger.String("filename", session.Filename),
+		logger.Int64("size", session.Size),
+	)
+
+	c.Set(fiber.HeaderLocation, c.Path()+"/"+session.ID)
+	setUploadHeaders(c, session)
+	return c.Status(http.StatusCreated).JSON(schemas.SuccessResponse{
+		Status: http.StatusCreated,
+		Data:   session,
+	})
+}
+
+// GetUploadSession returns an upload session, with the bytes received so far also in the Upload-Offset header
+// Clients resuming an interrupted upload send the next chunk from that offset; HEAD returns the headers alone
+func (h *Handler) GetUploadSession(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "GetUploadSession"),
+	)
+
+	id, err := sessionID(c)
+	if err != nil {
+		l.Warn("Invalid upload session ID", logger.Error(err), logger.String("id", c.Params("id")))
+		errResp := sessionErrorResponse(err, constants.MsgFailedToRetrieveUploadSession)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	session, err := h.Sessions.GetSession(c.Context(), id)
+	if err != nil {
+		l.Warn("Failed to retrieve upload session", logger.Error(err), logger.String("id", id))
+		errResp := sessionErrorResponse(err, constants.MsgFailedToRetrieveUploadSession)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	setUploadHeaders(c, session)
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   session,
+	})
+}
+
+// UploadChunk appends the request body to an upload session at the offset given in the Upload-Offset header
+// An optional "Upload-Checksum: sha256 <base64 digest>" header is checked against the chunk.
+// The chunk that completes the file stores it like a multipart upload and responds with the upload result, which is also
+// kept on the session. When storing fails, an empty chunk at the final offset retries it without sending the file again
+func (h *Handler) UploadChunk(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "UploadChunk"),
+	)
+	id, err := sessionID(c)
+	if err != nil {
+		l.Warn("Invalid upload session ID", logger.Error(err), logger.String("id", c.Params("id")))
+		errResp := sessionErrorResponse(err, constants.MsgFailedToWriteChunk)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	offset, err := strconv.ParseInt(c.Get(headerUploadOffset), 10, 64)
+	if err != nil || offset < 0 {
+		l.Warn("Invalid upload offset", logger.String("id", id), logger.String("offset", c.Get(headerUploadOffset)))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidUploadOffset,
+			Error:   constants.MsgInvalidUploadOffset,
+		})
+	}
+
+	checksum, err := parseChunkChecksum(c.Get(headerUploadChecksum))
+	if err != nil {
+		l.Warn("Invalid upload checksum", logger.Error(err), logger.String("id", id))
+		return c.Status(http.StatusBadRequest).JSON(schemas.ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Message: constants.MsgInvalidUploadChecksum,
+			Error:   err.Error(),
+		})
+	}
+
+	session, err := h.Sessions.AppendChunk(c.Context(), id, offset, bytes.NewReader(c.Body()), checksum)
+	if session != nil {
+		setUploadHeaders(c, session)
+	}
+	retry := errors.Is(err, schemas.ErrUploadComplete) && len(c.Body()) == 0
+	if err != nil && !retry {
+		l.Warn("Failed to write upload chunk", logger.Error(err), logger.String("id", id), logger.Int64("offset", offset))
+		errResp := sessionErrorResponse(err, constants.MsgFailedToWriteChunk)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	if !session.Complete() {
+		return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+			Status: http.StatusOK,
+			Data:   session,
+		})
+	}
+
+	// The whole file has arrived: verify it and store it, once
+	file, session, err := h.Sessions.OpenCompleted(c.Context(), id)
+	if err != nil {
+		l.Warn("Failed to open completed upload", logger.Error(err), logger.String("id", id))
+		errResp := sessionErrorResponse(err, constants.MsgFailedToOpenFile)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Resumable upload completed", logger.String("id", id), logger.String("filename", session.Filename))
+	response, err := h.storeUpload(c.Context(), l, file, session.Size, session.UploadOptions())
+	file.Close()
+	if err != nil {
+		// Keep the staged file, so the client can retry
+		if releaseErr := h.Sessions.ReleaseSession(c.Context(), id); releaseErr != nil {
+			l.Warn("Failed to release upload session", logger.Error(releaseErr), logger.String("id", id))
+		}
+		errResp := uploadErrorResponse(err)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	if _, err := h.Sessions.CompleteSession(c.Context(), id, response); err != nil {
+		l.Warn("Failed to keep upload result", logger.Error(err), logger.String("id", id))
+	}
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
+
+// DeleteUploadSession cancels an upload session and removes the chunks received so far
+func (h *Handler) DeleteUploadSession(c *fiber.Ctx) error {
+	l := h.Logger.With(
+		logger.String("context", ContextName),
+		logger.String("method", "DeleteUploadSession"),
+	)
+
+	id, err := sessionID(c)
+	if err != nil {
+		l.Warn("Invalid upload session ID", logger.Error(err), logger.String("id", c.Params("id")))
+		errResp := sessionErrorResponse(err, constants.MsgFailedToDeleteUploadSession)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	if err := h.Sessions.DeleteSession(c.Context(), id); err != nil {
+		l.Warn("Failed to cancel upload session", logger.Error(err), logger.String("id", id))
+		errResp := sessionErrorResponse(err, constants.MsgFailedToDeleteUploadSession)
+		return c.Status(errResp.Status).JSON(errResp)
+	}
+
+	l.Info("Upload session cancelled", logger.String("id", id))
+
+	return c.Status(http.StatusOK).JSON(schemas.SuccessResponse{
+		Status: http.StatusOK,
+		Data:   constants.MsgUploadSessionDeleted,
+	})
+}
+
+// sessionID returns the upload session ID in the path, which must be a UUID as CreateSession issues them
+// Anything else is rejected before it reaches the session store or the staging directory
+func sessionID(c *fiber.Ctx) (string, error) {
+	id := c.Params("id")
+	if parsed, err := uuid.Parse(id); err != nil || parsed.String() != id {
+		return "", fmt.Errorf("%w: id must be a UUID", schemas.ErrInvalidUploadSession)
+	}
+	return id, nil
+}
+
+// setUploadHeaders reports the progress of an upload session in the resumable upload headers
+func setUploadHeaders(c *fiber.Ctx, session *schemas.UploadSession) {
+	c.Set(headerUploadOffset, strconv.FormatInt(session.Offset, 10))
+	c.Set(headerUploadLength, strconv.FormatInt(session.Size, 10))
+}
+
+// parseChunkChecksum reads an Upload-Checksum header of the form "sha256 <base64 digest>"
+// An empty header means the chunk is not checked and returns a nil digest
+func parseChunkChecksum(header string) ([]byte, error) {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return nil, nil
+	}
+
+	algorithm, encoded, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(algorithm, "sha256") {
+		return nil, errors.New("only sha256 checksums are supported")
+	}
+	digest, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, err
+	}
+	if len(digest) != sha256.Size {
+		return nil, errors.New("sha256 digest must be 32 bytes")
+	}
+	return digest, nil
+}
+
+// sessionErrorResponse maps resumable upload errors to an error response, falling back to a 500 with the given message
+func sessionErrorResponse(err error, fallbackMessage string) schemas.ErrorResponse {
+	status := http.StatusInternalServerError
+	message := fallbackMessage
+
+	switch {
+	case errors.Is(err, schemas.ErrUploadSessionNotFound):
+		status, message = http.StatusNotFound, constants.MsgUploadSessionNotFound
+	case errors.Is(err, schemas.ErrUploadSessionExpired):
+		status, message = http.StatusGone, constants.MsgUploadSessionExpired
+	case errors.Is(err, schemas.ErrUploadOffsetMismatch), errors.Is(err, schemas.ErrUploadIncomplete):
+		status, message = http.StatusConflict, constants.MsgUploadOffsetMismatch
+	case errors.Is(err, schemas.ErrUploadComplete), errors.Is(err, schemas.ErrUploadSessionConsumed):
+		status, message = http.StatusConflict, constants.MsgUploadSessionConsumed
+	case errors.Is(err, schemas.ErrUploadChecksumMismatch):
+		status, message = http.StatusBadRequest, constants.MsgUploadChecksumMismatch
+	case errors.Is(err, schemas.ErrInvalidParseOptions):
+		status, message = http.StatusBadRequest, constants.MsgInvalidParseOptions
+	case errors.Is(err, schemas.ErrInvalidUploadSession), errors.Is(err, schemas.ErrInvalidSource):
+		status, message = http.StatusBadRequest, constants.MsgInvalidUploadSession
+	}
+
+	return schemas.ErrorResponse{
+		Status:  status,
+		Message: message,
+		Error:   err.Error(),
+	}
+}
@@ -0,0 +1,322 @@
+package repository
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/textenc"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+)
+
+// camtAmount is an amount element with its currency attribute
+type camtAmount struct {
+	Value    string `xml:",chardata"`
+	Currency string `xml:"Ccy,attr"`
+}
+
+// camtDate is a date element holding either a date or a date and time
+type camtDate struct {
+	Date     string `xml:"Dt"`
+	DateTime string `xml:"DtTm"`
+}
+
+// camtAccount is the account a statement belongs to
+type camtAccount struct {
+	IBAN     string `xml:"Id>IBAN"`
+	Other    string `xml:"Id>Othr>Id"`
+	Currency string `xml:"Ccy"`
+}
+
+// camtBalance is one balance of a statement, such as the opening or closing booked balance
+type camtBalance struct {
+	Code      string     `xml:"Tp>CdOrPrtry>Cd"`
+	Amount    camtAmount `xml:"Amt"`
+	Indicator string     `xml:"CdtDbtInd"`
+}
+
+// camtParty is a debtor or creditor; newer camt versions nest the name in a Pty element
+type camtParty struct {
+	Name      string `xml:"Nm"`
+	PartyName string `xml:"Pty>Nm"`
+}
+
+// camtStatus is the entry status, a plain code before camt.053.001.08 and a Cd element since
+type camtStatus struct {
+	Text string `xml:",chardata"`
+	Code string `xml:"Cd"`
+}
+
+// camtTransaction holds the details of one transaction booked under an entry
+type camtTransaction struct {
+	AccountServicerReference string    `xml:"Refs>AcctSvcrRef"`
+	Debtor                   camtParty `xml:"RltdPties>Dbtr"`
+	Creditor                 camtParty `xml:"RltdPties>Cdtr"`
+	Unstructured             []string  `xml:"RmtInf>Ustrd"`
+	Structured               []string  `xml:"RmtInf>Strd>CdtrRefInf>Ref"`
+}
+
+// camtEntry is one Ntry of a statement
+type camtEntry struct {
+	Reference                string            `xml:"NtryRef"`
+	Amount                   camtAmount        `xml:"Amt"`
+	Indicator                string            `xml:"CdtDbtInd"`
+	Status                   camtStatus        `xml:"Sts"`
+	BookingDate              camtDate          `xml:"BookgDt"`
+	ValueDate                camtDate          `xml:"ValDt"`
+	AccountServicerReference string            `xml:"AcctSvcrRef"`
+	AdditionalInfo           string            `xml:"AddtlNtryInf"`
+	Transactions             []camtTransaction `xml:"NtryDtls>TxDtls"`
+}
+
+// ParseCAMT053WithValidation parses an ISO 20022 camt.053 bank to customer statement with field validation
+// Elements are matched by local name, so every camt.053.001 version is read the same way.
+// Each Ntry becomes a transaction dated by its booking date, with the counterparty as name and the remittance
+// information as description; the account servicer reference, scoped by account, becomes the external ID.
+// The opening (OPBD or PRCD) and closing (CLBD) booked balances of each Stmt are checked against its booked entries.
+// Files declared in Windows-1252, ISO-8859-1 or UTF-16 are converted to UTF-8 while reading
+func (r *Repository) ParseCAMT053WithValidation(ctx context.Context, file io.Reader, fieldValidator *validator.FieldValidator) (*schemas.Statement, error) {
+	decoder := xml.NewDecoder(file)
+	decoder.CharsetReader = textenc.CharsetReader
+
+	var entries []statementEntry
+	var balances []*statementBalances
+	var current *statementBalances
+	var path []string
+	occurrences := make(map[string]int)
+	statementFound := false
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf(constants.MsgStatementInvalidFormat, "camt.053", err.Error())
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			line, _ := decoder.InputPos()
+			name := t.Name.Local
+			inStatement := current != nil && len(path) > 0 && path[len(path)-1] == "Stmt"
+
+			switch {
+			case name == "BkToCstmrStmt":
+				statementFound = true
+			case name == "Stmt" && statementFound:
+				current = &statementBalances{}
+				balances = append(balances, current)
+			case inStatement && name == "Acct":
+				var account camtAccount
+				if err := decoder.DecodeElement(&account, &t); err != nil {
+					return nil, fmt.Errorf(constants.MsgStatementInvalidFormat, "camt.053", fmt.Sprintf("account at line %d: %v", line, err))
+				}
+				current.account = strings.TrimSpace(account.IBAN + account.Other)
+				current.currency = strings.TrimSpace(account.Currency)
+				continue
+			case inStatement && name == "Bal":
+				var balance camtBalance
+				if err := decoder.DecodeElement(&balance, &t); err != nil {
+					return nil, fmt.Errorf(constants.MsgStatementInvalidFormat, "camt.053", fmt.Sprintf("balance at line %d: %v", line, err))
+				}
+				current.applyCAMTBalance(balance)
+				continue
+			case inStatement && name == "Ntry":
+				var raw camtEntry
+				if err := decoder.DecodeElement(&raw, &t); err != nil {
+					return nil, fmt.Errorf(constants.MsgStatementInvalidFormat, "camt.053", fmt.Sprintf("entry at line %d: %v", line, err))
+				}
+				entry, booked, err := camtStatementEntry(raw, current, line, occurrences)
+				if err != nil {
+					return nil, err
+				}
+				if entry == nil {
+					continue // Informational entry, not a transaction
+				}
+				entries = append(entries, *entry)
+				if booked {
+					current.book(*entry)
+				}
+				continue
+			}
+			path = append(path, name)
+
+		case xml.EndElement:
+			if len(path) > 0 {
+				path = path[:len(path)-1]
+			}
+			if t.Name.Local == "Stmt" {
+				current = nil
+			}
+
+		case xml.CharData:
+			// The statement identification is the Id directly under Stmt
+			if current != nil && len(path) >= 2 && path[len(path)-1] == "Id" && path[len(path)-2] == "Stmt" {
+				current.statementID += strings.TrimSpace(string(t))
+			}
+		}
+	}
+
+	if !statementFound {
+		return nil, fmt.Errorf(constants.MsgStatementInvalidFormat, "camt.053", "no <BkToCstmrStmt> element")
+	}
+
+	return collectStatement(entries, balances, fieldValidator)
+}
+
+// applyCAMTBalance records an opening or closing booked balance of the statement
+// A PRCD (previously closed) balance only stands in for a missing OPBD
+func (b *statementBalances) applyCAMTBalance(balance camtBalance) {
+	amount := toCents(balance.Amount.Value)
+	if strings.TrimSpace(balance.Indicator) == "DBIT" {
+		amount = -amount
+	}
+	if b.currency == "" {
+		b.currency = strings.TrimSpace(balance.Amount.Currency)
+	}
+
+	switch strings.TrimSpace(balance.Code) {
+	case "OPBD":
+		b.opening = &amount
+	case "PRCD":
+		if b.opening == nil {
+			b.opening = &amount
+		}
+	case "CLBD":
+		b.closing = &amount
+	}
+}
+
+// camtStatementEntry maps a camt.053 entry to a statement entry and reports whether it is booked
+// Pending and future entries become pending transactions and informational entries are skipped (nil)
+func camtStatementEntry(raw camtEntry, statement *statementBalances, line int, occurrences map[string]int) (*statementEntry, bool, error) {
+	status := strings.TrimSpace(raw.Status.Code)
+	if status == "" {
+		status = strings.TrimSpace(raw.Status.Text)
+	}
+	if status == "INFO" {
+		return nil, false, nil
+	}
+	booked := status == "BOOK" || status == ""
+
+	date := raw.BookingDate
+	if date.Date == "" && date.DateTime == "" {
+		date = raw.ValueDate
+	}
+	timestamp, err := parseCAMTDate(date)
+	if err != nil {
+		return nil, false, fmt.Errorf(constants.MsgStatementInvalidDate, line, err)
+	}
+
+	amount, negative := splitAmount(raw.Amount.Value)
+	debit := strings.TrimSpace(raw.Indicator) == "DBIT"
+
+	var details camtTransaction
+	if len(raw.Transactions) > 0 {
+		details = raw.Transactions[0]
+	}
+
+	// The counterparty is whoever paid for a credit and whoever was paid for a debit
+	counterparty := details.Debtor
+	if debit {
+		counterparty = details.Creditor
+	}
+
+	var remittance []string
+	for _, transaction := range raw.Transactions {
+		remittance = append(remittance, transaction.Unstructured...)
+	}
+	if len(remittance) == 0 {
+		for _, transaction := range raw.Transactions {
+			remittance = append(remittance, transaction.Structured...)
+		}
+	}
+	description := joinFields(remittance...)
+	if description == "" {
+		description = strings.TrimSpace(raw.AdditionalInfo)
+	}
+
+	name := joinFields(counterparty.Name, counterparty.PartyName)
+	if name == "" {
+		name = strings.TrimSpace(raw.AdditionalInfo)
+	}
+	if name == "" {
+		name = "CAMT.053"
+	}
+
+	currency := strings.TrimSpace(raw.Amount.Currency)
+	if currency == "" {
+		currency = statement.currency
+	}
+
+	reference := strings.TrimSpace(raw.AccountServicerReference)
+	if reference == "" {
+		reference = strings.TrimSpace(details.AccountServicerReference)
+	}
+	if reference == "" {
+		reference = strings.TrimSpace(raw.Reference)
+	}
+	externalID := "camt:" + statement.account + ":" + reference
+	if reference == "" {
+		externalID = contentID("camt:", occurrences, statement.account, date.Date+date.DateTime, raw.Amount.Value, raw.Indicator, name, description)
+	}
+
+	entryStatus := schemas.StatusSuccess
+	if !booked {
+		entryStatus = schemas.StatusPending
+	}
+
+	return &statementEntry{
+		line:        line,
+		timestamp:   timestamp,
+		name:        name,
+		amount:      amount,
+		description: description,
+		negative:    negative,
+		debit:       debit,
+		externalID:  externalID,
+		currency:    currency,
+		status:      entryStatus,
+	}, booked, nil
+}
+
+// parseCAMTDate parses an ISO date or date and time to a Unix timestamp
+// Dates are taken at midnight UTC and date times without an offset are in UTC
+func parseCAMTDate(date camtDate) (int64, error) {
+	if value := strings.TrimSpace(date.DateTime); value != "" {
+		for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999"} {
+			if t, err := time.Parse(layout, value); err == nil {
+				return t.Unix(), nil
+			}
+		}
+		return 0, fmt.Errorf("unsupported date time %q", value)
+	}
+
+	value := strings.TrimSpace(date.Date)
+	if value == "" {
+		return 0, errors.New("booking date is missing")
+	}
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return 0, fmt.Errorf("unsupported date %q", value)
+	}
+	return t.Unix(), nil
+}
+
+// joinFields joins the non-empty trimmed values with single spaces
+func joinFields(values ...string) string {
+	var parts []string
+	for _, value := range values {
+		if value = strings.Join(strings.Fields(value), " "); value != "" {
+			parts = append(parts, value)
+		}
+	}
+	return strings.Join(parts, " ")
+}
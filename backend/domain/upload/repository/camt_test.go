@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+)
+
+// camtStatement is a camt.053 statement with booked, pending and informational entries
+// The closing balance is written in by each test
+const camtStatement = `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.08">
+<BkToCstmrStmt>
+<GrpHdr><MsgId>MSG1</MsgId><CreDtTm>2024-01-17T08:00:00</CreDtTm></GrpHdr>
+<Stmt>
+<Id>STMT-2024-01</Id>
+<Acct><Id><IBAN>NL91ABNA0417164300</IBAN></Id><Ccy>EUR</Ccy></Acct>
+<Bal><Tp><CdOrPrtry><Cd>OPBD</Cd></CdOrPrtry></Tp><Amt Ccy="EUR">1000.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Dt><Dt>2024-01-14</Dt></Dt></Bal>
+<Bal><Tp><CdOrPrtry><Cd>CLBD</Cd></CdOrPrtry></Tp><Amt Ccy="EUR">CLOSING</Amt><CdtDbtInd>CRDT</CdtDbtInd><Dt><Dt>2024-01-16</Dt></Dt></Bal>
+<Ntry>
+<Amt Ccy="EUR">250.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Sts><Cd>BOOK</Cd></Sts>
+<BookgDt><Dt>2024-01-15</Dt></BookgDt><AcctSvcrRef>REF-1</AcctSvcrRef>
+<NtryDtls><TxDtls>
+<RltdPties><Dbtr><Pty><Nm>ACME LTD</Nm></Pty></Dbtr></RltdPties>
+<RmtInf><Ustrd>Invoice 42</Ustrd><Ustrd>January</Ustrd></RmtInf>
+</TxDtls></NtryDtls>
+</Ntry>
+<Ntry>
+<Amt Ccy="EUR">100.50</Amt><CdtDbtInd>DBIT</CdtDbtInd><Sts>BOOK</Sts>
+<BookgDt><DtTm>2024-01-16T09:30:00+01:00</DtTm></BookgDt>
+<NtryDtls><TxDtls>
+<RltdPties><Dbtr><Nm>OURSELVES</Nm></Dbtr><Cdtr><Nm>LANDLORD</Nm></Cdtr></RltdPties>
+<RmtInf><Ustrd>Rent</Ustrd></RmtInf>
+</TxDtls></NtryDtls>
+</Ntry>
+<Ntry>
+<Amt Ccy="EUR">10.00</Amt><CdtDbtInd>DBIT</CdtDbtInd><Sts><Cd>PDNG</Cd></Sts>
+<BookgDt><Dt>2024-01-16</Dt></BookgDt><NtryRef>CARD-9</NtryRef><AddtlNtryInf>CARD PAYMENT</AddtlNtryInf>
+</Ntry>
+<Ntry>
+<Amt Ccy="EUR">0.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Sts><Cd>INFO</Cd></Sts>
+<BookgDt><Dt>2024-01-16</Dt></BookgDt>
+</Ntry>
+</Stmt>
+</BkToCstmrStmt>
+</Document>`
+
+// TestParseCAMT053WithValidation tests entry mapping, status handling and a matching balance check
+func TestParseCAMT053WithValidation(t *testing.T) {
+	repo := NewRepository()
+	content := strings.Replace(camtStatement, "CLOSING", "1149.50", 1)
+	statement, err := repo.ParseCAMT053WithValidation(context.Background(), strings.NewReader(content), validator.NewFieldValidator())
+	if err != nil {
+		t.Fatalf("ParseCAMT053WithValidation failed: %v", err)
+	}
+
+	if len(statement.Transactions) != 3 {
+		t.Fatalf("Expected the informational entry to be skipped, got %d transactions", len(statement.Transactions))
+	}
+
+	invoice := statement.Transactions[0]
+	if invoice.Type != schemas.TypeCredit || invoice.Amount != 25000 || invoice.Name != "ACME LTD" || invoice.Description != "Invoice 42 January" {
+		t.Errorf("Unexpected credit: %+v", invoice)
+	}
+	if invoice.Timestamp != 1705276800 || invoice.Currency != "EUR" || invoice.Status != schemas.StatusSuccess {
+		t.Errorf("Expected booking date, currency and SUCCESS, got %d %s %s", invoice.Timestamp, invoice.Currency, invoice.Status)
+	}
+	if invoice.ExternalID == nil || *invoice.ExternalID != "camt:NL91ABNA0417164300:REF-1" {
+		t.Errorf("Expected external ID scoped by account, got %v", invoice.ExternalID)
+	}
+
+	rent := statement.Transactions[1]
+	if rent.Type != schemas.TypeDebit || rent.Amount != 10050 || rent.Name != "LANDLORD" || rent.Timestamp != 1705393800 {
+		t.Errorf("Expected the creditor as counterparty of a debit, got %+v", rent)
+	}
+	if rent.ExternalID == nil || !strings.HasPrefix(*rent.ExternalID, "camt:") {
+		t.Errorf("Expected a content external ID without a reference, got %v", rent.ExternalID)
+	}
+
+	card := statement.Transactions[2]
+	if card.Status != schemas.StatusPending || card.Name != "CARD PAYMENT" {
+		t.Errorf("Expected a pending entry, got %+v", card)
+	}
+
+	if len(statement.Balances) != 1 {
+		t.Fatalf("Expected one balance check, got %d", len(statement.Balances))
+	}
+	balance := statement.Balances[0]
+	if balance.StatementID != "STMT-2024-01" || balance.Account != "NL91ABNA0417164300" || balance.Currency != "EUR" {
+		t.Errorf("Unexpected statement identification: %+v", balance)
+	}
+	if balance.Opening != 100000 || balance.Closing != 114950 || balance.Computed != 114950 || balance.Mismatch {
+		t.Errorf("Expected pending entries to stay out of a matching balance, got %+v", balance)
+	}
+}
+
+// TestParseCAMT053BalanceMismatch tests that a closing balance that disagrees with the entries is flagged
+func TestParseCAMT053BalanceMismatch(t *testing.T) {
+	repo := NewRepository()
+	content := strings.Replace(camtStatement, "CLOSING", "1200.00", 1)
+	statement, err := repo.ParseCAMT053WithValidation(context.Background(), strings.NewReader(content), validator.NewFieldValidator())
+	if err != nil {
+		t.Fatalf("ParseCAMT053WithValidation failed: %v", err)
+	}
+
+	balance := statement.Balances[0]
+	if !balance.Mismatch || balance.Closing != 120000 || balance.Computed != 114950 {
+		t.Errorf("Expected a mismatch, got %+v", balance)
+	}
+}
+
+// TestParseCAMT053Invalid tests rejection of other camt messages and malformed XML
+func TestParseCAMT053Invalid(t *testing.T) {
+	repo := NewRepository()
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"account report", `<Document><BkToCstmrAcctRpt><Rpt><Id>1</Id></Rpt></BkToCstmrAcctRpt></Document>`},
+		{"unclosed element", `<Document><BkToCstmrStmt><Stmt><Ntry>`},
+		{"missing booking date", `<Document><BkToCstmrStmt><Stmt><Ntry><Amt Ccy="EUR">1.00</Amt><CdtDbtInd>CRDT</CdtDbtInd></Ntry></Stmt></BkToCstmrStmt></Document>`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := repo.ParseCAMT053WithValidation(context.Background(), strings.NewReader(tc.content), validator.NewFieldValidator()); err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	}
+}
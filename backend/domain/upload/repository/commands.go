@@ -2,3 +2,4 @@ package repository
 
 // Commands are implemented in repository.go
 // ParseCSV is a command operation that parses CSV files
+// The JSON and NDJSON parsers are implemented in json.go, the bank statement parsers in ofx.go and qif.go
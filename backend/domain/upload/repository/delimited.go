@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+)
+
+// sniffLines is how many non-empty lines are looked at to choose a delimiter
+const sniffLines = 10
+
+// delimiterCandidates are the delimiters tried when sniffing, in order of preference on a tie
+var delimiterCandidates = []byte{',', ';', '\t', '|'}
+
+// sniffDelimiter chooses the delimiter of a delimited text file from its first lines
+// The candidate found the same non-zero number of times on every line wins, preferring the one found most often;
+// without such a candidate the one found most often overall is used, and a comma when none is found at all
+func sniffDelimiter(content []byte) string {
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() && len(lines) < sniffLines {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	best, bestCount, bestConsistent := byte(','), 0, false
+	for _, candidate := range delimiterCandidates {
+		total, consistent := 0, true
+		for i, line := range lines {
+			count := countDelimiter(line, candidate)
+			if count == 0 || (i > 0 && count != countDelimiter(lines[0], candidate)) {
+				consistent = false
+			}
+			total += count
+		}
+		if total == 0 {
+			continue
+		}
+
+		if (consistent && !bestConsistent) || (consistent == bestConsistent && total > bestCount) {
+			best, bestCount, bestConsistent = candidate, total, consistent
+		}
+	}
+	return string(best)
+}
+
+// countDelimiter counts the delimiter in a line, leaving out quoted text
+func countDelimiter(line string, delimiter byte) int {
+	count := 0
+	quoted := false
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '"':
+			quoted = !quoted
+		case delimiter:
+			if !quoted {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// Amount endings that give the decimal separator away: the separator followed by one or two digits
+var (
+	pointDecimalAmount = regexp.MustCompile(`\.\d{1,2}$`)
+	commaDecimalAmount = regexp.MustCompile(`,\d{1,2}$`)
+)
+
+// sniffDecimalSeparator chooses the decimal separator of a file from its amounts, such as 1.234,50 or 1234.50
+// Amounts that disagree are rejected, as are files whose separators only appear in amounts such as 1.234,
+// which may be read either way; setting the decimal separator explicitly accepts both
+func sniffDecimalSeparator(amounts []string) (string, error) {
+	var point, comma, unclear string
+	for _, amount := range amounts {
+		amount = strings.TrimSpace(amount)
+		switch {
+		case pointDecimalAmount.MatchString(amount):
+			if point == "" {
+				point = amount
+			}
+		case commaDecimalAmount.MatchString(amount):
+			if comma == "" {
+				comma = amount
+			}
+		case strings.ContainsAny(amount, ".,") && unclear == "":
+			unclear = amount
+		}
+	}
+
+	switch {
+	case point != "" && comma != "":
+		return "", fmt.Errorf("%w: amounts %q and %q use different decimal separators", schemas.ErrInvalidParseOptions, point, comma)
+	case comma != "":
+		return schemas.DecimalComma, nil
+	case point != "" || unclear == "":
+		return schemas.DecimalPoint, nil
+	}
+	return "", fmt.Errorf("%w: cannot tell the decimal separator of amount %q, set decimal_separator", schemas.ErrInvalidParseOptions, unclear)
+}
+
+// pointDecimal rewrites an amount written with a decimal comma, such as 1.234,56, to 1234.56
+// Points and spaces, including non-breaking ones, are thousands separators in this format and are dropped
+func pointDecimal(amount string) string {
+	amount = strings.NewReplacer(".", "", " ", "", "\u00a0", "").Replace(strings.TrimSpace(amount))
+	return strings.Replace(amount, ",", ".", 1)
+}
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+)
+
+// TestSniffDelimiter tests choosing the delimiter from the first lines
+func TestSniffDelimiter(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{"comma", "timestamp,name,type,amount,status,description\n1,A,DEBIT,10,SUCCESS,x\n", ","},
+		{"semicolon with decimal commas", "timestamp;name;type;amount;status;description\n1;A;DEBIT;10,50;SUCCESS;x\n", ";"},
+		{"tab", "timestamp\tname\ttype\tamount\tstatus\tdescription\n1\tA, B\tDEBIT\t10\tSUCCESS\tx\n", "\t"},
+		{"pipe", "timestamp|name|type|amount|status|description\n1|A|DEBIT|10|SUCCESS|x\n", "|"},
+		{"quoted delimiters ignored", "timestamp;name;type;amount;status;description\n1;\"A, B, C\";DEBIT;10;SUCCESS;x\n", ";"},
+		{"no delimiter", "timestamp\n", ","},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if delimiter := sniffDelimiter([]byte(tc.content)); delimiter != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, delimiter)
+			}
+		})
+	}
+}
+
+// TestParseDelimitedWithValidation tests semicolon files with decimal commas and explicit options
+func TestParseDelimitedWithValidation(t *testing.T) {
+	repo := NewRepository()
+	fieldValidator := validator.NewFieldValidator()
+
+	content := "timestamp;name;type;amount;status;description\n" +
+		"1624507883;Kopi Kenangan;DEBIT;1.234,50;SUCCESS;Kopi susu\n" +
+		"1624608050;Gaji;CREDIT;12,25;PENDING;Juni\n"
+
+	transactions, err := repo.ParseDelimitedWithValidation(context.Background(), strings.NewReader(content), fieldValidator, schemas.ParseOptions{})
+	if err != nil {
+		t.Fatalf("ParseDelimitedWithValidation failed: %v", err)
+	}
+	if len(transactions) != 2 {
+		t.Fatalf("Expected 2 transactions, got %d", len(transactions))
+	}
+	if transactions[0].Name != "Kopi Kenangan" || transactions[0].Amount != 123450 {
+		t.Errorf("Unexpected first transaction: %+v", transactions[0])
+	}
+	if transactions[1].Amount != 1225 {
+		t.Errorf("Expected 1225 cents, got %d", transactions[1].Amount)
+	}
+
+	// A semicolon file with decimal points is read by its amounts, not its delimiter
+	content = "timestamp;name;type;amount;status;description\n1624507883;A;DEBIT;1234.50;SUCCESS;x\n1624507884;B;DEBIT;12;SUCCESS;x\n"
+	transactions, err = repo.ParseDelimitedWithValidation(context.Background(), strings.NewReader(content), fieldValidator, schemas.ParseOptions{})
+	if err != nil {
+		t.Fatalf("ParseDelimitedWithValidation failed: %v", err)
+	}
+	if transactions[0].Amount != 123450 || transactions[1].Amount != 1200 {
+		t.Errorf("Expected 123450 and 1200 cents, got %+v", transactions)
+	}
+
+	// An amount that reads either way needs the separator set explicitly
+	content = "timestamp;name;type;amount;status;description\n1624507883;A;DEBIT;1.234;SUCCESS;x\n"
+	if _, err := repo.ParseDelimitedWithValidation(context.Background(), strings.NewReader(content), fieldValidator, schemas.ParseOptions{}); !errors.Is(err, schemas.ErrInvalidParseOptions) {
+		t.Errorf("Expected ErrInvalidParseOptions for an ambiguous amount, got %v", err)
+	}
+	transactions, err = repo.ParseDelimitedWithValidation(context.Background(), strings.NewReader(content), fieldValidator,
+		schemas.ParseOptions{Delimiter: ";", DecimalSeparator: schemas.DecimalComma})
+	if err != nil {
+		t.Fatalf("ParseDelimitedWithValidation with options failed: %v", err)
+	}
+	if transactions[0].Amount != 123400 {
+		t.Errorf("Expected 123400 cents, got %d", transactions[0].Amount)
+	}
+}
+
+// TestSniffDecimalSeparator tests choosing the decimal separator from the amounts
+func TestSniffDecimalSeparator(t *testing.T) {
+	tests := []struct {
+		name     string
+		amounts  []string
+		expected string
+	}{
+		{"decimal commas", []string{"1.234,50", "12"}, schemas.DecimalComma},
+		{"one decimal digit", []string{"12,5"}, schemas.DecimalComma},
+		{"decimal points", []string{" 1234.50 ", "7"}, schemas.DecimalPoint},
+		{"thousands separator with decimal point", []string{"1.234", "10.25"}, schemas.DecimalPoint},
+		{"whole amounts", []string{"250000", "1500"}, schemas.DecimalPoint},
+		{"mixed separators", []string{"10.50", "10,50"}, ""},
+		{"ambiguous thousands", []string{"1.234", "5"}, ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			decimal, err := sniffDecimalSeparator(tc.amounts)
+			if tc.expected == "" {
+				if !errors.Is(err, schemas.ErrInvalidParseOptions) {
+					t.Errorf("Expected ErrInvalidParseOptions, got %q, %v", decimal, err)
+				}
+				return
+			}
+			if err != nil || decimal != tc.expected {
+				t.Errorf("Expected %q, got %q, %v", tc.expected, decimal, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+)
+
+// maxNDJSONLine is the longest NDJSON line accepted, in bytes
+const maxNDJSONLine = 1024 * 1024
+
+// jsonRecord is one transaction of a JSON or NDJSON upload
+// Fields hold the raw JSON values so numbers and numeric strings are both accepted
+type jsonRecord struct {
+	Timestamp   json.RawMessage `json:"timestamp"`
+	Name        json.RawMessage `json:"name"`
+	Type        json.RawMessage `json:"type"`
+	Amount      json.RawMessage `json:"amount"`
+	Status      json.RawMessage `json:"status"`
+	Description json.RawMessage `json:"description"`
+}
+
+// fields returns the record in the column order of the upload CSV
+func (r jsonRecord) fields() []string {
+	values := []json.RawMessage{r.Timestamp, r.Name, r.Type, r.Amount, r.Status, r.Description}
+	fields := make([]string, len(values))
+	for i, value := range values {
+		fields[i] = rawString(value)
+	}
+	return fields
+}
+
+// rawString returns the text of a JSON string, or the literal of any other value; null and missing values are empty
+func rawString(value json.RawMessage) string {
+	value = bytes.TrimSpace(value)
+	if len(value) == 0 || bytes.Equal(value, []byte("null")) {
+		return ""
+	}
+
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		return s
+	}
+	return string(value)
+}
+
+// ParseJSONWithValidation parses a JSON array of transactions with field validation
+// Elements are decoded one at a time and errors report the zero based index of the element
+func (r *Repository) ParseJSONWithValidation(ctx context.Context, file io.Reader, fieldValidator *validator.FieldValidator) ([]schemas.Transaction, error) {
+	decoder := json.NewDecoder(file)
+
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, fmt.Errorf(constants.MsgJSONReadError, 0, err)
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return nil, errors.New(constants.MsgJSONInvalidFormat)
+	}
+
+	var transactions []schemas.Transaction
+	seenTransactions := make(map[string]bool) // Track duplicates
+
+	index := 0
+	for ; decoder.More(); index++ {
+		var record jsonRecord
+		if err := decoder.Decode(&record); err != nil {
+			return nil, fmt.Errorf(constants.MsgJSONReadError, index, err)
+		}
+
+		transaction, field, err := parseRecord(record.fields(), fieldValidator)
+		if err != nil {
+			return nil, fmt.Errorf(constants.MsgJSONValidationErrorField, index, field, err)
+		}
+
+		key := duplicateKey(transaction)
+		if seenTransactions[key] {
+			continue // Skip duplicate transaction
+		}
+		seenTransactions[key] = true
+
+		transactions = append(transactions, transaction)
+	}
+
+	// Closing bracket of the array
+	if _, err := decoder.Token(); err != nil {
+		return nil, fmt.Errorf(constants.MsgJSONReadError, index, err)
+	}
+
+	if len(transactions) == 0 {
+		return nil, errors.New(constants.MsgNoValidTransactions)
+	}
+
+	return transactions, nil
+}
+
+// ParseNDJSONWithValidation parses newline-delimited JSON, one transaction object per line, with field validation
+// Blank lines are skipped and errors report the one based line number
+func (r *Repository) ParseNDJSONWithValidation(ctx context.Context, file io.Reader, fieldValidator *validator.FieldValidator) ([]schemas.Transaction, error) {
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxNDJSONLine)
+
+	var transactions []schemas.Transaction
+	seenTransactions := make(map[string]bool) // Track duplicates
+	lineNum := 0
+
+	for scanner.Scan() {
+		lineNum++
+
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var record jsonRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return nil, fmt.Errorf(constants.MsgNDJSONReadError, lineNum, err)
+		}
+
+		transaction, field, err := parseRecord(record.fields(), fieldValidator)
+		if err != nil {
+			return nil, fmt.Errorf(constants.MsgCSVValidationErrorField, lineNum, field, err)
+		}
+
+		key := duplicateKey(transaction)
+		if seenTransactions[key] {
+			continue // Skip duplicate transaction
+		}
+		seenTransactions[key] = true
+
+		transactions = append(transactions, transaction)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf(constants.MsgNDJSONReadError, lineNum+1, err)
+	}
+
+	if len(transactions) == 0 {
+		return nil, errors.New(constants.MsgNoValidTransactions)
+	}
+
+	return transactions, nil
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+)
+
+// TestParseJSONWithValidation tests parsing a JSON array with numeric and string values and duplicates
+func TestParseJSONWithValidation(t *testing.T) {
+	content := `[
+		{"timestamp": 1624507883, "name": " JOHN DOE ", "type": "debit", "amount": 2500.5, "status": "SUCCESS", "description": "restaurant"},
+		{"timestamp": "1624608050", "name": "E-COMMERCE A", "type": "DEBIT", "amount": "1500", "status": "FAILED"},
+		{"timestamp": 1624507883, "name": "JOHN DOE", "type": "DEBIT", "amount": 2500.5, "status": "SUCCESS", "description": "again"}
+	]`
+
+	repo := NewRepository()
+	transactions, err := repo.ParseJSONWithValidation(context.Background(), strings.NewReader(content), validator.NewFieldValidator())
+	if err != nil {
+		t.Fatalf("ParseJSONWithValidation failed: %v", err)
+	}
+
+	if len(transactions) != 2 {
+		t.Fatalf("Expected 2 transactions after skipping the duplicate, got %d", len(transactions))
+	}
+	if transactions[0].Name != "JOHN DOE" || transactions[0].Type != schemas.TypeDebit || transactions[0].Amount != 250050 {
+		t.Errorf("Unexpected first transaction: %+v", transactions[0])
+	}
+	if transactions[1].Timestamp != 1624608050 || transactions[1].Amount != 150000 || transactions[1].Description != "" {
+		t.Errorf("Unexpected second transaction: %+v", transactions[1])
+	}
+}
+
+// TestParseJSONErrors tests that JSON errors report the index of the element
+func TestParseJSONErrors(t *testing.T) {
+	repo := NewRepository()
+	fieldValidator := validator.NewFieldValidator()
+
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{"not an array", `{"timestamp": 1}`, "expected an array"},
+		{"invalid field", `[{"timestamp": 1624507883, "name": "A", "type": "DEBIT", "amount": 1, "status": "SUCCESS"}, {"timestamp": 1624507884, "name": "B", "type": "WIRE", "amount": 1, "status": "SUCCESS"}]`, "index 1 (type)"},
+		{"missing field", `[{"timestamp": 1624507883, "type": "DEBIT", "amount": 1, "status": "SUCCESS"}]`, "index 0 (name)"},
+		{"not an object", `[{"timestamp": 1624507883, "name": "A", "type": "DEBIT", "amount": 1, "status": "SUCCESS"}, 42]`, "index 1"},
+		{"truncated", `[{"timestamp": 1624507883, "name": "A", "type": "DEBIT", "amount": 1, "status": "SUCCESS"}`, "index 1"},
+		{"empty", `[]`, "No valid transactions"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := repo.ParseJSONWithValidation(context.Background(), strings.NewReader(tt.content), fieldValidator)
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("Expected error containing %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+// TestParseNDJSONWithValidation tests parsing NDJSON with blank lines and line accurate errors
+func TestParseNDJSONWithValidation(t *testing.T) {
+	repo := NewRepository()
+	fieldValidator := validator.NewFieldValidator()
+
+	content := `{"timestamp": 1624507883, "name": "JOHN DOE", "type": "DEBIT", "amount": 2500, "status": "SUCCESS"}
+
+{"timestamp": 1624512883, "name": "COMPANY A", "type": "CREDIT", "amount": 120000, "status": "PENDING", "description": "salary"}
+`
+	transactions, err := repo.ParseNDJSONWithValidation(context.Background(), strings.NewReader(content), fieldValidator)
+	if err != nil {
+		t.Fatalf("ParseNDJSONWithValidation failed: %v", err)
+	}
+	if len(transactions) != 2 || transactions[1].Status != schemas.StatusPending || transactions[1].Amount != 12000000 {
+		t.Errorf("Unexpected transactions: %+v", transactions)
+	}
+
+	invalid := content + `{"timestamp": 1624507883, "name": "A", "type": "DEBIT", "amount": -5, "status": "SUCCESS"}` + "\n"
+	if _, err := repo.ParseNDJSONWithValidation(context.Background(), strings.NewReader(invalid), fieldValidator); err == nil || !strings.Contains(err.Error(), "line 4 (amount)") {
+		t.Errorf("Expected amount error at line 4, got %v", err)
+	}
+
+	malformed := content + "{not json}\n"
+	if _, err := repo.ParseNDJSONWithValidation(context.Background(), strings.NewReader(malformed), fieldValidator); err == nil || !strings.Contains(err.Error(), "NDJSON at line 4") {
+		t.Errorf("Expected read error at line 4, got %v", err)
+	}
+}
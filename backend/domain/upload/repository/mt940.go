@@ -0,0 +1,322 @@
+package repository
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+)
+
+var (
+	// mt940Field matches the tag that starts a field, such as :61: or :28C:
+	mt940Field = regexp.MustCompile(`^:(\d{2}[A-Z]?):(.*)$`)
+	// mt940Balance matches an opening or closing balance: mark, date, currency and amount
+	mt940Balance = regexp.MustCompile(`^([CD])(\d{6})([A-Z]{3})(\d+,\d*)$`)
+	// mt940Line matches the first line of a :61: statement line up to the references
+	mt940Line = regexp.MustCompile(`^(\d{6})(\d{4})?(R?[CD])([A-Z])?(\d+,\d*)([A-Z][A-Z0-9]{3})(.*)$`)
+	// mt940Subfield matches a ?NN subfield of a structured :86: field
+	mt940Subfield = regexp.MustCompile(`\?(\d{2})`)
+	// mt940Code matches a /CODE/ of a SWIFT structured :86: field
+	mt940Code = regexp.MustCompile(`/([A-Z]{4})/`)
+)
+
+// mt940Statement tracks the statement being read and the entry waiting for its :86: information
+type mt940Statement struct {
+	balances *statementBalances
+	pending  *mt940Entry
+}
+
+// mt940Entry is a :61: statement line with its :86: information
+type mt940Entry struct {
+	line int
+	raw  string
+	info string
+}
+
+// ParseMT940WithValidation parses a SWIFT MT940 customer statement with field validation
+// Each :61: statement line becomes a transaction dated by its entry date (or value date), with the counterparty
+// and remittance information of the following :86: field as name and description; the bank reference,
+// scoped by the :25: account, becomes the external ID.
+// The :60F:/:60M: opening and :62F:/:62M: closing balances of each statement are checked against its lines
+func (r *Repository) ParseMT940WithValidation(ctx context.Context, file io.Reader, fieldValidator *validator.FieldValidator) (*schemas.Statement, error) {
+	scanner := bufio.NewScanner(file)
+
+	var entries []statementEntry
+	var balances []*statementBalances
+	var statement mt940Statement
+	occurrences := make(map[string]int)
+	tag, value, fieldLine, lineNum := "", "", 0, 0
+
+	// flush handles the field read so far
+	flush := func() error {
+		if tag == "" {
+			return nil
+		}
+		defer func() { tag, value = "", "" }()
+
+		if tag == "20" || statement.balances == nil {
+			if err := statement.finish(&entries, occurrences); err != nil {
+				return err
+			}
+			statement.balances = &statementBalances{}
+			balances = append(balances, statement.balances)
+		}
+		current := statement.balances
+
+		switch tag {
+		case "20":
+			current.statementID = strings.TrimSpace(value)
+		case "25":
+			current.account = strings.TrimSpace(value)
+		case "28C":
+			current.statementID = strings.TrimSpace(value)
+		case "60F", "60M", "62F", "62M":
+			amount, currency, err := parseMT940Balance(value)
+			if err != nil {
+				return fmt.Errorf(constants.MsgStatementInvalidFormat, "MT940", fmt.Sprintf(":%s: at line %d: %v", tag, fieldLine, err))
+			}
+			if current.currency == "" {
+				current.currency = currency
+			}
+			if strings.HasPrefix(tag, "60") {
+				current.opening = &amount
+			} else {
+				if err := statement.finishEntry(&entries, occurrences); err != nil {
+					return err
+				}
+				current.closing = &amount
+			}
+		case "61":
+			if err := statement.finishEntry(&entries, occurrences); err != nil {
+				return err
+			}
+			statement.pending = &mt940Entry{line: fieldLine, raw: value}
+		case "86":
+			if statement.pending != nil && statement.pending.info == "" {
+				statement.pending.info = value
+			}
+		}
+		return nil
+	}
+
+	for scanner.Scan() {
+		lineNum++
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if lineNum == 1 {
+			text = strings.TrimPrefix(text, "\ufeff")
+		}
+
+		// Drop the SWIFT header blocks and message trailers around the text block
+		if strings.HasPrefix(text, "{") {
+			i := strings.Index(text, "{4:")
+			if i < 0 {
+				continue
+			}
+			text = text[i+3:]
+		}
+		if text == "-" || text == "-}" || strings.HasPrefix(text, "-}{") {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if match := mt940Field.FindStringSubmatch(text); match != nil {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			tag, value, fieldLine = match[1], match[2], lineNum
+			continue
+		}
+		if tag != "" {
+			value += "\n" + text
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", constants.MsgFailedToReadFile, err)
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	if err := statement.finish(&entries, occurrences); err != nil {
+		return nil, err
+	}
+
+	if len(balances) == 0 {
+		return nil, fmt.Errorf(constants.MsgStatementInvalidFormat, "MT940", "no :20: or :61: fields")
+	}
+
+	return collectStatement(entries, balances, fieldValidator)
+}
+
+// finish converts the entry waiting for its :86: information and closes the statement
+func (s *mt940Statement) finish(entries *[]statementEntry, occurrences map[string]int) error {
+	if err := s.finishEntry(entries, occurrences); err != nil {
+		return err
+	}
+	s.balances = nil
+	return nil
+}
+
+// finishEntry converts the entry waiting for its :86: information, if any, and books it
+func (s *mt940Statement) finishEntry(entries *[]statementEntry, occurrences map[string]int) error {
+	if s.pending == nil {
+		return nil
+	}
+	entry, err := mt940StatementEntry(*s.pending, s.balances, occurrences)
+	if err != nil {
+		return err
+	}
+	*entries = append(*entries, entry)
+	s.balances.book(entry)
+	s.pending = nil
+	return nil
+}
+
+// mt940StatementEntry maps a :61: statement line and its :86: information to a statement entry
+func mt940StatementEntry(raw mt940Entry, statement *statementBalances, occurrences map[string]int) (statementEntry, error) {
+	lines := strings.SplitN(raw.raw, "\n", 2)
+	match := mt940Line.FindStringSubmatch(strings.TrimSpace(lines[0]))
+	if match == nil {
+		return statementEntry{}, fmt.Errorf(constants.MsgStatementInvalidFormat, "MT940", fmt.Sprintf("unreadable :61: at line %d", raw.line))
+	}
+	valueDate, entryDate, mark, amount, transactionType, references := match[1], match[2], match[3], match[5], match[6], match[7]
+
+	timestamp, err := parseMT940Date(valueDate, entryDate)
+	if err != nil {
+		return statementEntry{}, fmt.Errorf(constants.MsgStatementInvalidDate, raw.line, err)
+	}
+
+	customerReference, bankReference := references, ""
+	if i := strings.Index(references, "//"); i >= 0 {
+		customerReference, bankReference = references[:i], references[i+2:]
+	}
+	customerReference, bankReference = strings.TrimSpace(customerReference), strings.TrimSpace(bankReference)
+
+	name, description := parseMT940Info(raw.info)
+	if description == "" && len(lines) > 1 {
+		description = joinFields(lines[1])
+	}
+	if name == "" && customerReference != "" && customerReference != "NONREF" {
+		name = customerReference
+	}
+	if name == "" {
+		name = "MT940 " + transactionType
+	}
+
+	externalID := "mt940:" + statement.account + ":" + bankReference
+	if bankReference == "" {
+		externalID = contentID("mt940:", occurrences, statement.account, valueDate+entryDate, mark, amount, customerReference, raw.info)
+	}
+
+	return statementEntry{
+		line:        raw.line,
+		timestamp:   timestamp,
+		name:        name,
+		amount:      mt940Amount(amount),
+		description: description,
+		// A reversal of a credit takes the money back out, and a reversal of a debit returns it
+		debit:      mark == "D" || mark == "RC",
+		externalID: externalID,
+		currency:   statement.currency,
+	}, nil
+}
+
+// parseMT940Balance parses a balance field to signed cents and its currency
+func parseMT940Balance(value string) (int64, string, error) {
+	match := mt940Balance.FindStringSubmatch(strings.TrimSpace(value))
+	if match == nil {
+		return 0, "", fmt.Errorf("unreadable balance %q", strings.TrimSpace(value))
+	}
+	amount := toCents(mt940Amount(match[4]))
+	if match[1] == "D" {
+		amount = -amount
+	}
+	return amount, match[3], nil
+}
+
+// parseMT940Date parses a YYMMDD value date, with an optional MMDD entry date, to a Unix timestamp at midnight UTC
+// The entry date takes the year of the value date, moved by one when the two dates straddle a new year
+func parseMT940Date(valueDate string, entryDate string) (int64, error) {
+	value, err := time.Parse("060102", valueDate)
+	if err != nil {
+		return 0, fmt.Errorf("unsupported value date %q", valueDate)
+	}
+	if entryDate == "" {
+		return value.Unix(), nil
+	}
+
+	entry, err := time.Parse("0102", entryDate)
+	if err != nil {
+		return 0, fmt.Errorf("unsupported entry date %q", entryDate)
+	}
+	year := value.Year()
+	switch months := int(entry.Month()) - int(value.Month()); {
+	case months > 6:
+		year--
+	case months < -6:
+		year++
+	}
+	return time.Date(year, entry.Month(), entry.Day(), 0, 0, 0, 0, time.UTC).Unix(), nil
+}
+
+// parseMT940Info reads the counterparty name and remittance information from a :86: field
+// It understands ?NN subfields (?20-?29 and ?60-?63 remittance, ?32-?33 name), SWIFT /NAME/ and /REMI/ codes,
+// and otherwise takes the first line as the name and the whole text as the description
+func parseMT940Info(info string) (string, string) {
+	if strings.TrimSpace(info) == "" {
+		return "", ""
+	}
+
+	if mt940Subfield.MatchString(info) {
+		text := strings.ReplaceAll(info, "\n", "")
+		subfields := make(map[string]string)
+		indexes := mt940Subfield.FindAllStringSubmatchIndex(text, -1)
+		for i, index := range indexes {
+			end := len(text)
+			if i+1 < len(indexes) {
+				end = indexes[i+1][0]
+			}
+			subfields[text[index[2]:index[3]]] += text[index[1]:end]
+		}
+
+		var remittance []string
+		for _, code := range []string{"20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "60", "61", "62", "63"} {
+			remittance = append(remittance, subfields[code])
+		}
+		return joinFields(subfields["32"] + subfields["33"]), joinFields(remittance...)
+	}
+
+	if mt940Code.MatchString(info) {
+		text := strings.ReplaceAll(info, "\n", "")
+		codes := make(map[string]string)
+		indexes := mt940Code.FindAllStringSubmatchIndex(text, -1)
+		for i, index := range indexes {
+			end := len(text)
+			if i+1 < len(indexes) {
+				end = indexes[i+1][0]
+			}
+			codes[text[index[2]:index[3]]] += text[index[1]:end]
+		}
+		return joinFields(strings.Trim(codes["NAME"], "/")), joinFields(strings.Trim(codes["REMI"], "/"))
+	}
+
+	lines := strings.SplitN(info, "\n", 2)
+	return joinFields(lines[0]), joinFields(info)
+}
+
+// mt940Amount converts an MT940 amount, which always uses a decimal comma, to the upload format
+func mt940Amount(value string) string {
+	amount := strings.TrimSuffix(strings.Replace(value, ",", ".", 1), ".")
+	if strings.HasPrefix(amount, ".") {
+		amount = "0" + amount
+	}
+	return amount
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+)
+
+// mt940Statements is an MT940 message with two statements; the second one does not balance
+const mt940Statements = `{1:F01BANKDEFFXXXX0000000000}{2:I940BANKDEFFXXXXN}{4:
+:20:STARTUMSE
+:25:10020030/1234567
+:28C:00001/001
+:60F:C240114EUR1000,00
+:61:2401150115CR250,00NTRFNONREF//BANKREF1
+:86:166?00GUTSCHRIFT?20SVWZ+Invoice 42?21January?32ACME
+ LTD?33 GMBH
+:61:240116DR100,5NMSCRENT-JAN
+:86:/BENM//NAME/LANDLORD/REMI/Rent January
+:61:240116RC20,00NTRFNONREF
+:86:Returned payment
+second line
+:62F:C240116EUR1129,50
+:20:STMT2
+:25:10020030/1234567
+:28C:00002/001
+:60F:C240116EUR1129,50
+:61:2312290102D5,NCHGNONREF//FEE1
+:62F:C240117EUR1000,
+-}`
+
+// TestParseMT940WithValidation tests statement lines, :86: information styles, reversals and balance checks
+func TestParseMT940WithValidation(t *testing.T) {
+	repo := NewRepository()
+	statement, err := repo.ParseMT940WithValidation(context.Background(), strings.NewReader(mt940Statements), validator.NewFieldValidator())
+	if err != nil {
+		t.Fatalf("ParseMT940WithValidation failed: %v", err)
+	}
+
+	if len(statement.Transactions) != 4 {
+		t.Fatalf("Expected 4 transactions, got %d", len(statement.Transactions))
+	}
+
+	invoice := statement.Transactions[0]
+	if invoice.Type != schemas.TypeCredit || invoice.Amount != 25000 || invoice.Name != "ACME LTD GMBH" || invoice.Description != "SVWZ+Invoice 42 January" {
+		t.Errorf("Unexpected credit: %+v", invoice)
+	}
+	if invoice.Timestamp != 1705276800 || invoice.Currency != "EUR" {
+		t.Errorf("Expected the entry date and currency, got %d %s", invoice.Timestamp, invoice.Currency)
+	}
+	if invoice.ExternalID == nil || *invoice.ExternalID != "mt940:10020030/1234567:BANKREF1" {
+		t.Errorf("Expected external ID scoped by account, got %v", invoice.ExternalID)
+	}
+
+	rent := statement.Transactions[1]
+	if rent.Type != schemas.TypeDebit || rent.Amount != 10050 || rent.Name != "LANDLORD" || rent.Description != "Rent January" {
+		t.Errorf("Unexpected debit: %+v", rent)
+	}
+
+	reversal := statement.Transactions[2]
+	if reversal.Type != schemas.TypeDebit || reversal.Name != "Returned payment" || reversal.Description != "Returned payment second line" {
+		t.Errorf("Expected a reversed credit to be a debit, got %+v", reversal)
+	}
+
+	fee := statement.Transactions[3]
+	if fee.Name != "MT940 NCHG" || fee.Amount != 500 || fee.Timestamp != 1704153600 {
+		t.Errorf("Expected the entry date in the next year, got %+v", fee)
+	}
+
+	if len(statement.Balances) != 2 {
+		t.Fatalf("Expected two balance checks, got %d", len(statement.Balances))
+	}
+	first, second := statement.Balances[0], statement.Balances[1]
+	if first.StatementID != "00001/001" || first.Opening != 100000 || first.Closing != 112950 || first.Computed != 112950 || first.Mismatch {
+		t.Errorf("Expected the first statement to balance, got %+v", first)
+	}
+	if !second.Mismatch || second.Computed != 112450 || second.Closing != 100000 {
+		t.Errorf("Expected the second statement to be flagged, got %+v", second)
+	}
+}
+
+// TestParseMT940Invalid tests rejection of files without statements and unreadable fields
+func TestParseMT940Invalid(t *testing.T) {
+	repo := NewRepository()
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"no fields", "timestamp,name\n1,a\n"},
+		{"unreadable statement line", ":20:X\n:60F:C240114EUR1,00\n:61:NOT A LINE\n"},
+		{"unreadable balance", ":20:X\n:60F:C2401EUR1,00\n"},
+		{"invalid value date", ":20:X\n:61:241340C1,00NTRFNONREF\n"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := repo.ParseMT940WithValidation(context.Background(), strings.NewReader(tc.content), validator.NewFieldValidator()); err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+)
+
+// ofxTag matches a tag and the text that follows it
+// OFX 1.x is SGML and leaves leaf elements unclosed while OFX 2.x is XML, so only the opening tag carries the value
+var ofxTag = regexp.MustCompile(`<(/?)([A-Za-z0-9.]+)>([^<]*)`)
+
+// ofxDebitTypes are the OFX TRNTYPE values that always take money out of the account
+var ofxDebitTypes = map[string]bool{
+	"DEBIT": true, "FEE": true, "SRVCHG": true, "ATM": true, "POS": true, "CHECK": true,
+	"PAYMENT": true, "CASH": true, "DIRECTDEBIT": true, "REPEATPMT": true,
+}
+
+// ParseOFXWithValidation parses the STMTTRN records of an OFX or QFX statement with field validation
+// The sign of TRNAMT gives the type, DTPOSTED the timestamp, NAME (or MEMO) the name and MEMO the description;
+// the FITID, scoped by account, becomes the external ID used to skip transactions that were uploaded before
+func (r *Repository) ParseOFXWithValidation(ctx context.Context, file io.Reader, fieldValidator *validator.FieldValidator) ([]schemas.Transaction, error) {
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", constants.MsgFailedToReadFile, err)
+	}
+	if !bytes.Contains(bytes.ToUpper(content), []byte("<OFX>")) {
+		return nil, fmt.Errorf(constants.MsgStatementInvalidFormat, "OFX", "no <OFX> element")
+	}
+
+	var entries []statementEntry
+	var current map[string]string
+	account, currentLine := "", 0
+	line, offset := 1, 0
+
+	for _, match := range ofxTag.FindAllSubmatchIndex(content, -1) {
+		line += bytes.Count(content[offset:match[0]], []byte("\n"))
+		offset = match[0]
+
+		closing := match[3] > match[2]
+		tag := strings.ToUpper(string(content[match[4]:match[5]]))
+		value := strings.TrimSpace(html.UnescapeString(string(content[match[6]:match[7]])))
+
+		switch {
+		case tag == "STMTTRN" && !closing:
+			current, currentLine = make(map[string]string), line
+		case tag == "STMTTRN" && closing:
+			if current == nil {
+				return nil, fmt.Errorf(constants.MsgStatementInvalidFormat, "OFX", fmt.Sprintf("unexpected </STMTTRN> at line %d", line))
+			}
+			entry, err := ofxEntry(current, account, currentLine)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, entry)
+			current = nil
+		case closing || value == "":
+			// Other closing tags and aggregates carry no value
+		case current != nil:
+			current[tag] = value
+		case tag == "ACCTID":
+			account = value
+		}
+	}
+	if current != nil {
+		return nil, fmt.Errorf(constants.MsgStatementInvalidFormat, "OFX", fmt.Sprintf("<STMTTRN> at line %d is not closed", currentLine))
+	}
+
+	return collectEntries(entries, fieldValidator)
+}
+
+// ofxEntry maps the fields of one STMTTRN record to a statement entry
+func ofxEntry(fields map[string]string, account string, line int) (statementEntry, error) {
+	timestamp, err := parseOFXDate(fields["DTPOSTED"])
+	if err != nil {
+		return statementEntry{}, fmt.Errorf(constants.MsgStatementInvalidDate, line, err)
+	}
+
+	amount, negative := splitAmount(fields["TRNAMT"])
+	transactionType := strings.ToUpper(fields["TRNTYPE"])
+
+	name := fields["NAME"]
+	if name == "" {
+		name = fields["MEMO"]
+	}
+	if name == "" {
+		name = transactionType
+	}
+
+	externalID := ""
+	if fitID := fields["FITID"]; fitID != "" {
+		externalID = "ofx:" + fitID
+		if account != "" {
+			externalID = "ofx:" + account + ":" + fitID
+		}
+	}
+
+	return statementEntry{
+		line:        line,
+		timestamp:   timestamp,
+		name:        name,
+		amount:      amount,
+		description: fields["MEMO"],
+		negative:    negative,
+		debit:       ofxDebitTypes[transactionType],
+		externalID:  externalID,
+	}, nil
+}
+
+// parseOFXDate parses an OFX date, YYYYMMDD[HHMMSS[.XXX]][[offset:TZ]], to a Unix timestamp
+// Dates without an offset are in GMT, as the OFX specification requires
+func parseOFXDate(value string) (int64, error) {
+	if value == "" {
+		return 0, errors.New("DTPOSTED is missing")
+	}
+
+	date, zone := value, ""
+	if i := strings.Index(value, "["); i >= 0 {
+		date, zone = value[:i], strings.TrimSuffix(value[i+1:], "]")
+	}
+	if i := strings.Index(date, "."); i >= 0 {
+		date = date[:i]
+	}
+
+	layouts := map[int]string{8: "20060102", 12: "200601021504", 14: "20060102150405"}
+	layout, ok := layouts[len(date)]
+	if !ok {
+		return 0, fmt.Errorf("unsupported date %q", value)
+	}
+
+	location := time.UTC
+	if zone != "" {
+		hours, err := strconv.ParseFloat(strings.SplitN(zone, ":", 2)[0], 64)
+		if err != nil {
+			return 0, fmt.Errorf("unsupported time zone in %q", value)
+		}
+		location = time.FixedZone("", int(hours*3600))
+	}
+
+	t, err := time.ParseInLocation(layout, date, location)
+	if err != nil {
+		return 0, fmt.Errorf("unsupported date %q", value)
+	}
+	return t.Unix(), nil
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+)
+
+// sgmlStatement is an OFX 1.x statement with unclosed leaf elements
+const sgmlStatement = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<BANKACCTFROM><BANKID>123<ACCTID>0042<ACCTTYPE>CHECKING</BANKACCTFROM>
+<BANKTRANLIST>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20240115120000.000[-5:EST]
+<TRNAMT>-1,234.56
+<FITID>T1
+<NAME>GROCERY &amp; CO
+<MEMO>weekly shop
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20240116
+<TRNAMT>2500.00
+<FITID>T2
+<MEMO>SALARY JAN
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20240115120000.000[-5:EST]
+<TRNAMT>-1,234.56
+<FITID>T1
+<NAME>GROCERY &amp; CO
+</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>`
+
+// TestParseOFXWithValidation tests type, amount sign, date and memo mapping and FITID deduplication
+func TestParseOFXWithValidation(t *testing.T) {
+	repo := NewRepository()
+	transactions, err := repo.ParseOFXWithValidation(context.Background(), strings.NewReader(sgmlStatement), validator.NewFieldValidator())
+	if err != nil {
+		t.Fatalf("ParseOFXWithValidation failed: %v", err)
+	}
+
+	if len(transactions) != 2 {
+		t.Fatalf("Expected the repeated FITID to be skipped, got %d transactions", len(transactions))
+	}
+
+	grocery := transactions[0]
+	if grocery.Type != schemas.TypeDebit || grocery.Amount != 123456 || grocery.Name != "GROCERY & CO" || grocery.Description != "weekly shop" {
+		t.Errorf("Unexpected debit: %+v", grocery)
+	}
+	if grocery.Timestamp != 1705338000 || grocery.Status != schemas.StatusSuccess {
+		t.Errorf("Expected 17:00 UTC and SUCCESS, got %d %s", grocery.Timestamp, grocery.Status)
+	}
+	if grocery.ExternalID == nil || *grocery.ExternalID != "ofx:0042:T1" {
+		t.Errorf("Expected external ID scoped by account, got %v", grocery.ExternalID)
+	}
+
+	salary := transactions[1]
+	if salary.Type != schemas.TypeCredit || salary.Amount != 250000 || salary.Name != "SALARY JAN" || salary.Timestamp != 1705363200 {
+		t.Errorf("Unexpected credit: %+v", salary)
+	}
+}
+
+// TestParseOFXVersion2 tests XML statements and unsigned debit types
+func TestParseOFXVersion2(t *testing.T) {
+	content := `<?xml version="1.0" encoding="UTF-8"?>
+<?OFX OFXHEADER="200" VERSION="220"?>
+<OFX><CREDITCARDMSGSRSV1><CCSTMTTRNRS><CCSTMTRS>
+<BANKTRANLIST>
+<STMTTRN><TRNTYPE>FEE</TRNTYPE><DTPOSTED>20240201000000</DTPOSTED><TRNAMT>5.00</TRNAMT><FITID>F1</FITID><NAME>CARD FEE</NAME></STMTTRN>
+</BANKTRANLIST>
+</CCSTMTRS></CCSTMTTRNRS></CREDITCARDMSGSRSV1></OFX>`
+
+	repo := NewRepository()
+	transactions, err := repo.ParseOFXWithValidation(context.Background(), strings.NewReader(content), validator.NewFieldValidator())
+	if err != nil {
+		t.Fatalf("ParseOFXWithValidation failed: %v", err)
+	}
+	if len(transactions) != 1 || transactions[0].Type != schemas.TypeDebit || transactions[0].Amount != 500 || *transactions[0].ExternalID != "ofx:F1" {
+		t.Errorf("Unexpected transactions: %+v", transactions)
+	}
+}
+
+// TestParseOFXErrors tests that OFX errors report the line of the record
+func TestParseOFXErrors(t *testing.T) {
+	repo := NewRepository()
+	fieldValidator := validator.NewFieldValidator()
+
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{"not ofx", "timestamp,name\n", "no <OFX> element"},
+		{"bad date", "<OFX>\n<STMTTRN>\n<DTPOSTED>2024-01-15\n<TRNAMT>1\n<NAME>A\n</STMTTRN>\n</OFX>", "Invalid date at line 2"},
+		{"bad amount", "<OFX>\n\n<STMTTRN>\n<DTPOSTED>20240115\n<TRNAMT>abc\n<NAME>A\n</STMTTRN>\n</OFX>", "line 3 (amount)"},
+		{"unclosed", "<OFX>\n<STMTTRN>\n<DTPOSTED>20240115\n</OFX>", "is not closed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := repo.ParseOFXWithValidation(context.Background(), strings.NewReader(tt.content), fieldValidator)
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("Expected error containing %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
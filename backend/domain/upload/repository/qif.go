@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+)
+
+// ParseQIFWithValidation parses the records of a QIF bank, cash or card account with field validation
+// The sign of T gives the type, D the date, P (or M) the name and M the description. QIF has no transaction ID,
+// so the external ID is a hash of the record and how often the same record already appeared in the file
+func (r *Repository) ParseQIFWithValidation(ctx context.Context, file io.Reader, fieldValidator *validator.FieldValidator) ([]schemas.Transaction, error) {
+	scanner := bufio.NewScanner(file)
+
+	var entries []statementEntry
+	fields := make(map[byte]string)
+	occurrences := make(map[string]int)
+	account, inAccount := "", false
+	lineNum, recordLine := 0, 0
+
+	for scanner.Scan() {
+		lineNum++
+		text := strings.TrimRightFunc(scanner.Text(), unicode.IsSpace)
+		if lineNum == 1 {
+			text = strings.TrimPrefix(text, "\ufeff")
+		}
+		if text == "" {
+			continue
+		}
+
+		if text[0] == '!' {
+			header := strings.ToLower(strings.ReplaceAll(text, " ", ""))
+			switch {
+			case header == "!account":
+				inAccount = true
+			case strings.HasPrefix(header, "!type:invst"), strings.HasPrefix(header, "!type:cat"), strings.HasPrefix(header, "!type:class"), strings.HasPrefix(header, "!type:memorized"):
+				return nil, fmt.Errorf(constants.MsgStatementInvalidFormat, "QIF", fmt.Sprintf("unsupported section %s at line %d", text, lineNum))
+			}
+			continue
+		}
+
+		if text[0] != '^' {
+			if len(fields) == 0 {
+				recordLine = lineNum
+			}
+			// Split lines (S, E, $) repeat per category; the record keeps its first value of each field
+			if _, ok := fields[text[0]]; !ok {
+				fields[text[0]] = strings.TrimSpace(text[1:])
+			}
+			continue
+		}
+
+		// End of record
+		if inAccount {
+			account, inAccount = fields['N'], false
+		} else if len(fields) > 0 {
+			entry, err := qifEntry(fields, account, recordLine, occurrences)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, entry)
+		}
+		fields = make(map[byte]string)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", constants.MsgFailedToReadFile, err)
+	}
+	if len(fields) > 0 && !inAccount {
+		return nil, fmt.Errorf(constants.MsgStatementInvalidFormat, "QIF", fmt.Sprintf("record at line %d is not closed with ^", recordLine))
+	}
+
+	return collectEntries(entries, fieldValidator)
+}
+
+// qifEntry maps the fields of one QIF record to a statement entry
+func qifEntry(fields map[byte]string, account string, line int, occurrences map[string]int) (statementEntry, error) {
+	timestamp, err := parseQIFDate(fields['D'])
+	if err != nil {
+		return statementEntry{}, fmt.Errorf(constants.MsgStatementInvalidDate, line, err)
+	}
+
+	rawAmount := fields['T']
+	if rawAmount == "" {
+		rawAmount = fields['U']
+	}
+	amount, negative := splitAmount(rawAmount)
+
+	name := fields['P']
+	if name == "" {
+		name = fields['M']
+	}
+	if name == "" && fields['N'] != "" {
+		name = "CHECK " + fields['N']
+	}
+	if name == "" {
+		name = "QIF"
+	}
+
+	return statementEntry{
+		line:        line,
+		timestamp:   timestamp,
+		name:        name,
+		amount:      amount,
+		description: fields['M'],
+		negative:    negative,
+		externalID:  contentID("qif:", occurrences, account, strconv.FormatInt(timestamp, 10), rawAmount, fields['P'], fields['M'], fields['N']),
+	}, nil
+}
+
+// parseQIFDate parses a QIF date to a Unix timestamp at midnight UTC
+// QIF dates are month first (1/15/2024, 01/15/24, 1/15'24) unless written year first (2024-01-15)
+// or with dots (15.01.2024); two digit years after an apostrophe or below 70 are in the 2000s
+func parseQIFDate(value string) (int64, error) {
+	date := strings.ReplaceAll(value, " ", "")
+	parts := strings.FieldsFunc(date, func(r rune) bool {
+		return r == '/' || r == '-' || r == '.' || r == '\''
+	})
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("unsupported date %q", value)
+	}
+
+	numbers := make([]int, 3)
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return 0, fmt.Errorf("unsupported date %q", value)
+		}
+		numbers[i] = n
+	}
+
+	var year, month, day int
+	switch {
+	case len(parts[0]) == 4:
+		year, month, day = numbers[0], numbers[1], numbers[2]
+	case strings.Contains(date, "."):
+		day, month, year = numbers[0], numbers[1], numbers[2]
+	default:
+		month, day, year = numbers[0], numbers[1], numbers[2]
+	}
+	if year < 100 {
+		if strings.Contains(date, "'") || year < 70 {
+			year += 2000
+		} else {
+			year += 1900
+		}
+	}
+
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if t.Year() != year || int(t.Month()) != month || t.Day() != day {
+		return 0, fmt.Errorf("invalid date %q", value)
+	}
+	return t.Unix(), nil
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+)
+
+// TestParseQIFWithValidation tests type, amount sign, date and memo mapping and the record hash used as external ID
+func TestParseQIFWithValidation(t *testing.T) {
+	content := `!Account
+NChecking
+TBank
+^
+!Type:Bank
+D1/15'24
+T-1,234.56
+PGROCERY CO
+Mweekly shop
+^
+D01/16/2024
+T2500
+MSALARY JAN
+^
+D1/15'24
+T-1,234.56
+PGROCERY CO
+Mweekly shop
+^
+`
+	repo := NewRepository()
+	transactions, err := repo.ParseQIFWithValidation(context.Background(), strings.NewReader(content), validator.NewFieldValidator())
+	if err != nil {
+		t.Fatalf("ParseQIFWithValidation failed: %v", err)
+	}
+
+	if len(transactions) != 3 {
+		t.Fatalf("Expected identical records to stay separate, got %d transactions", len(transactions))
+	}
+	grocery := transactions[0]
+	if grocery.Type != schemas.TypeDebit || grocery.Amount != 123456 || grocery.Name != "GROCERY CO" || grocery.Description != "weekly shop" || grocery.Timestamp != 1705276800 {
+		t.Errorf("Unexpected debit: %+v", grocery)
+	}
+	if transactions[1].Type != schemas.TypeCredit || transactions[1].Name != "SALARY JAN" || transactions[1].Timestamp != 1705363200 {
+		t.Errorf("Unexpected credit: %+v", transactions[1])
+	}
+	if *grocery.ExternalID == *transactions[2].ExternalID {
+		t.Error("Expected repeated records to get different external IDs")
+	}
+
+	// The same file uploaded again gives the same external IDs
+	again, _ := repo.ParseQIFWithValidation(context.Background(), strings.NewReader(content), validator.NewFieldValidator())
+	if *again[2].ExternalID != *transactions[2].ExternalID {
+		t.Error("Expected stable external IDs across uploads")
+	}
+}
+
+// TestParseQIFDate tests the QIF date variants
+func TestParseQIFDate(t *testing.T) {
+	tests := map[string]int64{
+		"1/15'24":    1705276800,
+		"01/15/2024": 1705276800,
+		"1/15/24":    1705276800,
+		"1/15/99":    916358400,
+		"2024-01-15": 1705276800,
+		"15.01.2024": 1705276800,
+		" 1/15' 4":   1074124800,
+	}
+	for input, expected := range tests {
+		got, err := parseQIFDate(input)
+		if err != nil || got != expected {
+			t.Errorf("parseQIFDate(%q) = %d, %v, expected %d", input, got, err, expected)
+		}
+	}
+
+	for _, input := range []string{"", "2/30/2024", "15 January"} {
+		if _, err := parseQIFDate(input); err == nil {
+			t.Errorf("parseQIFDate(%q): expected error", input)
+		}
+	}
+}
+
+// TestParseQIFErrors tests unsupported sections and line accurate errors
+func TestParseQIFErrors(t *testing.T) {
+	repo := NewRepository()
+	fieldValidator := validator.NewFieldValidator()
+
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{"investment account", "!Type:Invst\nD1/15/24\n^\n", "unsupported section"},
+		{"bad date", "!Type:Bank\nD1/15/24\nT1\nPA\n^\nD13/45/24\nT1\nPB\n^\n", "Invalid date at line 6"},
+		{"bad amount", "!Type:Bank\nD1/15/24\nTabc\nPA\n^\n", "line 2 (amount)"},
+		{"unclosed", "!Type:Bank\nD1/15/24\nT1\nPA\n", "not closed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := repo.ParseQIFWithValidation(context.Background(), strings.NewReader(tt.content), fieldValidator)
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("Expected error containing %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/csv"
 	"fmt"
 	"io"
+	"math"
 	"strconv"
 	"strings"
 
@@ -20,6 +21,13 @@ type IRepository interface {
 	// Commands
 	ParseCSV(ctx context.Context, file io.Reader) ([]schemas.Transaction, error)
 	ParseCSVWithValidation(ctx context.Context, file io.Reader, fieldValidator *validator.FieldValidator) ([]schemas.Transaction, error)
+	ParseDelimitedWithValidation(ctx context.Context, file io.Reader, fieldValidator *validator.FieldValidator, opts schemas.ParseOptions) ([]schemas.Transaction, error)
+	ParseJSONWithValidation(ctx context.Context, file io.Reader, fieldValidator *validator.FieldValidator) ([]schemas.Transaction, error)
+	ParseNDJSONWithValidation(ctx context.Context, file io.Reader, fieldValidator *validator.FieldValidator) ([]schemas.Transaction, error)
+	ParseOFXWithValidation(ctx context.Context, file io.Reader, fieldValidator *validator.FieldValidator) ([]schemas.Transaction, error)
+	ParseQIFWithValidation(ctx context.Context, file io.Reader, fieldValidator *validator.FieldValidator) ([]schemas.Transaction, error)
+	ParseCAMT053WithValidation(ctx context.Context, file io.Reader, fieldValidator *validator.FieldValidator) (*schemas.Statement, error)
+	ParseMT940WithValidation(ctx context.Context, file io.Reader, fieldValidator *validator.FieldValidator) (*schemas.Statement, error)
 
 	// Queries
 }
@@ -94,7 +102,7 @@ func (r *Repository) ParseCSV(ctx context.Context, file io.Reader) ([]schemas.Tr
 			return nil, fmt.Errorf(constants.MsgCSVInvalidAmount, lineNum, err)
 		}
 		// Convert to cents (multiply by 100 to preserve decimals as integers)
-		amount := int64(amountFloat * 100)
+		amount := int64(math.Round(amountFloat * 100))
 
 		status := strings.TrimSpace(record[4])
 		status = strings.ToUpper(status)
@@ -149,19 +157,34 @@ func (r *Repository) ParseCSV(ctx context.Context, file io.Reader) ([]schemas.Tr
 }
 
 // ParseCSVWithValidation parses a CSV file with field validation
+// The delimiter is sniffed from the first lines and the decimal separator from the amounts
 func (r *Repository) ParseCSVWithValidation(ctx context.Context, file io.Reader, fieldValidator *validator.FieldValidator) ([]schemas.Transaction, error) {
+	return r.ParseDelimitedWithValidation(ctx, file, fieldValidator, schemas.ParseOptions{})
+}
+
+// ParseDelimitedWithValidation parses a delimited UTF-8 text file with field validation
+// An empty delimiter is sniffed from the first lines and an empty decimal separator from the amounts
+func (r *Repository) ParseDelimitedWithValidation(ctx context.Context, file io.Reader, fieldValidator *validator.FieldValidator, opts schemas.ParseOptions) ([]schemas.Transaction, error) {
 	// Read all content from the file
 	content, err := io.ReadAll(file)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", constants.MsgFailedToReadFile, err)
 	}
 
+	delimiter := opts.Delimiter
+	if delimiter == "" {
+		delimiter = sniffDelimiter(content)
+	}
+
 	// Create CSV reader
 	reader := csv.NewReader(bytes.NewReader(content))
+	reader.Comma = []rune(delimiter)[0]
 	reader.TrimLeadingSpace = true
 
-	var transactions []schemas.Transaction
-	seenTransactions := make(map[string]bool) // Track duplicates
+	// Read every record first, as the decimal separator is sniffed from all amounts
+	var records [][]string
+	var lines []int
+	var amounts []string
 	lineNum := 0
 	headerSkipped := false
 
@@ -187,65 +210,48 @@ func (r *Repository) ParseCSVWithValidation(ctx context.Context, file io.Reader,
 			continue
 		}
 
-		// Validate field count
-		if err := fieldValidator.ValidateFieldCount(record); err != nil {
-			return nil, fmt.Errorf(constants.MsgCSVValidationError, lineNum, err)
+		records = append(records, record)
+		lines = append(lines, lineNum)
+		if len(record) > 3 {
+			amounts = append(amounts, record[3])
 		}
+	}
 
-		// Validate each field using field validator
-		if err := fieldValidator.ValidateTimestamp(record[0]); err != nil {
-			return nil, fmt.Errorf(constants.MsgCSVValidationErrorField, lineNum, "timestamp", err)
+	decimal := opts.DecimalSeparator
+	if decimal == "" {
+		if decimal, err = sniffDecimalSeparator(amounts); err != nil {
+			return nil, err
 		}
+	}
 
-		if err := fieldValidator.ValidateName(record[1]); err != nil {
-			return nil, fmt.Errorf(constants.MsgCSVValidationErrorField, lineNum, "name", err)
-		}
+	var transactions []schemas.Transaction
+	seenTransactions := make(map[string]bool) // Track duplicates
 
-		if err := fieldValidator.ValidateTransactionType(record[2]); err != nil {
-			return nil, fmt.Errorf(constants.MsgCSVValidationErrorField, lineNum, "type", err)
-		}
+	for i, record := range records {
+		lineNum := lines[i]
 
-		if err := fieldValidator.ValidateAmount(record[3]); err != nil {
-			return nil, fmt.Errorf(constants.MsgCSVValidationErrorField, lineNum, "amount", err)
+		// Validate field count
+		if err := fieldValidator.ValidateFieldCount(record); err != nil {
+			return nil, fmt.Errorf(constants.MsgCSVValidationError, lineNum, err)
 		}
 
-		if err := fieldValidator.ValidateStatus(record[4]); err != nil {
-			return nil, fmt.Errorf(constants.MsgCSVValidationErrorField, lineNum, "status", err)
+		// Amounts written as 1.234,56 are read as 1234.56
+		if decimal == schemas.DecimalComma {
+			record[3] = pointDecimal(record[3])
 		}
 
-		if err := fieldValidator.ValidateDescription(record[5]); err != nil {
-			return nil, fmt.Errorf(constants.MsgCSVValidationErrorField, lineNum, "description", err)
+		// Validate each field using field validator
+		transaction, field, err := parseRecord(record, fieldValidator)
+		if err != nil {
+			return nil, fmt.Errorf(constants.MsgCSVValidationErrorField, lineNum, field, err)
 		}
 
-		// Parse fields
-		timestamp, _ := strconv.ParseInt(strings.TrimSpace(record[0]), 10, 64)
-		name := strings.TrimSpace(record[1])
-		txType := strings.ToUpper(strings.TrimSpace(record[2]))
-		// Parse amount as float to handle decimal values, then convert to int64 (cents)
-		amountFloat, _ := strconv.ParseFloat(strings.TrimSpace(record[3]), 64)
-		amount := int64(amountFloat * 100) // Convert to cents
-		status := strings.ToUpper(strings.TrimSpace(record[4]))
-		description := strings.TrimSpace(record[5])
-
-		// Create unique key for duplicate detection
-		duplicateKey := fmt.Sprintf("%d-%s-%s-%d-%s", timestamp, name, txType, amount, status)
-
 		// Check for duplicates
-		if seenTransactions[duplicateKey] {
+		key := duplicateKey(transaction)
+		if seenTransactions[key] {
 			continue // Skip duplicate transaction
 		}
-		seenTransactions[duplicateKey] = true
-
-		// Create transaction object
-		transaction := schemas.Transaction{
-			ID:          uuid.New().String(),
-			Timestamp:   timestamp,
-			Name:        name,
-			Type:        schemas.TransactionType(txType),
-			Amount:      amount,
-			Status:      schemas.TransactionStatus(status),
-			Description: description,
-		}
+		seenTransactions[key] = true
 
 		transactions = append(transactions, transaction)
 	}
@@ -255,4 +261,49 @@ func (r *Repository) ParseCSVWithValidation(ctx context.Context, file io.Reader,
 	}
 
 	return transactions, nil
-}
\ No newline at end of file
+}
+
+// parseRecord validates the six upload fields of a record with the field validator and converts them to a transaction
+// On error it also returns the name of the invalid field
+func parseRecord(record []string, fieldValidator *validator.FieldValidator) (schemas.Transaction, string, error) {
+	checks := []struct {
+		field    string
+		validate func(string) error
+	}{
+		{"timestamp", fieldValidator.ValidateTimestamp},
+		{"name", fieldValidator.ValidateName},
+		{"type", fieldValidator.ValidateTransactionType},
+		{"amount", fieldValidator.ValidateAmount},
+		{"status", fieldValidator.ValidateStatus},
+		{"description", fieldValidator.ValidateDescription},
+	}
+	for i, check := range checks {
+		if err := check.validate(record[i]); err != nil {
+			return schemas.Transaction{}, check.field, err
+		}
+	}
+
+	// Parse fields
+	timestamp, _ := fieldValidator.ParseTimestamp(record[0])
+	// Parse amount as float to handle decimal values, then convert to int64 (cents)
+	amountFloat, _ := strconv.ParseFloat(strings.TrimSpace(record[3]), 64)
+
+	return schemas.Transaction{
+		ID:          uuid.New().String(),
+		Timestamp:   timestamp,
+		Name:        strings.TrimSpace(record[1]),
+		Type:        schemas.TransactionType(strings.ToUpper(strings.TrimSpace(record[2]))),
+		Amount:      int64(math.Round(amountFloat * 100)), // Convert to cents; rounding keeps 0.29 from becoming 28
+		Status:      schemas.TransactionStatus(strings.ToUpper(strings.TrimSpace(record[4]))),
+		Description: strings.TrimSpace(record[5]),
+	}, "", nil
+}
+
+// duplicateKey identifies a transaction for duplicate detection within an upload
+// Transactions with an external ID, such as an OFX FITID, are identified by it alone
+func duplicateKey(t schemas.Transaction) string {
+	if t.ExternalID != nil {
+		return "external:" + *t.ExternalID
+	}
+	return fmt.Sprintf("%d-%s-%s-%d-%s", t.Timestamp, t.Name, t.Type, t.Amount, t.Status)
+}
@@ -11,6 +11,35 @@ import (
 	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
 )
 
+// TestParseRecordAmounts tests that amounts are rounded to the nearest cent rather than truncated
+func TestParseRecordAmounts(t *testing.T) {
+	fieldValidator := validator.NewFieldValidator()
+	tests := []struct {
+		amount   string
+		expected int64
+	}{
+		{"250000", 25000000},
+		{"0.29", 29},
+		{"4.35", 435},
+		{"2500.5", 250050},
+		// 1.005 is stored as 1.00499..., just under half a cent
+		{"1.005", 100},
+		{"1.006", 101},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.amount, func(t *testing.T) {
+			transaction, field, err := parseRecord([]string{"1624507883", "JOHN DOE", "DEBIT", tc.amount, "SUCCESS", ""}, fieldValidator)
+			if err != nil {
+				t.Fatalf("parseRecord failed on %s: %v", field, err)
+			}
+			if transaction.Amount != tc.expected {
+				t.Errorf("Expected %d cents, got %d", tc.expected, transaction.Amount)
+			}
+		})
+	}
+}
+
 // TestParseCSVValid tests parsing a valid CSV
 func TestParseCSVValid(t *testing.T) {
 	csvContent := `1624507883,JOHN DOE,DEBIT,250000,SUCCESS,restaurant
@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+	"github.com/google/uuid"
+)
+
+// statementEntry is one transaction read from a bank statement, before field validation
+// Entries are booked, and so successful, unless the statement gives another status
+type statementEntry struct {
+	line        int
+	timestamp   int64
+	name        string
+	amount      string
+	description string
+	negative    bool
+	debit       bool
+	externalID  string
+	currency    string
+	status      schemas.TransactionStatus
+}
+
+// toTransaction validates a statement entry with the CSV field rules and converts it to a transaction
+// A negative amount, or a debit type on banks that leave amounts unsigned, makes it a debit
+func (e statementEntry) toTransaction(fieldValidator *validator.FieldValidator) (schemas.Transaction, error) {
+	transactionType := schemas.TypeCredit
+	if e.negative || e.debit {
+		transactionType = schemas.TypeDebit
+	}
+
+	status := e.status
+	if status == "" {
+		status = schemas.StatusSuccess
+	}
+
+	record := []string{
+		strconv.FormatInt(e.timestamp, 10),
+		e.name,
+		string(transactionType),
+		e.amount,
+		string(status),
+		e.description,
+	}
+	transaction, field, err := parseRecord(record, fieldValidator)
+	if err != nil {
+		return transaction, fmt.Errorf(constants.MsgCSVValidationErrorField, e.line, field, err)
+	}
+
+	if e.externalID != "" {
+		externalID := e.externalID
+		transaction.ExternalID = &externalID
+	}
+	transaction.Currency = e.currency
+	return transaction, nil
+}
+
+// signedCents returns the entry amount in cents, negative for debits
+// Amounts that do not parse count as zero; field validation rejects them later
+func (e statementEntry) signedCents() int64 {
+	cents := toCents(e.amount)
+	if e.negative || e.debit {
+		return -cents
+	}
+	return cents
+}
+
+// toCents converts an unsigned decimal amount to cents, rounding to the nearest cent
+func toCents(amount string) int64 {
+	value, err := strconv.ParseFloat(strings.TrimSpace(amount), 64)
+	if err != nil {
+		return 0
+	}
+	return int64(math.Round(value * 100))
+}
+
+// contentID derives a stable external ID from the fields of an entry that has no bank reference
+// The count of earlier entries with the same fields keeps identical entries of one file apart
+func contentID(prefix string, occurrences map[string]int, fields ...string) string {
+	key := strings.Join(fields, "\x00")
+	occurrences[key]++
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s\x00%d", key, occurrences[key])))
+	return prefix + hex.EncodeToString(sum[:16])
+}
+
+// splitAmount turns a signed statement amount into the unsigned upload format and reports whether it was negative
+// Spaces and thousands separators are dropped, and a decimal comma becomes a point
+func splitAmount(value string) (string, bool) {
+	amount := strings.ReplaceAll(strings.TrimSpace(value), " ", "")
+	negative := strings.HasPrefix(amount, "-")
+	amount = strings.TrimLeft(amount, "+-")
+
+	comma, point := strings.LastIndex(amount, ","), strings.LastIndex(amount, ".")
+	if comma > point && len(amount)-comma-1 != 3 {
+		// Decimal comma; points, if any, group thousands
+		return strings.ReplaceAll(amount[:comma], ".", "") + "." + amount[comma+1:], negative
+	}
+	return strings.ReplaceAll(amount, ",", ""), negative
+}
+
+// collectEntries converts statement entries to transactions, skipping repeated external IDs
+func collectEntries(entries []statementEntry, fieldValidator *validator.FieldValidator) ([]schemas.Transaction, error) {
+	var transactions []schemas.Transaction
+	seenTransactions := make(map[string]bool) // Track duplicates
+
+	for _, entry := range entries {
+		transaction, err := entry.toTransaction(fieldValidator)
+		if err != nil {
+			return nil, err
+		}
+
+		key := duplicateKey(transaction)
+		if seenTransactions[key] {
+			continue // Skip duplicate transaction
+		}
+		seenTransactions[key] = true
+
+		transactions = append(transactions, transaction)
+	}
+
+	if len(transactions) == 0 {
+		return nil, errors.New(constants.MsgNoValidTransactions)
+	}
+
+	return transactions, nil
+}
+
+// statementBalances accumulates the balances of one statement while its entries are read
+type statementBalances struct {
+	statementID string
+	account     string
+	currency    string
+	opening     *int64
+	closing     *int64
+	booked      int64
+}
+
+// book adds a booked entry to the computed balance
+func (b *statementBalances) book(entry statementEntry) {
+	b.booked += entry.signedCents()
+}
+
+// check compares the closing balance with the opening balance plus the booked entries
+// Statements that leave out either balance cannot be checked and report false
+func (b *statementBalances) check() (schemas.StatementBalance, bool) {
+	if b.opening == nil || b.closing == nil {
+		return schemas.StatementBalance{}, false
+	}
+
+	computed := *b.opening + b.booked
+	return schemas.StatementBalance{
+		ID:          uuid.New().String(),
+		StatementID: b.statementID,
+		Account:     b.account,
+		Currency:    b.currency,
+		Opening:     *b.opening,
+		Closing:     *b.closing,
+		Computed:    computed,
+		Mismatch:    computed != *b.closing,
+	}, true
+}
+
+// collectStatement converts the entries of a statement file to transactions and checks the balances of each statement in it
+func collectStatement(entries []statementEntry, balances []*statementBalances, fieldValidator *validator.FieldValidator) (*schemas.Statement, error) {
+	transactions, err := collectEntries(entries, fieldValidator)
+	if err != nil {
+		return nil, err
+	}
+
+	statement := &schemas.Statement{Transactions: transactions}
+	for _, b := range balances {
+		if balance, ok := b.check(); ok {
+			statement.Balances = append(statement.Balances, balance)
+		}
+	}
+	return statement, nil
+}
@@ -2,93 +2,553 @@ package use_case
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
+	"path"
+	"time"
 
+	anomalyUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/anomaly/use_case"
+	auditSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	counterpartyUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/counterparty/use_case"
+	linkUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/link/use_case"
+	profileUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/profile/use_case"
+	ruleUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/rule/use_case"
 	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/repository"
 	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
 	uploadRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/upload/repository"
 	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/constants"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/requestmeta"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/textenc"
 	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // IUseCase defines the contract for upload use case operations
 type IUseCase interface {
-	ParseAndStore(ctx context.Context, file io.Reader) (*schemas.UploadResponse, error)
-	ParseAndStoreWithValidation(ctx context.Context, file io.Reader, fieldValidator *validator.FieldValidator) (*schemas.UploadResponse, error)
-	Clear(ctx context.Context) error
+	ParseAndStore(ctx context.Context, file io.Reader, opts schemas.UploadOptions) (*schemas.UploadResponse, error)
+	ParseAndStoreWithValidation(ctx context.Context, file io.Reader, fieldValidator *validator.FieldValidator, opts schemas.UploadOptions) (*schemas.UploadResponse, error)
+	ParseAndStoreArchive(ctx context.Context, files []schemas.UploadFile, fieldValidator *validator.FieldValidator, opts schemas.UploadOptions) (*schemas.ArchiveUploadResponse, error)
+	Clear(ctx context.Context) (*schemas.ClearResponse, error)
+	Restore(ctx context.Context, clearID string) (*schemas.RestoreResponse, error)
+	Purge(ctx context.Context, confirm string) (*schemas.PurgeResponse, error)
+	GetClearHistory(ctx context.Context) ([]schemas.ClearOperation, error)
+	GetBatches(ctx context.Context) ([]schemas.UploadBatch, error)
 }
 
 // UseCase implements IUseCase
 type UseCase struct {
 	uploadRepo      uploadRepo.IRepository
 	transactionRepo repository.IRepository
+	audit           auditUseCase.IUseCase
+	rules           ruleUseCase.IUseCase
+	counterparties  counterpartyUseCase.IUseCase
+	anomalies       anomalyUseCase.IUseCase
+	links           linkUseCase.IUseCase
+	profiles        profileUseCase.IUseCase
+	clearRetention  time.Duration
+	now             func() time.Time
 }
 
 // NewUseCase creates a new upload use case instance
-func NewUseCase(uploadRepo uploadRepo.IRepository, transactionRepo repository.IRepository) IUseCase {
+// clearRetention is how long a clear can still be restored
+func NewUseCase(uploadRepo uploadRepo.IRepository, transactionRepo repository.IRepository, audit auditUseCase.IUseCase, rules ruleUseCase.IUseCase, counterparties counterpartyUseCase.IUseCase, anomalies anomalyUseCase.IUseCase, links linkUseCase.IUseCase, profiles profileUseCase.IUseCase, clearRetention time.Duration) IUseCase {
 	return &UseCase{
 		uploadRepo:      uploadRepo,
 		transactionRepo: transactionRepo,
+		audit:           audit,
+		rules:           rules,
+		counterparties:  counterparties,
+		anomalies:       anomalies,
+		links:           links,
+		profiles:        profiles,
+		clearRetention:  clearRetention,
+		now:             time.Now,
 	}
 }
 
 // ParseAndStore parses CSV file and stores transactions (without field validation)
-func (uc *UseCase) ParseAndStore(ctx context.Context, file io.Reader) (*schemas.UploadResponse, error) {
+func (uc *UseCase) ParseAndStore(ctx context.Context, file io.Reader, opts schemas.UploadOptions) (*schemas.UploadResponse, error) {
 	// Parse CSV
 	transactions, err := uc.uploadRepo.ParseCSV(ctx, file)
+	if err != nil {
+		return nil, invalidFile(ctx, err)
+	}
+
+	return uc.store(ctx, transactions, nil, opts)
+}
+
+// ParseAndStoreWithValidation parses a CSV, JSON, NDJSON, OFX, QIF, camt.053 or MT940 file with field validation and stores transactions
+// Bank statements also have their balances checked, and the checks are stored with the upload batch.
+// Text is converted to UTF-8 first; camt.053 files declare their own encoding and are left to the XML decoder
+func (uc *UseCase) ParseAndStoreWithValidation(ctx context.Context, file io.Reader, fieldValidator *validator.FieldValidator, opts schemas.UploadOptions) (*schemas.UploadResponse, error) {
+	opts, err := uc.resolveOptions(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Format != validator.FormatCAMT053 {
+		file, _, err = textenc.NewReader(file, opts.Encoding)
+		if err != nil {
+			return nil, invalidFile(ctx, err)
+		}
+	}
+
+	switch opts.Format {
+	case validator.FormatCAMT053, validator.FormatMT940:
+		parseStatement := uc.uploadRepo.ParseCAMT053WithValidation
+		if opts.Format == validator.FormatMT940 {
+			parseStatement = uc.uploadRepo.ParseMT940WithValidation
+		}
+
+		statement, err := parseStatement(ctx, file, fieldValidator)
+		if err != nil {
+			return nil, invalidFile(ctx, err)
+		}
+		return uc.store(ctx, statement.Transactions, statement.Balances, opts)
+	}
+
+	// Parse with field validation, using the parser of the file format
+	// CSV is split on the chosen or sniffed delimiter, with amounts read in the chosen or implied decimal format
+	parse := func(ctx context.Context, file io.Reader, fieldValidator *validator.FieldValidator) ([]schemas.Transaction, error) {
+		return uc.uploadRepo.ParseDelimitedWithValidation(ctx, file, fieldValidator, opts.ParseOptions)
+	}
+	switch opts.Format {
+	case validator.FormatJSON:
+		parse = uc.uploadRepo.ParseJSONWithValidation
+	case validator.FormatNDJSON:
+		parse = uc.uploadRepo.ParseNDJSONWithValidation
+	case validator.FormatOFX:
+		parse = uc.uploadRepo.ParseOFXWithValidation
+	case validator.FormatQIF:
+		parse = uc.uploadRepo.ParseQIFWithValidation
+	}
+
+	transactions, err := parse(ctx, file, fieldValidator)
+	if err != nil {
+		return nil, invalidFile(ctx, err)
+	}
+
+	return uc.store(ctx, transactions, nil, opts)
+}
+
+// ParseAndStoreArchive parses and stores each file of an uploaded archive as an upload batch of its own
+// A file that fails is reported in its result and does not stop the others; batch filenames are prefixed with the archive name
+func (uc *UseCase) ParseAndStoreArchive(ctx context.Context, files []schemas.UploadFile, fieldValidator *validator.FieldValidator, opts schemas.UploadOptions) (*schemas.ArchiveUploadResponse, error) {
+	// Resolve the profile once, so a missing profile fails the archive rather than each of its files
+	opts, err := uc.resolveOptions(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &schemas.ArchiveUploadResponse{
+		TotalFiles: len(files),
+		Files:      make([]schemas.ArchiveFileResult, 0, len(files)),
+	}
+
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		result := schemas.ArchiveFileResult{Filename: file.Filename, Format: file.Format}
+		upload, err := uc.storeArchiveFile(ctx, file, fieldValidator, opts)
+		switch {
+		case err != nil:
+			result.Status = schemas.ArchiveFileFailed
+			result.Error = err.Error()
+			response.FailedFiles++
+		case upload.BatchID == "":
+			result.Status = schemas.ArchiveFileSkipped
+			result.Result = upload
+			response.SkippedFiles++
+		default:
+			result.Status = schemas.ArchiveFileStored
+			result.Result = upload
+			response.StoredFiles++
+			response.TotalRecords += upload.TotalRecords
+		}
+		response.Files = append(response.Files, result)
+	}
+
+	switch {
+	case response.FailedFiles == 0:
+		response.Message = constants.MsgArchiveUploadSuccess
+	case response.FailedFiles < response.TotalFiles:
+		response.Message = constants.MsgArchivePartialFailure
+	default:
+		response.Message = constants.MsgArchiveUploadFailed
+	}
+	return response, nil
+}
+
+// invalidFile marks an error reading or validating an uploaded file as ErrInvalidUploadFile, unless the upload was
+// cancelled, so callers can tell a file to fix from a failure to store it
+func invalidFile(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return err
+	}
+	return fmt.Errorf("%w: %w", schemas.ErrInvalidUploadFile, err)
+}
+
+// resolveOptions applies the import profile named in the upload options and checks the parse options
+// Options given with the upload take precedence over those of the profile
+func (uc *UseCase) resolveOptions(ctx context.Context, opts schemas.UploadOptions) (schemas.UploadOptions, error) {
+	if opts.Profile != "" {
+		profile, err := uc.profiles.ResolveProfile(ctx, opts.Profile)
+		if err != nil {
+			return opts, err
+		}
+		opts.ParseOptions = profile.ParseOptions.Merge(opts.ParseOptions)
+		if opts.Source == "" {
+			opts.Source = profile.Source
+		}
+		opts.Profile = ""
+	}
+
+	options, err := opts.ParseOptions.Normalize()
+	if err != nil {
+		return opts, err
+	}
+	opts.ParseOptions = options
+	return opts, nil
+}
+
+// storeArchiveFile parses and stores one file of an archive
+func (uc *UseCase) storeArchiveFile(ctx context.Context, file schemas.UploadFile, fieldValidator *validator.FieldValidator, opts schemas.UploadOptions) (*schemas.UploadResponse, error) {
+	if file.Err != nil {
+		return nil, file.Err
+	}
+
+	reader, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	fileOpts := opts
+	fileOpts.Filename = path.Join(opts.Filename, file.Filename)
+	fileOpts.Format = file.Format
+	return uc.ParseAndStoreWithValidation(ctx, reader, fieldValidator, fileOpts)
+}
+
+// store links parsed transactions to counterparties, runs the categorisation rules over them,
+// stores them with their tags and statement balance checks under a new upload batch, flags anomalies,
+// suggests refund links and records the upload in the audit log
+// All of it happens in one database transaction, so a failed step leaves nothing of the upload behind
+func (uc *UseCase) store(ctx context.Context, transactions []schemas.Transaction, balances []schemas.StatementBalance, opts schemas.UploadOptions) (*schemas.UploadResponse, error) {
+	var response *schemas.UploadResponse
+	err := uc.transactionRepo.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		response, err = uc.storeBatch(ctx, transactions, balances, opts)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// storeBatch runs the steps of store in the transaction ctx carries
+func (uc *UseCase) storeBatch(ctx context.Context, transactions []schemas.Transaction, balances []schemas.StatementBalance, opts schemas.UploadOptions) (*schemas.UploadResponse, error) {
+	source, err := schemas.NormalizeSource(opts.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	// Skip rows whose bank reference was stored by an earlier upload
+	transactions, skipped, err := uc.skipUploaded(ctx, transactions)
+	if err != nil {
+		return nil, err
+	}
+	if len(transactions) == 0 {
+		return &schemas.UploadResponse{
+			Message:           constants.MsgNoNewTransactions,
+			SkippedDuplicates: skipped,
+			BalanceMismatch:   balanceMismatch(balances),
+			StatementBalances: balances,
+		}, nil
+	}
+
+	batch := &schemas.UploadBatch{
+		ID:           uuid.New().String(),
+		Source:       source,
+		Filename:     opts.Filename,
+		TotalRecords: len(transactions),
+		UploadedBy:   requestmeta.FromContext(ctx).Actor,
+		CreatedAt:    uc.now().UTC(),
+	}
+	for i := range transactions {
+		transactions[i].BatchID = &batch.ID
+	}
+	for i := range balances {
+		balances[i].BatchID = batch.ID
+		balances[i].CreatedAt = batch.CreatedAt
+	}
+	batch.Balances = balances
+	batch.BalanceMismatch = balanceMismatch(balances)
+
+	// Link counterparties by name
+	newCounterparties, err := uc.counterparties.Link(ctx, transactions)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply categorisation rules
+	tags, err := uc.rules.Categorize(ctx, transactions)
 	if err != nil {
 		return nil, err
 	}
 
 	// Store transactions in database
+	if err := uc.transactionRepo.CreateUploadBatch(ctx, batch); err != nil {
+		return nil, err
+	}
+
 	err = uc.transactionRepo.CreateBatch(ctx, transactions)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := uc.transactionRepo.CreateTags(ctx, tags); err != nil {
+		return nil, err
+	}
+
+	// Flag suspicious rows against earlier uploads
+	flagged, err := uc.anomalies.Detect(ctx, batch.ID, transactions)
+	if err != nil {
+		return nil, err
+	}
+
+	// Suggest the original debits of uploaded refunds
+	suggestedLinks, err := uc.links.Suggest(ctx, transactions)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.recordUpload(ctx, batch, transactions, flagged); err != nil {
+		return nil, err
+	}
+
+	categorized := 0
+	for _, t := range transactions {
+		if t.CategoryID != nil {
+			categorized++
+		}
+	}
+
 	// Count transactions by status
 	successCount, _ := uc.transactionRepo.CountByStatus(ctx, schemas.StatusSuccess)
 	failedCount, _ := uc.transactionRepo.CountByStatus(ctx, schemas.StatusFailed)
 	pendingCount, _ := uc.transactionRepo.CountByStatus(ctx, schemas.StatusPending)
 
+	message := constants.MsgUploadSuccess
+	if batch.BalanceMismatch {
+		message = constants.MsgBalanceMismatch
+	}
+
 	return &schemas.UploadResponse{
-		Message:        constants.MsgUploadSuccess,
-		TotalRecords:   len(transactions),
-		SuccessRecords: int(successCount),
-		FailedRecords:  int(failedCount),
-		PendingRecords: int(pendingCount),
+		Message:            message,
+		TotalRecords:       len(transactions),
+		SuccessRecords:     int(successCount),
+		FailedRecords:      int(failedCount),
+		PendingRecords:     int(pendingCount),
+		CategorizedRecords: categorized,
+		NewCounterparties:  newCounterparties,
+		FlaggedRecords:     flagged,
+		SuggestedLinks:     suggestedLinks,
+		SkippedDuplicates:  skipped,
+		BatchID:            batch.ID,
+		BalanceMismatch:    batch.BalanceMismatch,
+		StatementBalances:  balances,
 	}, nil
 }
 
-// ParseAndStoreWithValidation parses CSV file with field validation and stores transactions
-func (uc *UseCase) ParseAndStoreWithValidation(ctx context.Context, file io.Reader, fieldValidator *validator.FieldValidator) (*schemas.UploadResponse, error) {
-	// Parse CSV with field validation
-	transactions, err := uc.uploadRepo.ParseCSVWithValidation(ctx, file, fieldValidator)
+// balanceMismatch reports whether any statement closing balance differs from its computed balance
+func balanceMismatch(balances []schemas.StatementBalance) bool {
+	for _, balance := range balances {
+		if balance.Mismatch {
+			return true
+		}
+	}
+	return false
+}
+
+// skipUploaded drops transactions whose external ID, such as an OFX FITID, is already stored
+// It returns the remaining transactions and how many were dropped
+func (uc *UseCase) skipUploaded(ctx context.Context, transactions []schemas.Transaction) ([]schemas.Transaction, int, error) {
+	var externalIDs []string
+	for _, t := range transactions {
+		if t.ExternalID != nil {
+			externalIDs = append(externalIDs, *t.ExternalID)
+		}
+	}
+	if len(externalIDs) == 0 {
+		return transactions, 0, nil
+	}
+
+	existing, err := uc.transactionRepo.FindExternalIDs(ctx, externalIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	kept := transactions[:0]
+	for _, t := range transactions {
+		if t.ExternalID == nil || !existing[*t.ExternalID] {
+			kept = append(kept, t)
+		}
+	}
+	return kept, len(transactions) - len(kept), nil
+}
+
+// recordUpload appends an audit event for a stored upload
+// The after hash covers the stored rows, so the event pins down exactly what was imported
+func (uc *UseCase) recordUpload(ctx context.Context, batch *schemas.UploadBatch, transactions []schemas.Transaction, flagged int) error {
+	return uc.audit.Record(ctx, auditSchemas.AuditEntry{
+		Action:     auditSchemas.ActionUpload,
+		TargetType: auditSchemas.TargetTransactions,
+		TargetID:   batch.ID,
+		After:      transactions,
+		Detail: map[string]interface{}{
+			"total_records":    len(transactions),
+			"flagged_records":  flagged,
+			"source":           batch.Source,
+			"filename":         batch.Filename,
+			"balance_mismatch": batch.BalanceMismatch,
+		},
+	})
+}
+
+// Clear soft-deletes all transactions and records who cleared them and when
+// The rows, the clear operation and the audit event are written in one database transaction
+func (uc *UseCase) Clear(ctx context.Context) (*schemas.ClearResponse, error) {
+	meta := requestmeta.FromContext(ctx)
+	operation := &schemas.ClearOperation{
+		ID:        uuid.New().String(),
+		ClearedBy: meta.Actor,
+		ClientIP:  meta.ClientIP,
+		ClearedAt: uc.now().UTC(),
+	}
+
+	err := uc.transactionRepo.Transaction(ctx, func(ctx context.Context) error {
+		if err := uc.transactionRepo.SoftDeleteAll(ctx, operation); err != nil {
+			return err
+		}
+
+		return uc.audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionClear,
+			TargetType: auditSchemas.TargetClearOperation,
+			TargetID:   operation.ID,
+			After:      operation,
+			Detail:     map[string]interface{}{"affected_rows": operation.AffectedRows},
+		})
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// Store transactions in database
-	err = uc.transactionRepo.CreateBatch(ctx, transactions)
+	return &schemas.ClearResponse{
+		Message:         constants.MsgAllTransactionsDeleted,
+		Operation:       operation,
+		RestorableUntil: operation.ClearedAt.Add(uc.clearRetention).Format(time.RFC3339),
+	}, nil
+}
+
+// Restore undoes a clear operation within the retention window
+// An empty clearID restores the latest clear that was not restored or purged
+// The lookup, the restore and the audit event run in one database transaction, so a clear is restored at most once
+func (uc *UseCase) Restore(ctx context.Context, clearID string) (*schemas.RestoreResponse, error) {
+	var operation *schemas.ClearOperation
+	var restored int64
+	err := uc.transactionRepo.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		if clearID == "" {
+			operation, err = uc.transactionRepo.FindLatestClearOperation(ctx)
+		} else {
+			operation, err = uc.transactionRepo.FindClearOperation(ctx, clearID)
+		}
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return schemas.ErrNothingToRestore
+		}
+		if err != nil {
+			return err
+		}
+
+		if operation.RestoredAt != nil || operation.PurgedAt != nil {
+			return schemas.ErrNothingToRestore
+		}
+
+		now := uc.now().UTC()
+		if now.After(operation.ClearedAt.Add(uc.clearRetention)) {
+			return schemas.ErrRestoreWindowExpired
+		}
+
+		operation.RestoredBy = requestmeta.FromContext(ctx).Actor
+		operation.RestoredAt = &now
+
+		restored, err = uc.transactionRepo.RestoreClear(ctx, operation)
+		if err != nil {
+			return err
+		}
+
+		return uc.audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionRestore,
+			TargetType: auditSchemas.TargetClearOperation,
+			TargetID:   operation.ID,
+			After:      operation,
+			Detail:     map[string]interface{}{"restored_count": restored},
+		})
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// Count transactions by status
-	successCount, _ := uc.transactionRepo.CountByStatus(ctx, schemas.StatusSuccess)
-	failedCount, _ := uc.transactionRepo.CountByStatus(ctx, schemas.StatusFailed)
-	pendingCount, _ := uc.transactionRepo.CountByStatus(ctx, schemas.StatusPending)
+	return &schemas.RestoreResponse{
+		Message:       constants.MsgTransactionsRestored,
+		Operation:     operation,
+		RestoredCount: restored,
+	}, nil
+}
 
-	return &schemas.UploadResponse{
-		Message:        constants.MsgUploadSuccess,
-		TotalRecords:   len(transactions),
-		SuccessRecords: int(successCount),
-		FailedRecords:  int(failedCount),
-		PendingRecords: int(pendingCount),
+// Purge hard-deletes all soft-deleted transactions once explicitly confirmed
+// The purge and its audit event are written in one database transaction
+func (uc *UseCase) Purge(ctx context.Context, confirm string) (*schemas.PurgeResponse, error) {
+	if confirm != schemas.PurgeConfirmation {
+		return nil, schemas.ErrPurgeNotConfirmed
+	}
+
+	var purged int64
+	err := uc.transactionRepo.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		purged, err = uc.transactionRepo.PurgeDeleted(ctx, requestmeta.FromContext(ctx).Actor, uc.now().UTC())
+		if err != nil {
+			return err
+		}
+
+		return uc.audit.Record(ctx, auditSchemas.AuditEntry{
+			Action:     auditSchemas.ActionPurge,
+			TargetType: auditSchemas.TargetTransactions,
+			Detail:     map[string]interface{}{"purged_count": purged},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &schemas.PurgeResponse{
+		Message:     constants.MsgTransactionsPurged,
+		PurgedCount: purged,
 	}, nil
 }
 
-// Clear deletes all transactions from database
-func (uc *UseCase) Clear(ctx context.Context) error {
-	return uc.transactionRepo.DeleteAll(ctx)
+// GetClearHistory retrieves the most recent clear operations
+func (uc *UseCase) GetClearHistory(ctx context.Context) ([]schemas.ClearOperation, error) {
+	return uc.transactionRepo.FindClearOperations(ctx, 50)
+}
+
+// GetBatches retrieves the most recent upload batches
+func (uc *UseCase) GetBatches(ctx context.Context) ([]schemas.UploadBatch, error) {
+	return uc.transactionRepo.FindUploadBatches(ctx, 50)
 }
@@ -0,0 +1,146 @@
+package use_case
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	anomalyRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/anomaly/repository"
+	anomalyUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/anomaly/use_case"
+	auditRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/repository"
+	auditSchemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	counterpartyRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/counterparty/repository"
+	counterpartyUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/counterparty/use_case"
+	linkRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/link/repository"
+	linkUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/link/use_case"
+	profileRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/profile/repository"
+	profileUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/profile/use_case"
+	ruleRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/rule/repository"
+	ruleUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/rule/use_case"
+	transactionRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	uploadRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/upload/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+const testCSV = "timestamp,name,type,amount,status,description\n" +
+	"1624507883,JOHN DOE,DEBIT,250000,SUCCESS,restaurant\n" +
+	"1624608050,E-COMMERCE A,DEBIT,150000,FAILED,online purchase\n"
+
+// setupTestUseCase creates an upload use case wired like the upload handler, backed by an in-memory SQLite database
+func setupTestUseCase(t *testing.T) (IUseCase, *gorm.DB) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to setup test database: %v", err)
+	}
+
+	if err := db.AutoMigrate(&schemas.Transaction{}, &schemas.TransactionTag{}, &schemas.TransactionFlag{}, &schemas.Rule{}, &schemas.Counterparty{}, &schemas.CounterpartyAlias{}, &schemas.UploadBatch{}, &schemas.StatementBalance{}, &schemas.ImportProfile{}, &schemas.LinkSuggestion{}, &auditSchemas.AuditEvent{}); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	transactions := transactionRepo.NewRepository(db)
+	audit := auditUseCase.NewUseCase(auditRepo.NewRepository(db))
+	policy, err := schemas.NewAnomalyPolicy(schemas.OutlierMethodZScore, 3, 1.5, 5, 0.25, 0, "0-5", "UTC")
+	if err != nil {
+		t.Fatalf("failed to create anomaly policy: %v", err)
+	}
+
+	uc := NewUseCase(
+		uploadRepo.NewRepository(),
+		transactions,
+		audit,
+		ruleUseCase.NewUseCase(ruleRepo.NewRepository(db), audit),
+		counterpartyUseCase.NewUseCase(counterpartyRepo.NewRepository(db), transactions, audit, 0.85),
+		anomalyUseCase.NewUseCase(anomalyRepo.NewRepository(db), policy),
+		linkUseCase.NewUseCase(linkRepo.NewRepository(db), audit, 30*24*time.Hour, 72*time.Hour),
+		profileUseCase.NewUseCase(profileRepo.NewRepository(db), audit),
+		time.Hour,
+	)
+	return uc, db
+}
+
+// TestParseAndStoreWithValidation tests that an upload is stored under a batch with its counterparties
+func TestParseAndStoreWithValidation(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+
+	response, err := uc.ParseAndStoreWithValidation(context.Background(), strings.NewReader(testCSV), validator.NewFieldValidator(), schemas.UploadOptions{Filename: "jan.csv"})
+	if err != nil {
+		t.Fatalf("ParseAndStoreWithValidation failed: %v", err)
+	}
+	if response.TotalRecords != 2 || response.NewCounterparties != 2 || response.BatchID == "" {
+		t.Errorf("Unexpected response: %+v", response)
+	}
+
+	var stored int64
+	db.Model(&schemas.Transaction{}).Where("batch_id = ?", response.BatchID).Count(&stored)
+	if stored != 2 {
+		t.Errorf("Expected 2 stored transactions, got %d", stored)
+	}
+}
+
+// TestParseAndStoreInvalidFile tests that a file failing validation is marked invalid and a cancelled upload is not
+func TestParseAndStoreInvalidFile(t *testing.T) {
+	uc, _ := setupTestUseCase(t)
+	invalid := "timestamp,name,type,amount,status,description\n1624507883,JOHN DOE,TRANSFER,250000,SUCCESS,restaurant\n"
+
+	_, err := uc.ParseAndStoreWithValidation(context.Background(), strings.NewReader(invalid), validator.NewFieldValidator(), schemas.UploadOptions{})
+	if !errors.Is(err, schemas.ErrInvalidUploadFile) {
+		t.Errorf("Expected ErrInvalidUploadFile, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = uc.ParseAndStoreWithValidation(ctx, strings.NewReader(testCSV), validator.NewFieldValidator(), schemas.UploadOptions{})
+	if err == nil || errors.Is(err, schemas.ErrInvalidUploadFile) {
+		t.Errorf("Expected a cancelled upload not to be an invalid file, got %v", err)
+	}
+}
+
+// TestParseAndStoreRollsBack tests that a failing step leaves nothing of the upload behind
+func TestParseAndStoreRollsBack(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+
+	// The audit record is the last step
+	if err := db.Migrator().DropTable(&auditSchemas.AuditEvent{}); err != nil {
+		t.Fatalf("failed to drop audit table: %v", err)
+	}
+
+	if _, err := uc.ParseAndStoreWithValidation(context.Background(), strings.NewReader(testCSV), validator.NewFieldValidator(), schemas.UploadOptions{}); err == nil {
+		t.Fatal("Expected the upload to fail without an audit log")
+	}
+
+	for _, model := range []interface{}{&schemas.Transaction{}, &schemas.UploadBatch{}, &schemas.Counterparty{}, &schemas.CounterpartyAlias{}} {
+		var count int64
+		db.Model(model).Count(&count)
+		if count != 0 {
+			t.Errorf("Expected no %T rows left, got %d", model, count)
+		}
+	}
+}
+
+// TestClearRollsBack tests that a clear whose audit event cannot be written leaves every transaction in place
+func TestClearRollsBack(t *testing.T) {
+	uc, db := setupTestUseCase(t)
+	if _, err := uc.ParseAndStoreWithValidation(context.Background(), strings.NewReader(testCSV), validator.NewFieldValidator(), schemas.UploadOptions{}); err != nil {
+		t.Fatalf("ParseAndStoreWithValidation failed: %v", err)
+	}
+	if err := db.Migrator().DropTable(&auditSchemas.AuditEvent{}); err != nil {
+		t.Fatalf("failed to drop audit table: %v", err)
+	}
+
+	if _, err := uc.Clear(context.Background()); err == nil {
+		t.Fatal("Expected the clear to fail without an audit log")
+	}
+
+	var live, operations int64
+	db.Model(&schemas.Transaction{}).Count(&live)
+	db.Model(&schemas.ClearOperation{}).Count(&operations)
+	if live != 2 || operations != 0 {
+		t.Errorf("Expected 2 live transactions and no clear operation, got %d and %d", live, operations)
+	}
+}
@@ -0,0 +1,89 @@
+package wiring
+
+import (
+	"time"
+
+	anomalyRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/anomaly/repository"
+	anomalyUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/anomaly/use_case"
+	auditRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/repository"
+	auditUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/use_case"
+	counterpartyRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/counterparty/repository"
+	counterpartyUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/counterparty/use_case"
+	generatorRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/generator/repository"
+	generatorUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/generator/use_case"
+	linkRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/link/repository"
+	linkUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/link/use_case"
+	profileRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/profile/repository"
+	profileUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/profile/use_case"
+	resumableRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/resumable/repository"
+	resumableUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/resumable/use_case"
+	ruleRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/rule/repository"
+	ruleUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/rule/use_case"
+	transactionRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/repository"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	transactionUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/use_case"
+	uploadRepo "github.com/fadlytanjung/flip-fullstack-test/backend/domain/upload/repository"
+	uploadUseCase "github.com/fadlytanjung/flip-fullstack-test/backend/domain/upload/use_case"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/config"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/deps"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+)
+
+// Audit builds the audit use case
+func Audit(d *deps.App) auditUseCase.IUseCase {
+	return auditUseCase.NewUseCase(auditRepo.NewRepository(d.DB.GetDB()))
+}
+
+// Transactions builds the transaction use case with the SLA policy from config
+// It stops the app when the SLA amount bands are invalid, rather than run with thresholds nobody configured
+func Transactions(d *deps.App) transactionUseCase.IUseCase {
+	cfg := config.GetConfig()
+	slaPolicy, err := schemas.NewSLAPolicy(cfg.SLADefaultHours, cfg.SLACreditHours, cfg.SLADebitHours, cfg.SLAAmountBands)
+	if err != nil {
+		d.Logger.Fatal("Invalid SLA amount bands", logger.Error(err))
+	}
+
+	return transactionUseCase.NewUseCase(transactionRepo.NewRepository(d.DB.GetDB()), slaPolicy, Audit(d))
+}
+
+// Uploads builds the upload use case with the rule, counterparty, anomaly, link and import profile use cases it applies
+// It stops the app when the anomaly config is invalid
+func Uploads(d *deps.App) uploadUseCase.IUseCase {
+	cfg := config.GetConfig()
+	conn := d.DB.GetDB()
+	transactions := transactionRepo.NewRepository(conn)
+	audit := Audit(d)
+
+	rules := ruleUseCase.NewUseCase(ruleRepo.NewRepository(conn), audit)
+	counterparties := counterpartyUseCase.NewUseCase(counterpartyRepo.NewRepository(conn), transactions, audit, cfg.CounterpartyMatchThreshold)
+	anomalies := anomalyUseCase.NewUseCase(anomalyRepo.NewRepository(conn), anomalyPolicy(d, cfg))
+	window := time.Duration(cfg.LinkWindowDays) * 24 * time.Hour
+	reversalWindow := time.Duration(cfg.LinkReversalHours) * time.Hour
+	links := linkUseCase.NewUseCase(linkRepo.NewRepository(conn), audit, window, reversalWindow)
+	profiles := profileUseCase.NewUseCase(profileRepo.NewRepository(conn), audit)
+	clearRetention := time.Duration(cfg.ClearRetentionHours) * time.Hour
+
+	return uploadUseCase.NewUseCase(uploadRepo.NewRepository(), transactions, audit, rules, counterparties, anomalies, links, profiles, clearRetention)
+}
+
+// Sessions builds the resumable upload use case, staging chunks in the configured directory
+func Sessions(d *deps.App) resumableUseCase.IUseCase {
+	cfg := config.GetConfig()
+	ttl := time.Duration(cfg.UploadSessionTTLHours) * time.Hour
+	return resumableUseCase.NewUseCase(resumableRepo.NewRepository(d.DB.GetDB(), cfg.UploadStagingDir), ttl)
+}
+
+// Generator builds the synthetic dataset generator
+func Generator(d *deps.App) generatorUseCase.IUseCase {
+	return generatorUseCase.NewUseCase(generatorRepo.NewRepository(d.DB.GetDB()), Audit(d))
+}
+
+// anomalyPolicy builds the anomaly policy from config, stopping the app when it is invalid
+func anomalyPolicy(d *deps.App, cfg *config.GlobalConfig) schemas.AnomalyPolicy {
+	policy, err := schemas.NewAnomalyPolicy(cfg.AnomalyOutlierMethod, cfg.AnomalyZScoreThreshold, cfg.AnomalyIQRMultiplier, cfg.AnomalyMinHistory,
+		cfg.AnomalyFailedRateDelta, cfg.AnomalyLargeDebitAmount, cfg.AnomalyOddHours, cfg.AnomalyTimezone)
+	if err != nil {
+		d.Logger.Fatal("Invalid anomaly config", logger.Error(err))
+	}
+	return policy
+}
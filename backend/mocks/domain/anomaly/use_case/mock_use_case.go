@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package use_case
+
+import (
+	context "context"
+
+	schemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIUseCase is an autogenerated mock type for the IUseCase type
+type MockIUseCase struct {
+	mock.Mock
+}
+
+type MockIUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIUseCase) EXPECT() *MockIUseCase_Expecter {
+	return &MockIUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Detect provides a mock function with given fields: ctx, batchID, transactions
+func (_m *MockIUseCase) Detect(ctx context.Context, batchID string, transactions []schemas.Transaction) (int, error) {
+	ret := _m.Called(ctx, batchID, transactions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Detect")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []schemas.Transaction) (int, error)); ok {
+		return rf(ctx, batchID, transactions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []schemas.Transaction) int); ok {
+		r0 = rf(ctx, batchID, transactions)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []schemas.Transaction) error); ok {
+		r1 = rf(ctx, batchID, transactions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_Detect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Detect'
+type MockIUseCase_Detect_Call struct {
+	*mock.Call
+}
+
+// Detect is a helper method to define mock.On call
+//   - ctx context.Context
+//   - batchID string
+//   - transactions []schemas.Transaction
+func (_e *MockIUseCase_Expecter) Detect(ctx interface{}, batchID interface{}, transactions interface{}) *MockIUseCase_Detect_Call {
+	return &MockIUseCase_Detect_Call{Call: _e.mock.On("Detect", ctx, batchID, transactions)}
+}
+
+func (_c *MockIUseCase_Detect_Call) Run(run func(ctx context.Context, batchID string, transactions []schemas.Transaction)) *MockIUseCase_Detect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]schemas.Transaction))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_Detect_Call) Return(_a0 int, _a1 error) *MockIUseCase_Detect_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_Detect_Call) RunAndReturn(run func(context.Context, string, []schemas.Transaction) (int, error)) *MockIUseCase_Detect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIUseCase creates a new instance of MockIUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIUseCase {
+	mock := &MockIUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
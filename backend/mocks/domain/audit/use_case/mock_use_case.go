@@ -0,0 +1,203 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package use_case
+
+import (
+	context "context"
+
+	schemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/audit/schemas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIUseCase is an autogenerated mock type for the IUseCase type
+type MockIUseCase struct {
+	mock.Mock
+}
+
+type MockIUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIUseCase) EXPECT() *MockIUseCase_Expecter {
+	return &MockIUseCase_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function with given fields: ctx, page, pageSize, filters
+func (_m *MockIUseCase) List(ctx context.Context, page int, pageSize int, filters schemas.AuditFilters) (*schemas.AuditEventsResponse, error) {
+	ret := _m.Called(ctx, page, pageSize, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *schemas.AuditEventsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, schemas.AuditFilters) (*schemas.AuditEventsResponse, error)); ok {
+		return rf(ctx, page, pageSize, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, schemas.AuditFilters) *schemas.AuditEventsResponse); ok {
+		r0 = rf(ctx, page, pageSize, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.AuditEventsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, schemas.AuditFilters) error); ok {
+		r1 = rf(ctx, page, pageSize, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockIUseCase_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page int
+//   - pageSize int
+//   - filters schemas.AuditFilters
+func (_e *MockIUseCase_Expecter) List(ctx interface{}, page interface{}, pageSize interface{}, filters interface{}) *MockIUseCase_List_Call {
+	return &MockIUseCase_List_Call{Call: _e.mock.On("List", ctx, page, pageSize, filters)}
+}
+
+func (_c *MockIUseCase_List_Call) Run(run func(ctx context.Context, page int, pageSize int, filters schemas.AuditFilters)) *MockIUseCase_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(schemas.AuditFilters))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_List_Call) Return(_a0 *schemas.AuditEventsResponse, _a1 error) *MockIUseCase_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_List_Call) RunAndReturn(run func(context.Context, int, int, schemas.AuditFilters) (*schemas.AuditEventsResponse, error)) *MockIUseCase_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: ctx, entry
+func (_m *MockIUseCase) Record(ctx context.Context, entry schemas.AuditEntry) error {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.AuditEntry) error); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUseCase_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type MockIUseCase_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry schemas.AuditEntry
+func (_e *MockIUseCase_Expecter) Record(ctx interface{}, entry interface{}) *MockIUseCase_Record_Call {
+	return &MockIUseCase_Record_Call{Call: _e.mock.On("Record", ctx, entry)}
+}
+
+func (_c *MockIUseCase_Record_Call) Run(run func(ctx context.Context, entry schemas.AuditEntry)) *MockIUseCase_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schemas.AuditEntry))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_Record_Call) Return(_a0 error) *MockIUseCase_Record_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUseCase_Record_Call) RunAndReturn(run func(context.Context, schemas.AuditEntry) error) *MockIUseCase_Record_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Verify provides a mock function with given fields: ctx
+func (_m *MockIUseCase) Verify(ctx context.Context) (*schemas.VerifyResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verify")
+	}
+
+	var r0 *schemas.VerifyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*schemas.VerifyResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *schemas.VerifyResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.VerifyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_Verify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Verify'
+type MockIUseCase_Verify_Call struct {
+	*mock.Call
+}
+
+// Verify is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIUseCase_Expecter) Verify(ctx interface{}) *MockIUseCase_Verify_Call {
+	return &MockIUseCase_Verify_Call{Call: _e.mock.On("Verify", ctx)}
+}
+
+func (_c *MockIUseCase_Verify_Call) Run(run func(ctx context.Context)) *MockIUseCase_Verify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_Verify_Call) Return(_a0 *schemas.VerifyResponse, _a1 error) *MockIUseCase_Verify_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_Verify_Call) RunAndReturn(run func(context.Context) (*schemas.VerifyResponse, error)) *MockIUseCase_Verify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIUseCase creates a new instance of MockIUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIUseCase {
+	mock := &MockIUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,438 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package use_case
+
+import (
+	context "context"
+
+	schemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIUseCase is an autogenerated mock type for the IUseCase type
+type MockIUseCase struct {
+	mock.Mock
+}
+
+type MockIUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIUseCase) EXPECT() *MockIUseCase_Expecter {
+	return &MockIUseCase_Expecter{mock: &_m.Mock}
+}
+
+// AssignCategory provides a mock function with given fields: ctx, req
+func (_m *MockIUseCase) AssignCategory(ctx context.Context, req schemas.CategoryAssignmentRequest) (*schemas.AssignmentResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignCategory")
+	}
+
+	var r0 *schemas.AssignmentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.CategoryAssignmentRequest) (*schemas.AssignmentResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.CategoryAssignmentRequest) *schemas.AssignmentResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.AssignmentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, schemas.CategoryAssignmentRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_AssignCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignCategory'
+type MockIUseCase_AssignCategory_Call struct {
+	*mock.Call
+}
+
+// AssignCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req schemas.CategoryAssignmentRequest
+func (_e *MockIUseCase_Expecter) AssignCategory(ctx interface{}, req interface{}) *MockIUseCase_AssignCategory_Call {
+	return &MockIUseCase_AssignCategory_Call{Call: _e.mock.On("AssignCategory", ctx, req)}
+}
+
+func (_c *MockIUseCase_AssignCategory_Call) Run(run func(ctx context.Context, req schemas.CategoryAssignmentRequest)) *MockIUseCase_AssignCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schemas.CategoryAssignmentRequest))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_AssignCategory_Call) Return(_a0 *schemas.AssignmentResponse, _a1 error) *MockIUseCase_AssignCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_AssignCategory_Call) RunAndReturn(run func(context.Context, schemas.CategoryAssignmentRequest) (*schemas.AssignmentResponse, error)) *MockIUseCase_AssignCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCategory provides a mock function with given fields: ctx, req
+func (_m *MockIUseCase) CreateCategory(ctx context.Context, req schemas.CategoryRequest) (*schemas.Category, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCategory")
+	}
+
+	var r0 *schemas.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.CategoryRequest) (*schemas.Category, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.CategoryRequest) *schemas.Category); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, schemas.CategoryRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_CreateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCategory'
+type MockIUseCase_CreateCategory_Call struct {
+	*mock.Call
+}
+
+// CreateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req schemas.CategoryRequest
+func (_e *MockIUseCase_Expecter) CreateCategory(ctx interface{}, req interface{}) *MockIUseCase_CreateCategory_Call {
+	return &MockIUseCase_CreateCategory_Call{Call: _e.mock.On("CreateCategory", ctx, req)}
+}
+
+func (_c *MockIUseCase_CreateCategory_Call) Run(run func(ctx context.Context, req schemas.CategoryRequest)) *MockIUseCase_CreateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schemas.CategoryRequest))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_CreateCategory_Call) Return(_a0 *schemas.Category, _a1 error) *MockIUseCase_CreateCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_CreateCategory_Call) RunAndReturn(run func(context.Context, schemas.CategoryRequest) (*schemas.Category, error)) *MockIUseCase_CreateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCategory provides a mock function with given fields: ctx, id
+func (_m *MockIUseCase) DeleteCategory(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUseCase_DeleteCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCategory'
+type MockIUseCase_DeleteCategory_Call struct {
+	*mock.Call
+}
+
+// DeleteCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIUseCase_Expecter) DeleteCategory(ctx interface{}, id interface{}) *MockIUseCase_DeleteCategory_Call {
+	return &MockIUseCase_DeleteCategory_Call{Call: _e.mock.On("DeleteCategory", ctx, id)}
+}
+
+func (_c *MockIUseCase_DeleteCategory_Call) Run(run func(ctx context.Context, id string)) *MockIUseCase_DeleteCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_DeleteCategory_Call) Return(_a0 error) *MockIUseCase_DeleteCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUseCase_DeleteCategory_Call) RunAndReturn(run func(context.Context, string) error) *MockIUseCase_DeleteCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategory provides a mock function with given fields: ctx, id
+func (_m *MockIUseCase) GetCategory(ctx context.Context, id string) (*schemas.Category, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategory")
+	}
+
+	var r0 *schemas.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*schemas.Category, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *schemas.Category); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_GetCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategory'
+type MockIUseCase_GetCategory_Call struct {
+	*mock.Call
+}
+
+// GetCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIUseCase_Expecter) GetCategory(ctx interface{}, id interface{}) *MockIUseCase_GetCategory_Call {
+	return &MockIUseCase_GetCategory_Call{Call: _e.mock.On("GetCategory", ctx, id)}
+}
+
+func (_c *MockIUseCase_GetCategory_Call) Run(run func(ctx context.Context, id string)) *MockIUseCase_GetCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_GetCategory_Call) Return(_a0 *schemas.Category, _a1 error) *MockIUseCase_GetCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_GetCategory_Call) RunAndReturn(run func(context.Context, string) (*schemas.Category, error)) *MockIUseCase_GetCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCategories provides a mock function with given fields: ctx
+func (_m *MockIUseCase) ListCategories(ctx context.Context) ([]schemas.Category, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCategories")
+	}
+
+	var r0 []schemas.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]schemas.Category, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []schemas.Category); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schemas.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_ListCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCategories'
+type MockIUseCase_ListCategories_Call struct {
+	*mock.Call
+}
+
+// ListCategories is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIUseCase_Expecter) ListCategories(ctx interface{}) *MockIUseCase_ListCategories_Call {
+	return &MockIUseCase_ListCategories_Call{Call: _e.mock.On("ListCategories", ctx)}
+}
+
+func (_c *MockIUseCase_ListCategories_Call) Run(run func(ctx context.Context)) *MockIUseCase_ListCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_ListCategories_Call) Return(_a0 []schemas.Category, _a1 error) *MockIUseCase_ListCategories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_ListCategories_Call) RunAndReturn(run func(context.Context) ([]schemas.Category, error)) *MockIUseCase_ListCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCategory provides a mock function with given fields: ctx, id, req
+func (_m *MockIUseCase) UpdateCategory(ctx context.Context, id string, req schemas.CategoryRequest) (*schemas.Category, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCategory")
+	}
+
+	var r0 *schemas.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, schemas.CategoryRequest) (*schemas.Category, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, schemas.CategoryRequest) *schemas.Category); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, schemas.CategoryRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_UpdateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCategory'
+type MockIUseCase_UpdateCategory_Call struct {
+	*mock.Call
+}
+
+// UpdateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - req schemas.CategoryRequest
+func (_e *MockIUseCase_Expecter) UpdateCategory(ctx interface{}, id interface{}, req interface{}) *MockIUseCase_UpdateCategory_Call {
+	return &MockIUseCase_UpdateCategory_Call{Call: _e.mock.On("UpdateCategory", ctx, id, req)}
+}
+
+func (_c *MockIUseCase_UpdateCategory_Call) Run(run func(ctx context.Context, id string, req schemas.CategoryRequest)) *MockIUseCase_UpdateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(schemas.CategoryRequest))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_UpdateCategory_Call) Return(_a0 *schemas.Category, _a1 error) *MockIUseCase_UpdateCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_UpdateCategory_Call) RunAndReturn(run func(context.Context, string, schemas.CategoryRequest) (*schemas.Category, error)) *MockIUseCase_UpdateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTags provides a mock function with given fields: ctx, req
+func (_m *MockIUseCase) UpdateTags(ctx context.Context, req schemas.TagsRequest) (*schemas.AssignmentResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTags")
+	}
+
+	var r0 *schemas.AssignmentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.TagsRequest) (*schemas.AssignmentResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.TagsRequest) *schemas.AssignmentResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.AssignmentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, schemas.TagsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_UpdateTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTags'
+type MockIUseCase_UpdateTags_Call struct {
+	*mock.Call
+}
+
+// UpdateTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req schemas.TagsRequest
+func (_e *MockIUseCase_Expecter) UpdateTags(ctx interface{}, req interface{}) *MockIUseCase_UpdateTags_Call {
+	return &MockIUseCase_UpdateTags_Call{Call: _e.mock.On("UpdateTags", ctx, req)}
+}
+
+func (_c *MockIUseCase_UpdateTags_Call) Run(run func(ctx context.Context, req schemas.TagsRequest)) *MockIUseCase_UpdateTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schemas.TagsRequest))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_UpdateTags_Call) Return(_a0 *schemas.AssignmentResponse, _a1 error) *MockIUseCase_UpdateTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_UpdateTags_Call) RunAndReturn(run func(context.Context, schemas.TagsRequest) (*schemas.AssignmentResponse, error)) *MockIUseCase_UpdateTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIUseCase creates a new instance of MockIUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIUseCase {
+	mock := &MockIUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,572 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package use_case
+
+import (
+	context "context"
+
+	schemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIUseCase is an autogenerated mock type for the IUseCase type
+type MockIUseCase struct {
+	mock.Mock
+}
+
+type MockIUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIUseCase) EXPECT() *MockIUseCase_Expecter {
+	return &MockIUseCase_Expecter{mock: &_m.Mock}
+}
+
+// AddAlias provides a mock function with given fields: ctx, id, req
+func (_m *MockIUseCase) AddAlias(ctx context.Context, id string, req schemas.CounterpartyAliasRequest) (*schemas.Counterparty, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAlias")
+	}
+
+	var r0 *schemas.Counterparty
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, schemas.CounterpartyAliasRequest) (*schemas.Counterparty, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, schemas.CounterpartyAliasRequest) *schemas.Counterparty); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.Counterparty)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, schemas.CounterpartyAliasRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_AddAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAlias'
+type MockIUseCase_AddAlias_Call struct {
+	*mock.Call
+}
+
+// AddAlias is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - req schemas.CounterpartyAliasRequest
+func (_e *MockIUseCase_Expecter) AddAlias(ctx interface{}, id interface{}, req interface{}) *MockIUseCase_AddAlias_Call {
+	return &MockIUseCase_AddAlias_Call{Call: _e.mock.On("AddAlias", ctx, id, req)}
+}
+
+func (_c *MockIUseCase_AddAlias_Call) Run(run func(ctx context.Context, id string, req schemas.CounterpartyAliasRequest)) *MockIUseCase_AddAlias_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(schemas.CounterpartyAliasRequest))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_AddAlias_Call) Return(_a0 *schemas.Counterparty, _a1 error) *MockIUseCase_AddAlias_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_AddAlias_Call) RunAndReturn(run func(context.Context, string, schemas.CounterpartyAliasRequest) (*schemas.Counterparty, error)) *MockIUseCase_AddAlias_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCounterparty provides a mock function with given fields: ctx, id
+func (_m *MockIUseCase) GetCounterparty(ctx context.Context, id string) (*schemas.Counterparty, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCounterparty")
+	}
+
+	var r0 *schemas.Counterparty
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*schemas.Counterparty, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *schemas.Counterparty); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.Counterparty)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_GetCounterparty_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCounterparty'
+type MockIUseCase_GetCounterparty_Call struct {
+	*mock.Call
+}
+
+// GetCounterparty is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIUseCase_Expecter) GetCounterparty(ctx interface{}, id interface{}) *MockIUseCase_GetCounterparty_Call {
+	return &MockIUseCase_GetCounterparty_Call{Call: _e.mock.On("GetCounterparty", ctx, id)}
+}
+
+func (_c *MockIUseCase_GetCounterparty_Call) Run(run func(ctx context.Context, id string)) *MockIUseCase_GetCounterparty_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_GetCounterparty_Call) Return(_a0 *schemas.Counterparty, _a1 error) *MockIUseCase_GetCounterparty_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_GetCounterparty_Call) RunAndReturn(run func(context.Context, string) (*schemas.Counterparty, error)) *MockIUseCase_GetCounterparty_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSummary provides a mock function with given fields: ctx, id
+func (_m *MockIUseCase) GetSummary(ctx context.Context, id string) (*schemas.CounterpartySummary, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSummary")
+	}
+
+	var r0 *schemas.CounterpartySummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*schemas.CounterpartySummary, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *schemas.CounterpartySummary); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.CounterpartySummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_GetSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSummary'
+type MockIUseCase_GetSummary_Call struct {
+	*mock.Call
+}
+
+// GetSummary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIUseCase_Expecter) GetSummary(ctx interface{}, id interface{}) *MockIUseCase_GetSummary_Call {
+	return &MockIUseCase_GetSummary_Call{Call: _e.mock.On("GetSummary", ctx, id)}
+}
+
+func (_c *MockIUseCase_GetSummary_Call) Run(run func(ctx context.Context, id string)) *MockIUseCase_GetSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_GetSummary_Call) Return(_a0 *schemas.CounterpartySummary, _a1 error) *MockIUseCase_GetSummary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_GetSummary_Call) RunAndReturn(run func(context.Context, string) (*schemas.CounterpartySummary, error)) *MockIUseCase_GetSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTransactions provides a mock function with given fields: ctx, id, page, pageSize
+func (_m *MockIUseCase) GetTransactions(ctx context.Context, id string, page int, pageSize int) (*schemas.IssuesResponse, error) {
+	ret := _m.Called(ctx, id, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactions")
+	}
+
+	var r0 *schemas.IssuesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) (*schemas.IssuesResponse, error)); ok {
+		return rf(ctx, id, page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) *schemas.IssuesResponse); ok {
+		r0 = rf(ctx, id, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.IssuesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = rf(ctx, id, page, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_GetTransactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransactions'
+type MockIUseCase_GetTransactions_Call struct {
+	*mock.Call
+}
+
+// GetTransactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - page int
+//   - pageSize int
+func (_e *MockIUseCase_Expecter) GetTransactions(ctx interface{}, id interface{}, page interface{}, pageSize interface{}) *MockIUseCase_GetTransactions_Call {
+	return &MockIUseCase_GetTransactions_Call{Call: _e.mock.On("GetTransactions", ctx, id, page, pageSize)}
+}
+
+func (_c *MockIUseCase_GetTransactions_Call) Run(run func(ctx context.Context, id string, page int, pageSize int)) *MockIUseCase_GetTransactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_GetTransactions_Call) Return(_a0 *schemas.IssuesResponse, _a1 error) *MockIUseCase_GetTransactions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_GetTransactions_Call) RunAndReturn(run func(context.Context, string, int, int) (*schemas.IssuesResponse, error)) *MockIUseCase_GetTransactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Link provides a mock function with given fields: ctx, transactions
+func (_m *MockIUseCase) Link(ctx context.Context, transactions []schemas.Transaction) (int, error) {
+	ret := _m.Called(ctx, transactions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Link")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []schemas.Transaction) (int, error)); ok {
+		return rf(ctx, transactions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []schemas.Transaction) int); ok {
+		r0 = rf(ctx, transactions)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []schemas.Transaction) error); ok {
+		r1 = rf(ctx, transactions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_Link_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Link'
+type MockIUseCase_Link_Call struct {
+	*mock.Call
+}
+
+// Link is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactions []schemas.Transaction
+func (_e *MockIUseCase_Expecter) Link(ctx interface{}, transactions interface{}) *MockIUseCase_Link_Call {
+	return &MockIUseCase_Link_Call{Call: _e.mock.On("Link", ctx, transactions)}
+}
+
+func (_c *MockIUseCase_Link_Call) Run(run func(ctx context.Context, transactions []schemas.Transaction)) *MockIUseCase_Link_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]schemas.Transaction))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_Link_Call) Return(_a0 int, _a1 error) *MockIUseCase_Link_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_Link_Call) RunAndReturn(run func(context.Context, []schemas.Transaction) (int, error)) *MockIUseCase_Link_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCounterparties provides a mock function with given fields: ctx, page, pageSize, search
+func (_m *MockIUseCase) ListCounterparties(ctx context.Context, page int, pageSize int, search string) (*schemas.CounterpartiesResponse, error) {
+	ret := _m.Called(ctx, page, pageSize, search)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCounterparties")
+	}
+
+	var r0 *schemas.CounterpartiesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, string) (*schemas.CounterpartiesResponse, error)); ok {
+		return rf(ctx, page, pageSize, search)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, string) *schemas.CounterpartiesResponse); ok {
+		r0 = rf(ctx, page, pageSize, search)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.CounterpartiesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, string) error); ok {
+		r1 = rf(ctx, page, pageSize, search)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_ListCounterparties_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCounterparties'
+type MockIUseCase_ListCounterparties_Call struct {
+	*mock.Call
+}
+
+// ListCounterparties is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page int
+//   - pageSize int
+//   - search string
+func (_e *MockIUseCase_Expecter) ListCounterparties(ctx interface{}, page interface{}, pageSize interface{}, search interface{}) *MockIUseCase_ListCounterparties_Call {
+	return &MockIUseCase_ListCounterparties_Call{Call: _e.mock.On("ListCounterparties", ctx, page, pageSize, search)}
+}
+
+func (_c *MockIUseCase_ListCounterparties_Call) Run(run func(ctx context.Context, page int, pageSize int, search string)) *MockIUseCase_ListCounterparties_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_ListCounterparties_Call) Return(_a0 *schemas.CounterpartiesResponse, _a1 error) *MockIUseCase_ListCounterparties_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_ListCounterparties_Call) RunAndReturn(run func(context.Context, int, int, string) (*schemas.CounterpartiesResponse, error)) *MockIUseCase_ListCounterparties_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeCounterparty provides a mock function with given fields: ctx, id, req
+func (_m *MockIUseCase) MergeCounterparty(ctx context.Context, id string, req schemas.CounterpartyMergeRequest) (*schemas.Counterparty, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeCounterparty")
+	}
+
+	var r0 *schemas.Counterparty
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, schemas.CounterpartyMergeRequest) (*schemas.Counterparty, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, schemas.CounterpartyMergeRequest) *schemas.Counterparty); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.Counterparty)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, schemas.CounterpartyMergeRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_MergeCounterparty_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeCounterparty'
+type MockIUseCase_MergeCounterparty_Call struct {
+	*mock.Call
+}
+
+// MergeCounterparty is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - req schemas.CounterpartyMergeRequest
+func (_e *MockIUseCase_Expecter) MergeCounterparty(ctx interface{}, id interface{}, req interface{}) *MockIUseCase_MergeCounterparty_Call {
+	return &MockIUseCase_MergeCounterparty_Call{Call: _e.mock.On("MergeCounterparty", ctx, id, req)}
+}
+
+func (_c *MockIUseCase_MergeCounterparty_Call) Run(run func(ctx context.Context, id string, req schemas.CounterpartyMergeRequest)) *MockIUseCase_MergeCounterparty_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(schemas.CounterpartyMergeRequest))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_MergeCounterparty_Call) Return(_a0 *schemas.Counterparty, _a1 error) *MockIUseCase_MergeCounterparty_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_MergeCounterparty_Call) RunAndReturn(run func(context.Context, string, schemas.CounterpartyMergeRequest) (*schemas.Counterparty, error)) *MockIUseCase_MergeCounterparty_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Relink provides a mock function with given fields: ctx
+func (_m *MockIUseCase) Relink(ctx context.Context) (*schemas.CounterpartyLinkResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Relink")
+	}
+
+	var r0 *schemas.CounterpartyLinkResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*schemas.CounterpartyLinkResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *schemas.CounterpartyLinkResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.CounterpartyLinkResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_Relink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Relink'
+type MockIUseCase_Relink_Call struct {
+	*mock.Call
+}
+
+// Relink is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIUseCase_Expecter) Relink(ctx interface{}) *MockIUseCase_Relink_Call {
+	return &MockIUseCase_Relink_Call{Call: _e.mock.On("Relink", ctx)}
+}
+
+func (_c *MockIUseCase_Relink_Call) Run(run func(ctx context.Context)) *MockIUseCase_Relink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_Relink_Call) Return(_a0 *schemas.CounterpartyLinkResponse, _a1 error) *MockIUseCase_Relink_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_Relink_Call) RunAndReturn(run func(context.Context) (*schemas.CounterpartyLinkResponse, error)) *MockIUseCase_Relink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameCounterparty provides a mock function with given fields: ctx, id, req
+func (_m *MockIUseCase) RenameCounterparty(ctx context.Context, id string, req schemas.CounterpartyRequest) (*schemas.Counterparty, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameCounterparty")
+	}
+
+	var r0 *schemas.Counterparty
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, schemas.CounterpartyRequest) (*schemas.Counterparty, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, schemas.CounterpartyRequest) *schemas.Counterparty); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.Counterparty)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, schemas.CounterpartyRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_RenameCounterparty_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameCounterparty'
+type MockIUseCase_RenameCounterparty_Call struct {
+	*mock.Call
+}
+
+// RenameCounterparty is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - req schemas.CounterpartyRequest
+func (_e *MockIUseCase_Expecter) RenameCounterparty(ctx interface{}, id interface{}, req interface{}) *MockIUseCase_RenameCounterparty_Call {
+	return &MockIUseCase_RenameCounterparty_Call{Call: _e.mock.On("RenameCounterparty", ctx, id, req)}
+}
+
+func (_c *MockIUseCase_RenameCounterparty_Call) Run(run func(ctx context.Context, id string, req schemas.CounterpartyRequest)) *MockIUseCase_RenameCounterparty_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(schemas.CounterpartyRequest))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_RenameCounterparty_Call) Return(_a0 *schemas.Counterparty, _a1 error) *MockIUseCase_RenameCounterparty_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_RenameCounterparty_Call) RunAndReturn(run func(context.Context, string, schemas.CounterpartyRequest) (*schemas.Counterparty, error)) *MockIUseCase_RenameCounterparty_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIUseCase creates a new instance of MockIUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIUseCase {
+	mock := &MockIUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package use_case
+
+import (
+	context "context"
+
+	schemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIUseCase is an autogenerated mock type for the IUseCase type
+type MockIUseCase struct {
+	mock.Mock
+}
+
+type MockIUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIUseCase) EXPECT() *MockIUseCase_Expecter {
+	return &MockIUseCase_Expecter{mock: &_m.Mock}
+}
+
+// GetForecast provides a mock function with given fields: ctx, days
+func (_m *MockIUseCase) GetForecast(ctx context.Context, days int) (*schemas.ForecastResponse, error) {
+	ret := _m.Called(ctx, days)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForecast")
+	}
+
+	var r0 *schemas.ForecastResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*schemas.ForecastResponse, error)); ok {
+		return rf(ctx, days)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *schemas.ForecastResponse); ok {
+		r0 = rf(ctx, days)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.ForecastResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, days)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_GetForecast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForecast'
+type MockIUseCase_GetForecast_Call struct {
+	*mock.Call
+}
+
+// GetForecast is a helper method to define mock.On call
+//   - ctx context.Context
+//   - days int
+func (_e *MockIUseCase_Expecter) GetForecast(ctx interface{}, days interface{}) *MockIUseCase_GetForecast_Call {
+	return &MockIUseCase_GetForecast_Call{Call: _e.mock.On("GetForecast", ctx, days)}
+}
+
+func (_c *MockIUseCase_GetForecast_Call) Run(run func(ctx context.Context, days int)) *MockIUseCase_GetForecast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_GetForecast_Call) Return(_a0 *schemas.ForecastResponse, _a1 error) *MockIUseCase_GetForecast_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_GetForecast_Call) RunAndReturn(run func(context.Context, int) (*schemas.ForecastResponse, error)) *MockIUseCase_GetForecast_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIUseCase creates a new instance of MockIUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIUseCase {
+	mock := &MockIUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,158 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package use_case
+
+import (
+	context "context"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	schemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+)
+
+// MockIUseCase is an autogenerated mock type for the IUseCase type
+type MockIUseCase struct {
+	mock.Mock
+}
+
+type MockIUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIUseCase) EXPECT() *MockIUseCase_Expecter {
+	return &MockIUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Insert provides a mock function with given fields: ctx, cfg
+func (_m *MockIUseCase) Insert(ctx context.Context, cfg schemas.GeneratorConfig) (*schemas.GeneratorSummary, error) {
+	ret := _m.Called(ctx, cfg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 *schemas.GeneratorSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.GeneratorConfig) (*schemas.GeneratorSummary, error)); ok {
+		return rf(ctx, cfg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.GeneratorConfig) *schemas.GeneratorSummary); ok {
+		r0 = rf(ctx, cfg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.GeneratorSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, schemas.GeneratorConfig) error); ok {
+		r1 = rf(ctx, cfg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
+type MockIUseCase_Insert_Call struct {
+	*mock.Call
+}
+
+// Insert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cfg schemas.GeneratorConfig
+func (_e *MockIUseCase_Expecter) Insert(ctx interface{}, cfg interface{}) *MockIUseCase_Insert_Call {
+	return &MockIUseCase_Insert_Call{Call: _e.mock.On("Insert", ctx, cfg)}
+}
+
+func (_c *MockIUseCase_Insert_Call) Run(run func(ctx context.Context, cfg schemas.GeneratorConfig)) *MockIUseCase_Insert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schemas.GeneratorConfig))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_Insert_Call) Return(_a0 *schemas.GeneratorSummary, _a1 error) *MockIUseCase_Insert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_Insert_Call) RunAndReturn(run func(context.Context, schemas.GeneratorConfig) (*schemas.GeneratorSummary, error)) *MockIUseCase_Insert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WriteCSV provides a mock function with given fields: ctx, cfg, w
+func (_m *MockIUseCase) WriteCSV(ctx context.Context, cfg schemas.GeneratorConfig, w io.Writer) (*schemas.GeneratorSummary, error) {
+	ret := _m.Called(ctx, cfg, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteCSV")
+	}
+
+	var r0 *schemas.GeneratorSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.GeneratorConfig, io.Writer) (*schemas.GeneratorSummary, error)); ok {
+		return rf(ctx, cfg, w)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.GeneratorConfig, io.Writer) *schemas.GeneratorSummary); ok {
+		r0 = rf(ctx, cfg, w)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.GeneratorSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, schemas.GeneratorConfig, io.Writer) error); ok {
+		r1 = rf(ctx, cfg, w)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_WriteCSV_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteCSV'
+type MockIUseCase_WriteCSV_Call struct {
+	*mock.Call
+}
+
+// WriteCSV is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cfg schemas.GeneratorConfig
+//   - w io.Writer
+func (_e *MockIUseCase_Expecter) WriteCSV(ctx interface{}, cfg interface{}, w interface{}) *MockIUseCase_WriteCSV_Call {
+	return &MockIUseCase_WriteCSV_Call{Call: _e.mock.On("WriteCSV", ctx, cfg, w)}
+}
+
+func (_c *MockIUseCase_WriteCSV_Call) Run(run func(ctx context.Context, cfg schemas.GeneratorConfig, w io.Writer)) *MockIUseCase_WriteCSV_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schemas.GeneratorConfig), args[2].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_WriteCSV_Call) Return(_a0 *schemas.GeneratorSummary, _a1 error) *MockIUseCase_WriteCSV_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_WriteCSV_Call) RunAndReturn(run func(context.Context, schemas.GeneratorConfig, io.Writer) (*schemas.GeneratorSummary, error)) *MockIUseCase_WriteCSV_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIUseCase creates a new instance of MockIUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIUseCase {
+	mock := &MockIUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,153 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package use_case
+
+import (
+	context "context"
+
+	schemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIUseCase is an autogenerated mock type for the IUseCase type
+type MockIUseCase struct {
+	mock.Mock
+}
+
+type MockIUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIUseCase) EXPECT() *MockIUseCase_Expecter {
+	return &MockIUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Poll provides a mock function with given fields: ctx
+func (_m *MockIUseCase) Poll(ctx context.Context) ([]schemas.InboxResult, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Poll")
+	}
+
+	var r0 []schemas.InboxResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]schemas.InboxResult, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []schemas.InboxResult); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schemas.InboxResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_Poll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Poll'
+type MockIUseCase_Poll_Call struct {
+	*mock.Call
+}
+
+// Poll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIUseCase_Expecter) Poll(ctx interface{}) *MockIUseCase_Poll_Call {
+	return &MockIUseCase_Poll_Call{Call: _e.mock.On("Poll", ctx)}
+}
+
+func (_c *MockIUseCase_Poll_Call) Run(run func(ctx context.Context)) *MockIUseCase_Poll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_Poll_Call) Return(_a0 []schemas.InboxResult, _a1 error) *MockIUseCase_Poll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_Poll_Call) RunAndReturn(run func(context.Context) ([]schemas.InboxResult, error)) *MockIUseCase_Poll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Recover provides a mock function with given fields: ctx
+func (_m *MockIUseCase) Recover(ctx context.Context) ([]schemas.InboxResult, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Recover")
+	}
+
+	var r0 []schemas.InboxResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]schemas.InboxResult, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []schemas.InboxResult); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schemas.InboxResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_Recover_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Recover'
+type MockIUseCase_Recover_Call struct {
+	*mock.Call
+}
+
+// Recover is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIUseCase_Expecter) Recover(ctx interface{}) *MockIUseCase_Recover_Call {
+	return &MockIUseCase_Recover_Call{Call: _e.mock.On("Recover", ctx)}
+}
+
+func (_c *MockIUseCase_Recover_Call) Run(run func(ctx context.Context)) *MockIUseCase_Recover_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_Recover_Call) Return(_a0 []schemas.InboxResult, _a1 error) *MockIUseCase_Recover_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_Recover_Call) RunAndReturn(run func(context.Context) ([]schemas.InboxResult, error)) *MockIUseCase_Recover_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIUseCase creates a new instance of MockIUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIUseCase {
+	mock := &MockIUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
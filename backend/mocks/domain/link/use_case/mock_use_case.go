@@ -0,0 +1,450 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package use_case
+
+import (
+	context "context"
+
+	schemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIUseCase is an autogenerated mock type for the IUseCase type
+type MockIUseCase struct {
+	mock.Mock
+}
+
+type MockIUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIUseCase) EXPECT() *MockIUseCase_Expecter {
+	return &MockIUseCase_Expecter{mock: &_m.Mock}
+}
+
+// ConfirmSuggestion provides a mock function with given fields: ctx, id, req
+func (_m *MockIUseCase) ConfirmSuggestion(ctx context.Context, id string, req schemas.ConfirmSuggestionRequest) (*schemas.LinkSuggestion, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmSuggestion")
+	}
+
+	var r0 *schemas.LinkSuggestion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, schemas.ConfirmSuggestionRequest) (*schemas.LinkSuggestion, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, schemas.ConfirmSuggestionRequest) *schemas.LinkSuggestion); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.LinkSuggestion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, schemas.ConfirmSuggestionRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_ConfirmSuggestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmSuggestion'
+type MockIUseCase_ConfirmSuggestion_Call struct {
+	*mock.Call
+}
+
+// ConfirmSuggestion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - req schemas.ConfirmSuggestionRequest
+func (_e *MockIUseCase_Expecter) ConfirmSuggestion(ctx interface{}, id interface{}, req interface{}) *MockIUseCase_ConfirmSuggestion_Call {
+	return &MockIUseCase_ConfirmSuggestion_Call{Call: _e.mock.On("ConfirmSuggestion", ctx, id, req)}
+}
+
+func (_c *MockIUseCase_ConfirmSuggestion_Call) Run(run func(ctx context.Context, id string, req schemas.ConfirmSuggestionRequest)) *MockIUseCase_ConfirmSuggestion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(schemas.ConfirmSuggestionRequest))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_ConfirmSuggestion_Call) Return(_a0 *schemas.LinkSuggestion, _a1 error) *MockIUseCase_ConfirmSuggestion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_ConfirmSuggestion_Call) RunAndReturn(run func(context.Context, string, schemas.ConfirmSuggestionRequest) (*schemas.LinkSuggestion, error)) *MockIUseCase_ConfirmSuggestion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateLink provides a mock function with given fields: ctx, req
+func (_m *MockIUseCase) CreateLink(ctx context.Context, req schemas.LinkRequest) (*schemas.Transaction, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLink")
+	}
+
+	var r0 *schemas.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.LinkRequest) (*schemas.Transaction, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.LinkRequest) *schemas.Transaction); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, schemas.LinkRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_CreateLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLink'
+type MockIUseCase_CreateLink_Call struct {
+	*mock.Call
+}
+
+// CreateLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req schemas.LinkRequest
+func (_e *MockIUseCase_Expecter) CreateLink(ctx interface{}, req interface{}) *MockIUseCase_CreateLink_Call {
+	return &MockIUseCase_CreateLink_Call{Call: _e.mock.On("CreateLink", ctx, req)}
+}
+
+func (_c *MockIUseCase_CreateLink_Call) Run(run func(ctx context.Context, req schemas.LinkRequest)) *MockIUseCase_CreateLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schemas.LinkRequest))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_CreateLink_Call) Return(_a0 *schemas.Transaction, _a1 error) *MockIUseCase_CreateLink_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_CreateLink_Call) RunAndReturn(run func(context.Context, schemas.LinkRequest) (*schemas.Transaction, error)) *MockIUseCase_CreateLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteLink provides a mock function with given fields: ctx, transactionID
+func (_m *MockIUseCase) DeleteLink(ctx context.Context, transactionID string) (*schemas.Transaction, error) {
+	ret := _m.Called(ctx, transactionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLink")
+	}
+
+	var r0 *schemas.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*schemas.Transaction, error)); ok {
+		return rf(ctx, transactionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *schemas.Transaction); ok {
+		r0 = rf(ctx, transactionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, transactionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_DeleteLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLink'
+type MockIUseCase_DeleteLink_Call struct {
+	*mock.Call
+}
+
+// DeleteLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionID string
+func (_e *MockIUseCase_Expecter) DeleteLink(ctx interface{}, transactionID interface{}) *MockIUseCase_DeleteLink_Call {
+	return &MockIUseCase_DeleteLink_Call{Call: _e.mock.On("DeleteLink", ctx, transactionID)}
+}
+
+func (_c *MockIUseCase_DeleteLink_Call) Run(run func(ctx context.Context, transactionID string)) *MockIUseCase_DeleteLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_DeleteLink_Call) Return(_a0 *schemas.Transaction, _a1 error) *MockIUseCase_DeleteLink_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_DeleteLink_Call) RunAndReturn(run func(context.Context, string) (*schemas.Transaction, error)) *MockIUseCase_DeleteLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSuggestions provides a mock function with given fields: ctx, status, page, pageSize
+func (_m *MockIUseCase) ListSuggestions(ctx context.Context, status string, page int, pageSize int) (*schemas.LinkSuggestionsResponse, error) {
+	ret := _m.Called(ctx, status, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSuggestions")
+	}
+
+	var r0 *schemas.LinkSuggestionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) (*schemas.LinkSuggestionsResponse, error)); ok {
+		return rf(ctx, status, page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) *schemas.LinkSuggestionsResponse); ok {
+		r0 = rf(ctx, status, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.LinkSuggestionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = rf(ctx, status, page, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_ListSuggestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSuggestions'
+type MockIUseCase_ListSuggestions_Call struct {
+	*mock.Call
+}
+
+// ListSuggestions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status string
+//   - page int
+//   - pageSize int
+func (_e *MockIUseCase_Expecter) ListSuggestions(ctx interface{}, status interface{}, page interface{}, pageSize interface{}) *MockIUseCase_ListSuggestions_Call {
+	return &MockIUseCase_ListSuggestions_Call{Call: _e.mock.On("ListSuggestions", ctx, status, page, pageSize)}
+}
+
+func (_c *MockIUseCase_ListSuggestions_Call) Run(run func(ctx context.Context, status string, page int, pageSize int)) *MockIUseCase_ListSuggestions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_ListSuggestions_Call) Return(_a0 *schemas.LinkSuggestionsResponse, _a1 error) *MockIUseCase_ListSuggestions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_ListSuggestions_Call) RunAndReturn(run func(context.Context, string, int, int) (*schemas.LinkSuggestionsResponse, error)) *MockIUseCase_ListSuggestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RejectSuggestion provides a mock function with given fields: ctx, id
+func (_m *MockIUseCase) RejectSuggestion(ctx context.Context, id string) (*schemas.LinkSuggestion, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectSuggestion")
+	}
+
+	var r0 *schemas.LinkSuggestion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*schemas.LinkSuggestion, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *schemas.LinkSuggestion); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.LinkSuggestion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_RejectSuggestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectSuggestion'
+type MockIUseCase_RejectSuggestion_Call struct {
+	*mock.Call
+}
+
+// RejectSuggestion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIUseCase_Expecter) RejectSuggestion(ctx interface{}, id interface{}) *MockIUseCase_RejectSuggestion_Call {
+	return &MockIUseCase_RejectSuggestion_Call{Call: _e.mock.On("RejectSuggestion", ctx, id)}
+}
+
+func (_c *MockIUseCase_RejectSuggestion_Call) Run(run func(ctx context.Context, id string)) *MockIUseCase_RejectSuggestion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_RejectSuggestion_Call) Return(_a0 *schemas.LinkSuggestion, _a1 error) *MockIUseCase_RejectSuggestion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_RejectSuggestion_Call) RunAndReturn(run func(context.Context, string) (*schemas.LinkSuggestion, error)) *MockIUseCase_RejectSuggestion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Suggest provides a mock function with given fields: ctx, transactions
+func (_m *MockIUseCase) Suggest(ctx context.Context, transactions []schemas.Transaction) (int, error) {
+	ret := _m.Called(ctx, transactions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Suggest")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []schemas.Transaction) (int, error)); ok {
+		return rf(ctx, transactions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []schemas.Transaction) int); ok {
+		r0 = rf(ctx, transactions)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []schemas.Transaction) error); ok {
+		r1 = rf(ctx, transactions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_Suggest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Suggest'
+type MockIUseCase_Suggest_Call struct {
+	*mock.Call
+}
+
+// Suggest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactions []schemas.Transaction
+func (_e *MockIUseCase_Expecter) Suggest(ctx interface{}, transactions interface{}) *MockIUseCase_Suggest_Call {
+	return &MockIUseCase_Suggest_Call{Call: _e.mock.On("Suggest", ctx, transactions)}
+}
+
+func (_c *MockIUseCase_Suggest_Call) Run(run func(ctx context.Context, transactions []schemas.Transaction)) *MockIUseCase_Suggest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]schemas.Transaction))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_Suggest_Call) Return(_a0 int, _a1 error) *MockIUseCase_Suggest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_Suggest_Call) RunAndReturn(run func(context.Context, []schemas.Transaction) (int, error)) *MockIUseCase_Suggest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SuggestAll provides a mock function with given fields: ctx
+func (_m *MockIUseCase) SuggestAll(ctx context.Context) (*schemas.SuggestLinksResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SuggestAll")
+	}
+
+	var r0 *schemas.SuggestLinksResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*schemas.SuggestLinksResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *schemas.SuggestLinksResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.SuggestLinksResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_SuggestAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuggestAll'
+type MockIUseCase_SuggestAll_Call struct {
+	*mock.Call
+}
+
+// SuggestAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIUseCase_Expecter) SuggestAll(ctx interface{}) *MockIUseCase_SuggestAll_Call {
+	return &MockIUseCase_SuggestAll_Call{Call: _e.mock.On("SuggestAll", ctx)}
+}
+
+func (_c *MockIUseCase_SuggestAll_Call) Run(run func(ctx context.Context)) *MockIUseCase_SuggestAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_SuggestAll_Call) Return(_a0 *schemas.SuggestLinksResponse, _a1 error) *MockIUseCase_SuggestAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_SuggestAll_Call) RunAndReturn(run func(context.Context) (*schemas.SuggestLinksResponse, error)) *MockIUseCase_SuggestAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIUseCase creates a new instance of MockIUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIUseCase {
+	mock := &MockIUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
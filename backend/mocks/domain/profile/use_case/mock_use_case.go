@@ -0,0 +1,379 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package use_case
+
+import (
+	context "context"
+
+	schemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIUseCase is an autogenerated mock type for the IUseCase type
+type MockIUseCase struct {
+	mock.Mock
+}
+
+type MockIUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIUseCase) EXPECT() *MockIUseCase_Expecter {
+	return &MockIUseCase_Expecter{mock: &_m.Mock}
+}
+
+// CreateProfile provides a mock function with given fields: ctx, req
+func (_m *MockIUseCase) CreateProfile(ctx context.Context, req schemas.ImportProfileRequest) (*schemas.ImportProfile, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProfile")
+	}
+
+	var r0 *schemas.ImportProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.ImportProfileRequest) (*schemas.ImportProfile, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.ImportProfileRequest) *schemas.ImportProfile); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.ImportProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, schemas.ImportProfileRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_CreateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProfile'
+type MockIUseCase_CreateProfile_Call struct {
+	*mock.Call
+}
+
+// CreateProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req schemas.ImportProfileRequest
+func (_e *MockIUseCase_Expecter) CreateProfile(ctx interface{}, req interface{}) *MockIUseCase_CreateProfile_Call {
+	return &MockIUseCase_CreateProfile_Call{Call: _e.mock.On("CreateProfile", ctx, req)}
+}
+
+func (_c *MockIUseCase_CreateProfile_Call) Run(run func(ctx context.Context, req schemas.ImportProfileRequest)) *MockIUseCase_CreateProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schemas.ImportProfileRequest))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_CreateProfile_Call) Return(_a0 *schemas.ImportProfile, _a1 error) *MockIUseCase_CreateProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_CreateProfile_Call) RunAndReturn(run func(context.Context, schemas.ImportProfileRequest) (*schemas.ImportProfile, error)) *MockIUseCase_CreateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProfile provides a mock function with given fields: ctx, id
+func (_m *MockIUseCase) DeleteProfile(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUseCase_DeleteProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProfile'
+type MockIUseCase_DeleteProfile_Call struct {
+	*mock.Call
+}
+
+// DeleteProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIUseCase_Expecter) DeleteProfile(ctx interface{}, id interface{}) *MockIUseCase_DeleteProfile_Call {
+	return &MockIUseCase_DeleteProfile_Call{Call: _e.mock.On("DeleteProfile", ctx, id)}
+}
+
+func (_c *MockIUseCase_DeleteProfile_Call) Run(run func(ctx context.Context, id string)) *MockIUseCase_DeleteProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_DeleteProfile_Call) Return(_a0 error) *MockIUseCase_DeleteProfile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUseCase_DeleteProfile_Call) RunAndReturn(run func(context.Context, string) error) *MockIUseCase_DeleteProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProfile provides a mock function with given fields: ctx, id
+func (_m *MockIUseCase) GetProfile(ctx context.Context, id string) (*schemas.ImportProfile, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProfile")
+	}
+
+	var r0 *schemas.ImportProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*schemas.ImportProfile, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *schemas.ImportProfile); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.ImportProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_GetProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProfile'
+type MockIUseCase_GetProfile_Call struct {
+	*mock.Call
+}
+
+// GetProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIUseCase_Expecter) GetProfile(ctx interface{}, id interface{}) *MockIUseCase_GetProfile_Call {
+	return &MockIUseCase_GetProfile_Call{Call: _e.mock.On("GetProfile", ctx, id)}
+}
+
+func (_c *MockIUseCase_GetProfile_Call) Run(run func(ctx context.Context, id string)) *MockIUseCase_GetProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_GetProfile_Call) Return(_a0 *schemas.ImportProfile, _a1 error) *MockIUseCase_GetProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_GetProfile_Call) RunAndReturn(run func(context.Context, string) (*schemas.ImportProfile, error)) *MockIUseCase_GetProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProfiles provides a mock function with given fields: ctx
+func (_m *MockIUseCase) ListProfiles(ctx context.Context) ([]schemas.ImportProfile, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProfiles")
+	}
+
+	var r0 []schemas.ImportProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]schemas.ImportProfile, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []schemas.ImportProfile); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schemas.ImportProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_ListProfiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProfiles'
+type MockIUseCase_ListProfiles_Call struct {
+	*mock.Call
+}
+
+// ListProfiles is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIUseCase_Expecter) ListProfiles(ctx interface{}) *MockIUseCase_ListProfiles_Call {
+	return &MockIUseCase_ListProfiles_Call{Call: _e.mock.On("ListProfiles", ctx)}
+}
+
+func (_c *MockIUseCase_ListProfiles_Call) Run(run func(ctx context.Context)) *MockIUseCase_ListProfiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_ListProfiles_Call) Return(_a0 []schemas.ImportProfile, _a1 error) *MockIUseCase_ListProfiles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_ListProfiles_Call) RunAndReturn(run func(context.Context) ([]schemas.ImportProfile, error)) *MockIUseCase_ListProfiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveProfile provides a mock function with given fields: ctx, nameOrID
+func (_m *MockIUseCase) ResolveProfile(ctx context.Context, nameOrID string) (*schemas.ImportProfile, error) {
+	ret := _m.Called(ctx, nameOrID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveProfile")
+	}
+
+	var r0 *schemas.ImportProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*schemas.ImportProfile, error)); ok {
+		return rf(ctx, nameOrID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *schemas.ImportProfile); ok {
+		r0 = rf(ctx, nameOrID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.ImportProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, nameOrID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_ResolveProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveProfile'
+type MockIUseCase_ResolveProfile_Call struct {
+	*mock.Call
+}
+
+// ResolveProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nameOrID string
+func (_e *MockIUseCase_Expecter) ResolveProfile(ctx interface{}, nameOrID interface{}) *MockIUseCase_ResolveProfile_Call {
+	return &MockIUseCase_ResolveProfile_Call{Call: _e.mock.On("ResolveProfile", ctx, nameOrID)}
+}
+
+func (_c *MockIUseCase_ResolveProfile_Call) Run(run func(ctx context.Context, nameOrID string)) *MockIUseCase_ResolveProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_ResolveProfile_Call) Return(_a0 *schemas.ImportProfile, _a1 error) *MockIUseCase_ResolveProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_ResolveProfile_Call) RunAndReturn(run func(context.Context, string) (*schemas.ImportProfile, error)) *MockIUseCase_ResolveProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProfile provides a mock function with given fields: ctx, id, req
+func (_m *MockIUseCase) UpdateProfile(ctx context.Context, id string, req schemas.ImportProfileRequest) (*schemas.ImportProfile, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfile")
+	}
+
+	var r0 *schemas.ImportProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, schemas.ImportProfileRequest) (*schemas.ImportProfile, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, schemas.ImportProfileRequest) *schemas.ImportProfile); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.ImportProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, schemas.ImportProfileRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
+type MockIUseCase_UpdateProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - req schemas.ImportProfileRequest
+func (_e *MockIUseCase_Expecter) UpdateProfile(ctx interface{}, id interface{}, req interface{}) *MockIUseCase_UpdateProfile_Call {
+	return &MockIUseCase_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", ctx, id, req)}
+}
+
+func (_c *MockIUseCase_UpdateProfile_Call) Run(run func(ctx context.Context, id string, req schemas.ImportProfileRequest)) *MockIUseCase_UpdateProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(schemas.ImportProfileRequest))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_UpdateProfile_Call) Return(_a0 *schemas.ImportProfile, _a1 error) *MockIUseCase_UpdateProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_UpdateProfile_Call) RunAndReturn(run func(context.Context, string, schemas.ImportProfileRequest) (*schemas.ImportProfile, error)) *MockIUseCase_UpdateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIUseCase creates a new instance of MockIUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIUseCase {
+	mock := &MockIUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
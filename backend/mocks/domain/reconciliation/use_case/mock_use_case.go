@@ -0,0 +1,326 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package use_case
+
+import (
+	context "context"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	schemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+)
+
+// MockIUseCase is an autogenerated mock type for the IUseCase type
+type MockIUseCase struct {
+	mock.Mock
+}
+
+type MockIUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIUseCase) EXPECT() *MockIUseCase_Expecter {
+	return &MockIUseCase_Expecter{mock: &_m.Mock}
+}
+
+// ExportCSV provides a mock function with given fields: ctx, id, result, w
+func (_m *MockIUseCase) ExportCSV(ctx context.Context, id string, result string, w io.Writer) error {
+	ret := _m.Called(ctx, id, result, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportCSV")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, io.Writer) error); ok {
+		r0 = rf(ctx, id, result, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUseCase_ExportCSV_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportCSV'
+type MockIUseCase_ExportCSV_Call struct {
+	*mock.Call
+}
+
+// ExportCSV is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - result string
+//   - w io.Writer
+func (_e *MockIUseCase_Expecter) ExportCSV(ctx interface{}, id interface{}, result interface{}, w interface{}) *MockIUseCase_ExportCSV_Call {
+	return &MockIUseCase_ExportCSV_Call{Call: _e.mock.On("ExportCSV", ctx, id, result, w)}
+}
+
+func (_c *MockIUseCase_ExportCSV_Call) Run(run func(ctx context.Context, id string, result string, w io.Writer)) *MockIUseCase_ExportCSV_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_ExportCSV_Call) Return(_a0 error) *MockIUseCase_ExportCSV_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUseCase_ExportCSV_Call) RunAndReturn(run func(context.Context, string, string, io.Writer) error) *MockIUseCase_ExportCSV_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetItems provides a mock function with given fields: ctx, id, result, page, pageSize
+func (_m *MockIUseCase) GetItems(ctx context.Context, id string, result string, page int, pageSize int) (*schemas.ReconciliationItemsResponse, error) {
+	ret := _m.Called(ctx, id, result, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetItems")
+	}
+
+	var r0 *schemas.ReconciliationItemsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, int) (*schemas.ReconciliationItemsResponse, error)); ok {
+		return rf(ctx, id, result, page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, int) *schemas.ReconciliationItemsResponse); ok {
+		r0 = rf(ctx, id, result, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.ReconciliationItemsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, int) error); ok {
+		r1 = rf(ctx, id, result, page, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_GetItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetItems'
+type MockIUseCase_GetItems_Call struct {
+	*mock.Call
+}
+
+// GetItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - result string
+//   - page int
+//   - pageSize int
+func (_e *MockIUseCase_Expecter) GetItems(ctx interface{}, id interface{}, result interface{}, page interface{}, pageSize interface{}) *MockIUseCase_GetItems_Call {
+	return &MockIUseCase_GetItems_Call{Call: _e.mock.On("GetItems", ctx, id, result, page, pageSize)}
+}
+
+func (_c *MockIUseCase_GetItems_Call) Run(run func(ctx context.Context, id string, result string, page int, pageSize int)) *MockIUseCase_GetItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_GetItems_Call) Return(_a0 *schemas.ReconciliationItemsResponse, _a1 error) *MockIUseCase_GetItems_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_GetItems_Call) RunAndReturn(run func(context.Context, string, string, int, int) (*schemas.ReconciliationItemsResponse, error)) *MockIUseCase_GetItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReconciliation provides a mock function with given fields: ctx, id
+func (_m *MockIUseCase) GetReconciliation(ctx context.Context, id string) (*schemas.Reconciliation, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReconciliation")
+	}
+
+	var r0 *schemas.Reconciliation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*schemas.Reconciliation, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *schemas.Reconciliation); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.Reconciliation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_GetReconciliation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliation'
+type MockIUseCase_GetReconciliation_Call struct {
+	*mock.Call
+}
+
+// GetReconciliation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIUseCase_Expecter) GetReconciliation(ctx interface{}, id interface{}) *MockIUseCase_GetReconciliation_Call {
+	return &MockIUseCase_GetReconciliation_Call{Call: _e.mock.On("GetReconciliation", ctx, id)}
+}
+
+func (_c *MockIUseCase_GetReconciliation_Call) Run(run func(ctx context.Context, id string)) *MockIUseCase_GetReconciliation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_GetReconciliation_Call) Return(_a0 *schemas.Reconciliation, _a1 error) *MockIUseCase_GetReconciliation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_GetReconciliation_Call) RunAndReturn(run func(context.Context, string) (*schemas.Reconciliation, error)) *MockIUseCase_GetReconciliation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReconciliations provides a mock function with given fields: ctx
+func (_m *MockIUseCase) ListReconciliations(ctx context.Context) ([]schemas.Reconciliation, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReconciliations")
+	}
+
+	var r0 []schemas.Reconciliation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]schemas.Reconciliation, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []schemas.Reconciliation); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schemas.Reconciliation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_ListReconciliations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReconciliations'
+type MockIUseCase_ListReconciliations_Call struct {
+	*mock.Call
+}
+
+// ListReconciliations is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIUseCase_Expecter) ListReconciliations(ctx interface{}) *MockIUseCase_ListReconciliations_Call {
+	return &MockIUseCase_ListReconciliations_Call{Call: _e.mock.On("ListReconciliations", ctx)}
+}
+
+func (_c *MockIUseCase_ListReconciliations_Call) Run(run func(ctx context.Context)) *MockIUseCase_ListReconciliations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_ListReconciliations_Call) Return(_a0 []schemas.Reconciliation, _a1 error) *MockIUseCase_ListReconciliations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_ListReconciliations_Call) RunAndReturn(run func(context.Context) ([]schemas.Reconciliation, error)) *MockIUseCase_ListReconciliations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reconcile provides a mock function with given fields: ctx, req
+func (_m *MockIUseCase) Reconcile(ctx context.Context, req schemas.ReconciliationRequest) (*schemas.Reconciliation, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reconcile")
+	}
+
+	var r0 *schemas.Reconciliation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.ReconciliationRequest) (*schemas.Reconciliation, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.ReconciliationRequest) *schemas.Reconciliation); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.Reconciliation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, schemas.ReconciliationRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_Reconcile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reconcile'
+type MockIUseCase_Reconcile_Call struct {
+	*mock.Call
+}
+
+// Reconcile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req schemas.ReconciliationRequest
+func (_e *MockIUseCase_Expecter) Reconcile(ctx interface{}, req interface{}) *MockIUseCase_Reconcile_Call {
+	return &MockIUseCase_Reconcile_Call{Call: _e.mock.On("Reconcile", ctx, req)}
+}
+
+func (_c *MockIUseCase_Reconcile_Call) Run(run func(ctx context.Context, req schemas.ReconciliationRequest)) *MockIUseCase_Reconcile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schemas.ReconciliationRequest))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_Reconcile_Call) Return(_a0 *schemas.Reconciliation, _a1 error) *MockIUseCase_Reconcile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_Reconcile_Call) RunAndReturn(run func(context.Context, schemas.ReconciliationRequest) (*schemas.Reconciliation, error)) *MockIUseCase_Reconcile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIUseCase creates a new instance of MockIUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIUseCase {
+	mock := &MockIUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
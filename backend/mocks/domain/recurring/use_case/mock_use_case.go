@@ -0,0 +1,98 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package use_case
+
+import (
+	context "context"
+	time "time"
+
+	schemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIUseCase is an autogenerated mock type for the IUseCase type
+type MockIUseCase struct {
+	mock.Mock
+}
+
+type MockIUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIUseCase) EXPECT() *MockIUseCase_Expecter {
+	return &MockIUseCase_Expecter{mock: &_m.Mock}
+}
+
+// GetRecurring provides a mock function with given fields: ctx, asOf, filters
+func (_m *MockIUseCase) GetRecurring(ctx context.Context, asOf time.Time, filters schemas.RecurringFilters) (*schemas.RecurringResponse, error) {
+	ret := _m.Called(ctx, asOf, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecurring")
+	}
+
+	var r0 *schemas.RecurringResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, schemas.RecurringFilters) (*schemas.RecurringResponse, error)); ok {
+		return rf(ctx, asOf, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, schemas.RecurringFilters) *schemas.RecurringResponse); ok {
+		r0 = rf(ctx, asOf, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.RecurringResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, schemas.RecurringFilters) error); ok {
+		r1 = rf(ctx, asOf, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_GetRecurring_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecurring'
+type MockIUseCase_GetRecurring_Call struct {
+	*mock.Call
+}
+
+// GetRecurring is a helper method to define mock.On call
+//   - ctx context.Context
+//   - asOf time.Time
+//   - filters schemas.RecurringFilters
+func (_e *MockIUseCase_Expecter) GetRecurring(ctx interface{}, asOf interface{}, filters interface{}) *MockIUseCase_GetRecurring_Call {
+	return &MockIUseCase_GetRecurring_Call{Call: _e.mock.On("GetRecurring", ctx, asOf, filters)}
+}
+
+func (_c *MockIUseCase_GetRecurring_Call) Run(run func(ctx context.Context, asOf time.Time, filters schemas.RecurringFilters)) *MockIUseCase_GetRecurring_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(schemas.RecurringFilters))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_GetRecurring_Call) Return(_a0 *schemas.RecurringResponse, _a1 error) *MockIUseCase_GetRecurring_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_GetRecurring_Call) RunAndReturn(run func(context.Context, time.Time, schemas.RecurringFilters) (*schemas.RecurringResponse, error)) *MockIUseCase_GetRecurring_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIUseCase creates a new instance of MockIUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIUseCase {
+	mock := &MockIUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,99 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package use_case
+
+import (
+	context "context"
+
+	schemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIUseCase is an autogenerated mock type for the IUseCase type
+type MockIUseCase struct {
+	mock.Mock
+}
+
+type MockIUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIUseCase) EXPECT() *MockIUseCase_Expecter {
+	return &MockIUseCase_Expecter{mock: &_m.Mock}
+}
+
+// GetCategoryReport provides a mock function with given fields: ctx, status, startDate, endDate, netOfRefunds
+func (_m *MockIUseCase) GetCategoryReport(ctx context.Context, status string, startDate string, endDate string, netOfRefunds bool) (*schemas.CategoryReportResponse, error) {
+	ret := _m.Called(ctx, status, startDate, endDate, netOfRefunds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoryReport")
+	}
+
+	var r0 *schemas.CategoryReportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, bool) (*schemas.CategoryReportResponse, error)); ok {
+		return rf(ctx, status, startDate, endDate, netOfRefunds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, bool) *schemas.CategoryReportResponse); ok {
+		r0 = rf(ctx, status, startDate, endDate, netOfRefunds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.CategoryReportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, bool) error); ok {
+		r1 = rf(ctx, status, startDate, endDate, netOfRefunds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_GetCategoryReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryReport'
+type MockIUseCase_GetCategoryReport_Call struct {
+	*mock.Call
+}
+
+// GetCategoryReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status string
+//   - startDate string
+//   - endDate string
+//   - netOfRefunds bool
+func (_e *MockIUseCase_Expecter) GetCategoryReport(ctx interface{}, status interface{}, startDate interface{}, endDate interface{}, netOfRefunds interface{}) *MockIUseCase_GetCategoryReport_Call {
+	return &MockIUseCase_GetCategoryReport_Call{Call: _e.mock.On("GetCategoryReport", ctx, status, startDate, endDate, netOfRefunds)}
+}
+
+func (_c *MockIUseCase_GetCategoryReport_Call) Run(run func(ctx context.Context, status string, startDate string, endDate string, netOfRefunds bool)) *MockIUseCase_GetCategoryReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_GetCategoryReport_Call) Return(_a0 *schemas.CategoryReportResponse, _a1 error) *MockIUseCase_GetCategoryReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_GetCategoryReport_Call) RunAndReturn(run func(context.Context, string, string, string, bool) (*schemas.CategoryReportResponse, error)) *MockIUseCase_GetCategoryReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIUseCase creates a new instance of MockIUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIUseCase {
+	mock := &MockIUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,500 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package use_case
+
+import (
+	context "context"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	os "os"
+
+	schemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+)
+
+// MockIUseCase is an autogenerated mock type for the IUseCase type
+type MockIUseCase struct {
+	mock.Mock
+}
+
+type MockIUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIUseCase) EXPECT() *MockIUseCase_Expecter {
+	return &MockIUseCase_Expecter{mock: &_m.Mock}
+}
+
+// AppendChunk provides a mock function with given fields: ctx, id, offset, chunk, checksum
+func (_m *MockIUseCase) AppendChunk(ctx context.Context, id string, offset int64, chunk io.Reader, checksum []byte) (*schemas.UploadSession, error) {
+	ret := _m.Called(ctx, id, offset, chunk, checksum)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AppendChunk")
+	}
+
+	var r0 *schemas.UploadSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, io.Reader, []byte) (*schemas.UploadSession, error)); ok {
+		return rf(ctx, id, offset, chunk, checksum)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, io.Reader, []byte) *schemas.UploadSession); ok {
+		r0 = rf(ctx, id, offset, chunk, checksum)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.UploadSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, io.Reader, []byte) error); ok {
+		r1 = rf(ctx, id, offset, chunk, checksum)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_AppendChunk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppendChunk'
+type MockIUseCase_AppendChunk_Call struct {
+	*mock.Call
+}
+
+// AppendChunk is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - offset int64
+//   - chunk io.Reader
+//   - checksum []byte
+func (_e *MockIUseCase_Expecter) AppendChunk(ctx interface{}, id interface{}, offset interface{}, chunk interface{}, checksum interface{}) *MockIUseCase_AppendChunk_Call {
+	return &MockIUseCase_AppendChunk_Call{Call: _e.mock.On("AppendChunk", ctx, id, offset, chunk, checksum)}
+}
+
+func (_c *MockIUseCase_AppendChunk_Call) Run(run func(ctx context.Context, id string, offset int64, chunk io.Reader, checksum []byte)) *MockIUseCase_AppendChunk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(io.Reader), args[4].([]byte))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_AppendChunk_Call) Return(_a0 *schemas.UploadSession, _a1 error) *MockIUseCase_AppendChunk_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_AppendChunk_Call) RunAndReturn(run func(context.Context, string, int64, io.Reader, []byte) (*schemas.UploadSession, error)) *MockIUseCase_AppendChunk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CleanupExpired provides a mock function with given fields: ctx
+func (_m *MockIUseCase) CleanupExpired(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CleanupExpired")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_CleanupExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CleanupExpired'
+type MockIUseCase_CleanupExpired_Call struct {
+	*mock.Call
+}
+
+// CleanupExpired is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIUseCase_Expecter) CleanupExpired(ctx interface{}) *MockIUseCase_CleanupExpired_Call {
+	return &MockIUseCase_CleanupExpired_Call{Call: _e.mock.On("CleanupExpired", ctx)}
+}
+
+func (_c *MockIUseCase_CleanupExpired_Call) Run(run func(ctx context.Context)) *MockIUseCase_CleanupExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_CleanupExpired_Call) Return(_a0 int, _a1 error) *MockIUseCase_CleanupExpired_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_CleanupExpired_Call) RunAndReturn(run func(context.Context) (int, error)) *MockIUseCase_CleanupExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteSession provides a mock function with given fields: ctx, id, result
+func (_m *MockIUseCase) CompleteSession(ctx context.Context, id string, result interface{}) (*schemas.UploadSession, error) {
+	ret := _m.Called(ctx, id, result)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteSession")
+	}
+
+	var r0 *schemas.UploadSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}) (*schemas.UploadSession, error)); ok {
+		return rf(ctx, id, result)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}) *schemas.UploadSession); ok {
+		r0 = rf(ctx, id, result)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.UploadSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, interface{}) error); ok {
+		r1 = rf(ctx, id, result)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_CompleteSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteSession'
+type MockIUseCase_CompleteSession_Call struct {
+	*mock.Call
+}
+
+// CompleteSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - result interface{}
+func (_e *MockIUseCase_Expecter) CompleteSession(ctx interface{}, id interface{}, result interface{}) *MockIUseCase_CompleteSession_Call {
+	return &MockIUseCase_CompleteSession_Call{Call: _e.mock.On("CompleteSession", ctx, id, result)}
+}
+
+func (_c *MockIUseCase_CompleteSession_Call) Run(run func(ctx context.Context, id string, result interface{})) *MockIUseCase_CompleteSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_CompleteSession_Call) Return(_a0 *schemas.UploadSession, _a1 error) *MockIUseCase_CompleteSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_CompleteSession_Call) RunAndReturn(run func(context.Context, string, interface{}) (*schemas.UploadSession, error)) *MockIUseCase_CompleteSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSession provides a mock function with given fields: ctx, req, format
+func (_m *MockIUseCase) CreateSession(ctx context.Context, req schemas.UploadSessionRequest, format string) (*schemas.UploadSession, error) {
+	ret := _m.Called(ctx, req, format)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSession")
+	}
+
+	var r0 *schemas.UploadSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.UploadSessionRequest, string) (*schemas.UploadSession, error)); ok {
+		return rf(ctx, req, format)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.UploadSessionRequest, string) *schemas.UploadSession); ok {
+		r0 = rf(ctx, req, format)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.UploadSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, schemas.UploadSessionRequest, string) error); ok {
+		r1 = rf(ctx, req, format)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_CreateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSession'
+type MockIUseCase_CreateSession_Call struct {
+	*mock.Call
+}
+
+// CreateSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req schemas.UploadSessionRequest
+//   - format string
+func (_e *MockIUseCase_Expecter) CreateSession(ctx interface{}, req interface{}, format interface{}) *MockIUseCase_CreateSession_Call {
+	return &MockIUseCase_CreateSession_Call{Call: _e.mock.On("CreateSession", ctx, req, format)}
+}
+
+func (_c *MockIUseCase_CreateSession_Call) Run(run func(ctx context.Context, req schemas.UploadSessionRequest, format string)) *MockIUseCase_CreateSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schemas.UploadSessionRequest), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_CreateSession_Call) Return(_a0 *schemas.UploadSession, _a1 error) *MockIUseCase_CreateSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_CreateSession_Call) RunAndReturn(run func(context.Context, schemas.UploadSessionRequest, string) (*schemas.UploadSession, error)) *MockIUseCase_CreateSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSession provides a mock function with given fields: ctx, id
+func (_m *MockIUseCase) DeleteSession(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUseCase_DeleteSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSession'
+type MockIUseCase_DeleteSession_Call struct {
+	*mock.Call
+}
+
+// DeleteSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIUseCase_Expecter) DeleteSession(ctx interface{}, id interface{}) *MockIUseCase_DeleteSession_Call {
+	return &MockIUseCase_DeleteSession_Call{Call: _e.mock.On("DeleteSession", ctx, id)}
+}
+
+func (_c *MockIUseCase_DeleteSession_Call) Run(run func(ctx context.Context, id string)) *MockIUseCase_DeleteSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_DeleteSession_Call) Return(_a0 error) *MockIUseCase_DeleteSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUseCase_DeleteSession_Call) RunAndReturn(run func(context.Context, string) error) *MockIUseCase_DeleteSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSession provides a mock function with given fields: ctx, id
+func (_m *MockIUseCase) GetSession(ctx context.Context, id string) (*schemas.UploadSession, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSession")
+	}
+
+	var r0 *schemas.UploadSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*schemas.UploadSession, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *schemas.UploadSession); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.UploadSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_GetSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSession'
+type MockIUseCase_GetSession_Call struct {
+	*mock.Call
+}
+
+// GetSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIUseCase_Expecter) GetSession(ctx interface{}, id interface{}) *MockIUseCase_GetSession_Call {
+	return &MockIUseCase_GetSession_Call{Call: _e.mock.On("GetSession", ctx, id)}
+}
+
+func (_c *MockIUseCase_GetSession_Call) Run(run func(ctx context.Context, id string)) *MockIUseCase_GetSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_GetSession_Call) Return(_a0 *schemas.UploadSession, _a1 error) *MockIUseCase_GetSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_GetSession_Call) RunAndReturn(run func(context.Context, string) (*schemas.UploadSession, error)) *MockIUseCase_GetSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenCompleted provides a mock function with given fields: ctx, id
+func (_m *MockIUseCase) OpenCompleted(ctx context.Context, id string) (*os.File, *schemas.UploadSession, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenCompleted")
+	}
+
+	var r0 *os.File
+	var r1 *schemas.UploadSession
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*os.File, *schemas.UploadSession, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *os.File); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*os.File)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) *schemas.UploadSession); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*schemas.UploadSession)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, id)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockIUseCase_OpenCompleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenCompleted'
+type MockIUseCase_OpenCompleted_Call struct {
+	*mock.Call
+}
+
+// OpenCompleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIUseCase_Expecter) OpenCompleted(ctx interface{}, id interface{}) *MockIUseCase_OpenCompleted_Call {
+	return &MockIUseCase_OpenCompleted_Call{Call: _e.mock.On("OpenCompleted", ctx, id)}
+}
+
+func (_c *MockIUseCase_OpenCompleted_Call) Run(run func(ctx context.Context, id string)) *MockIUseCase_OpenCompleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_OpenCompleted_Call) Return(_a0 *os.File, _a1 *schemas.UploadSession, _a2 error) *MockIUseCase_OpenCompleted_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockIUseCase_OpenCompleted_Call) RunAndReturn(run func(context.Context, string) (*os.File, *schemas.UploadSession, error)) *MockIUseCase_OpenCompleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseSession provides a mock function with given fields: ctx, id
+func (_m *MockIUseCase) ReleaseSession(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUseCase_ReleaseSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseSession'
+type MockIUseCase_ReleaseSession_Call struct {
+	*mock.Call
+}
+
+// ReleaseSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIUseCase_Expecter) ReleaseSession(ctx interface{}, id interface{}) *MockIUseCase_ReleaseSession_Call {
+	return &MockIUseCase_ReleaseSession_Call{Call: _e.mock.On("ReleaseSession", ctx, id)}
+}
+
+func (_c *MockIUseCase_ReleaseSession_Call) Run(run func(ctx context.Context, id string)) *MockIUseCase_ReleaseSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_ReleaseSession_Call) Return(_a0 error) *MockIUseCase_ReleaseSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUseCase_ReleaseSession_Call) RunAndReturn(run func(context.Context, string) error) *MockIUseCase_ReleaseSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIUseCase creates a new instance of MockIUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIUseCase {
+	mock := &MockIUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
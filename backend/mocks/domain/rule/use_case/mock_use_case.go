@@ -0,0 +1,498 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package use_case
+
+import (
+	context "context"
+
+	schemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIUseCase is an autogenerated mock type for the IUseCase type
+type MockIUseCase struct {
+	mock.Mock
+}
+
+type MockIUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIUseCase) EXPECT() *MockIUseCase_Expecter {
+	return &MockIUseCase_Expecter{mock: &_m.Mock}
+}
+
+// ApplyRule provides a mock function with given fields: ctx, id, req
+func (_m *MockIUseCase) ApplyRule(ctx context.Context, id string, req schemas.RuleApplyRequest) (*schemas.RuleApplyResponse, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyRule")
+	}
+
+	var r0 *schemas.RuleApplyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, schemas.RuleApplyRequest) (*schemas.RuleApplyResponse, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, schemas.RuleApplyRequest) *schemas.RuleApplyResponse); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.RuleApplyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, schemas.RuleApplyRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_ApplyRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyRule'
+type MockIUseCase_ApplyRule_Call struct {
+	*mock.Call
+}
+
+// ApplyRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - req schemas.RuleApplyRequest
+func (_e *MockIUseCase_Expecter) ApplyRule(ctx interface{}, id interface{}, req interface{}) *MockIUseCase_ApplyRule_Call {
+	return &MockIUseCase_ApplyRule_Call{Call: _e.mock.On("ApplyRule", ctx, id, req)}
+}
+
+func (_c *MockIUseCase_ApplyRule_Call) Run(run func(ctx context.Context, id string, req schemas.RuleApplyRequest)) *MockIUseCase_ApplyRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(schemas.RuleApplyRequest))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_ApplyRule_Call) Return(_a0 *schemas.RuleApplyResponse, _a1 error) *MockIUseCase_ApplyRule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_ApplyRule_Call) RunAndReturn(run func(context.Context, string, schemas.RuleApplyRequest) (*schemas.RuleApplyResponse, error)) *MockIUseCase_ApplyRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Categorize provides a mock function with given fields: ctx, transactions
+func (_m *MockIUseCase) Categorize(ctx context.Context, transactions []schemas.Transaction) ([]schemas.TransactionTag, error) {
+	ret := _m.Called(ctx, transactions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Categorize")
+	}
+
+	var r0 []schemas.TransactionTag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []schemas.Transaction) ([]schemas.TransactionTag, error)); ok {
+		return rf(ctx, transactions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []schemas.Transaction) []schemas.TransactionTag); ok {
+		r0 = rf(ctx, transactions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schemas.TransactionTag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []schemas.Transaction) error); ok {
+		r1 = rf(ctx, transactions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_Categorize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Categorize'
+type MockIUseCase_Categorize_Call struct {
+	*mock.Call
+}
+
+// Categorize is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactions []schemas.Transaction
+func (_e *MockIUseCase_Expecter) Categorize(ctx interface{}, transactions interface{}) *MockIUseCase_Categorize_Call {
+	return &MockIUseCase_Categorize_Call{Call: _e.mock.On("Categorize", ctx, transactions)}
+}
+
+func (_c *MockIUseCase_Categorize_Call) Run(run func(ctx context.Context, transactions []schemas.Transaction)) *MockIUseCase_Categorize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]schemas.Transaction))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_Categorize_Call) Return(_a0 []schemas.TransactionTag, _a1 error) *MockIUseCase_Categorize_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_Categorize_Call) RunAndReturn(run func(context.Context, []schemas.Transaction) ([]schemas.TransactionTag, error)) *MockIUseCase_Categorize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRule provides a mock function with given fields: ctx, req
+func (_m *MockIUseCase) CreateRule(ctx context.Context, req schemas.RuleRequest) (*schemas.Rule, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRule")
+	}
+
+	var r0 *schemas.Rule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.RuleRequest) (*schemas.Rule, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.RuleRequest) *schemas.Rule); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.Rule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, schemas.RuleRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_CreateRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRule'
+type MockIUseCase_CreateRule_Call struct {
+	*mock.Call
+}
+
+// CreateRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req schemas.RuleRequest
+func (_e *MockIUseCase_Expecter) CreateRule(ctx interface{}, req interface{}) *MockIUseCase_CreateRule_Call {
+	return &MockIUseCase_CreateRule_Call{Call: _e.mock.On("CreateRule", ctx, req)}
+}
+
+func (_c *MockIUseCase_CreateRule_Call) Run(run func(ctx context.Context, req schemas.RuleRequest)) *MockIUseCase_CreateRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schemas.RuleRequest))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_CreateRule_Call) Return(_a0 *schemas.Rule, _a1 error) *MockIUseCase_CreateRule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_CreateRule_Call) RunAndReturn(run func(context.Context, schemas.RuleRequest) (*schemas.Rule, error)) *MockIUseCase_CreateRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRule provides a mock function with given fields: ctx, id
+func (_m *MockIUseCase) DeleteRule(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUseCase_DeleteRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRule'
+type MockIUseCase_DeleteRule_Call struct {
+	*mock.Call
+}
+
+// DeleteRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIUseCase_Expecter) DeleteRule(ctx interface{}, id interface{}) *MockIUseCase_DeleteRule_Call {
+	return &MockIUseCase_DeleteRule_Call{Call: _e.mock.On("DeleteRule", ctx, id)}
+}
+
+func (_c *MockIUseCase_DeleteRule_Call) Run(run func(ctx context.Context, id string)) *MockIUseCase_DeleteRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_DeleteRule_Call) Return(_a0 error) *MockIUseCase_DeleteRule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUseCase_DeleteRule_Call) RunAndReturn(run func(context.Context, string) error) *MockIUseCase_DeleteRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRule provides a mock function with given fields: ctx, id
+func (_m *MockIUseCase) GetRule(ctx context.Context, id string) (*schemas.Rule, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRule")
+	}
+
+	var r0 *schemas.Rule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*schemas.Rule, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *schemas.Rule); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.Rule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_GetRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRule'
+type MockIUseCase_GetRule_Call struct {
+	*mock.Call
+}
+
+// GetRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIUseCase_Expecter) GetRule(ctx interface{}, id interface{}) *MockIUseCase_GetRule_Call {
+	return &MockIUseCase_GetRule_Call{Call: _e.mock.On("GetRule", ctx, id)}
+}
+
+func (_c *MockIUseCase_GetRule_Call) Run(run func(ctx context.Context, id string)) *MockIUseCase_GetRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_GetRule_Call) Return(_a0 *schemas.Rule, _a1 error) *MockIUseCase_GetRule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_GetRule_Call) RunAndReturn(run func(context.Context, string) (*schemas.Rule, error)) *MockIUseCase_GetRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRules provides a mock function with given fields: ctx
+func (_m *MockIUseCase) ListRules(ctx context.Context) ([]schemas.Rule, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRules")
+	}
+
+	var r0 []schemas.Rule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]schemas.Rule, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []schemas.Rule); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schemas.Rule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_ListRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRules'
+type MockIUseCase_ListRules_Call struct {
+	*mock.Call
+}
+
+// ListRules is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIUseCase_Expecter) ListRules(ctx interface{}) *MockIUseCase_ListRules_Call {
+	return &MockIUseCase_ListRules_Call{Call: _e.mock.On("ListRules", ctx)}
+}
+
+func (_c *MockIUseCase_ListRules_Call) Run(run func(ctx context.Context)) *MockIUseCase_ListRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_ListRules_Call) Return(_a0 []schemas.Rule, _a1 error) *MockIUseCase_ListRules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_ListRules_Call) RunAndReturn(run func(context.Context) ([]schemas.Rule, error)) *MockIUseCase_ListRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TestRule provides a mock function with given fields: ctx, req
+func (_m *MockIUseCase) TestRule(ctx context.Context, req schemas.RuleRequest) (*schemas.RuleTestResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TestRule")
+	}
+
+	var r0 *schemas.RuleTestResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.RuleRequest) (*schemas.RuleTestResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.RuleRequest) *schemas.RuleTestResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.RuleTestResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, schemas.RuleRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_TestRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TestRule'
+type MockIUseCase_TestRule_Call struct {
+	*mock.Call
+}
+
+// TestRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req schemas.RuleRequest
+func (_e *MockIUseCase_Expecter) TestRule(ctx interface{}, req interface{}) *MockIUseCase_TestRule_Call {
+	return &MockIUseCase_TestRule_Call{Call: _e.mock.On("TestRule", ctx, req)}
+}
+
+func (_c *MockIUseCase_TestRule_Call) Run(run func(ctx context.Context, req schemas.RuleRequest)) *MockIUseCase_TestRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schemas.RuleRequest))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_TestRule_Call) Return(_a0 *schemas.RuleTestResponse, _a1 error) *MockIUseCase_TestRule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_TestRule_Call) RunAndReturn(run func(context.Context, schemas.RuleRequest) (*schemas.RuleTestResponse, error)) *MockIUseCase_TestRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRule provides a mock function with given fields: ctx, id, req
+func (_m *MockIUseCase) UpdateRule(ctx context.Context, id string, req schemas.RuleRequest) (*schemas.Rule, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRule")
+	}
+
+	var r0 *schemas.Rule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, schemas.RuleRequest) (*schemas.Rule, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, schemas.RuleRequest) *schemas.Rule); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.Rule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, schemas.RuleRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_UpdateRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRule'
+type MockIUseCase_UpdateRule_Call struct {
+	*mock.Call
+}
+
+// UpdateRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - req schemas.RuleRequest
+func (_e *MockIUseCase_Expecter) UpdateRule(ctx interface{}, id interface{}, req interface{}) *MockIUseCase_UpdateRule_Call {
+	return &MockIUseCase_UpdateRule_Call{Call: _e.mock.On("UpdateRule", ctx, id, req)}
+}
+
+func (_c *MockIUseCase_UpdateRule_Call) Run(run func(ctx context.Context, id string, req schemas.RuleRequest)) *MockIUseCase_UpdateRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(schemas.RuleRequest))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_UpdateRule_Call) Return(_a0 *schemas.Rule, _a1 error) *MockIUseCase_UpdateRule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_UpdateRule_Call) RunAndReturn(run func(context.Context, string, schemas.RuleRequest) (*schemas.Rule, error)) *MockIUseCase_UpdateRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIUseCase creates a new instance of MockIUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIUseCase {
+	mock := &MockIUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
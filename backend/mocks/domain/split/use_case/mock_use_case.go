@@ -0,0 +1,159 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package use_case
+
+import (
+	context "context"
+
+	schemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+	mock "github.com/stretchr/testify/mock"
+
+	validator "github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
+)
+
+// MockIUseCase is an autogenerated mock type for the IUseCase type
+type MockIUseCase struct {
+	mock.Mock
+}
+
+type MockIUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIUseCase) EXPECT() *MockIUseCase_Expecter {
+	return &MockIUseCase_Expecter{mock: &_m.Mock}
+}
+
+// GetSplits provides a mock function with given fields: ctx, transactionID
+func (_m *MockIUseCase) GetSplits(ctx context.Context, transactionID string) (*schemas.SplitResponse, error) {
+	ret := _m.Called(ctx, transactionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSplits")
+	}
+
+	var r0 *schemas.SplitResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*schemas.SplitResponse, error)); ok {
+		return rf(ctx, transactionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *schemas.SplitResponse); ok {
+		r0 = rf(ctx, transactionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.SplitResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, transactionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_GetSplits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSplits'
+type MockIUseCase_GetSplits_Call struct {
+	*mock.Call
+}
+
+// GetSplits is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionID string
+func (_e *MockIUseCase_Expecter) GetSplits(ctx interface{}, transactionID interface{}) *MockIUseCase_GetSplits_Call {
+	return &MockIUseCase_GetSplits_Call{Call: _e.mock.On("GetSplits", ctx, transactionID)}
+}
+
+func (_c *MockIUseCase_GetSplits_Call) Run(run func(ctx context.Context, transactionID string)) *MockIUseCase_GetSplits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_GetSplits_Call) Return(_a0 *schemas.SplitResponse, _a1 error) *MockIUseCase_GetSplits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_GetSplits_Call) RunAndReturn(run func(context.Context, string) (*schemas.SplitResponse, error)) *MockIUseCase_GetSplits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceSplits provides a mock function with given fields: ctx, transactionID, req, fieldValidator
+func (_m *MockIUseCase) ReplaceSplits(ctx context.Context, transactionID string, req schemas.SplitRequest, fieldValidator *validator.FieldValidator) (*schemas.SplitResponse, error) {
+	ret := _m.Called(ctx, transactionID, req, fieldValidator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceSplits")
+	}
+
+	var r0 *schemas.SplitResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, schemas.SplitRequest, *validator.FieldValidator) (*schemas.SplitResponse, error)); ok {
+		return rf(ctx, transactionID, req, fieldValidator)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, schemas.SplitRequest, *validator.FieldValidator) *schemas.SplitResponse); ok {
+		r0 = rf(ctx, transactionID, req, fieldValidator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.SplitResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, schemas.SplitRequest, *validator.FieldValidator) error); ok {
+		r1 = rf(ctx, transactionID, req, fieldValidator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_ReplaceSplits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceSplits'
+type MockIUseCase_ReplaceSplits_Call struct {
+	*mock.Call
+}
+
+// ReplaceSplits is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionID string
+//   - req schemas.SplitRequest
+//   - fieldValidator *validator.FieldValidator
+func (_e *MockIUseCase_Expecter) ReplaceSplits(ctx interface{}, transactionID interface{}, req interface{}, fieldValidator interface{}) *MockIUseCase_ReplaceSplits_Call {
+	return &MockIUseCase_ReplaceSplits_Call{Call: _e.mock.On("ReplaceSplits", ctx, transactionID, req, fieldValidator)}
+}
+
+func (_c *MockIUseCase_ReplaceSplits_Call) Run(run func(ctx context.Context, transactionID string, req schemas.SplitRequest, fieldValidator *validator.FieldValidator)) *MockIUseCase_ReplaceSplits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(schemas.SplitRequest), args[3].(*validator.FieldValidator))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_ReplaceSplits_Call) Return(_a0 *schemas.SplitResponse, _a1 error) *MockIUseCase_ReplaceSplits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_ReplaceSplits_Call) RunAndReturn(run func(context.Context, string, schemas.SplitRequest, *validator.FieldValidator) (*schemas.SplitResponse, error)) *MockIUseCase_ReplaceSplits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIUseCase creates a new instance of MockIUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIUseCase {
+	mock := &MockIUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
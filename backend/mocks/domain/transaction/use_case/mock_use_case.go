@@ -1,13 +1,16 @@
-// Code generated by mockery v2.53.0. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package use_case
 
 import (
 	context "context"
+	io "io"
 
 	mock "github.com/stretchr/testify/mock"
 
 	schemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+
+	validator "github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
 )
 
 // MockIUseCase is an autogenerated mock type for the IUseCase type
@@ -23,6 +26,323 @@ func (_m *MockIUseCase) EXPECT() *MockIUseCase_Expecter {
 	return &MockIUseCase_Expecter{mock: &_m.Mock}
 }
 
+// CheckExport provides a mock function with given fields: ctx, format, filters
+func (_m *MockIUseCase) CheckExport(ctx context.Context, format string, filters schemas.TransactionFilters) error {
+	ret := _m.Called(ctx, format, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckExport")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, schemas.TransactionFilters) error); ok {
+		r0 = rf(ctx, format, filters)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUseCase_CheckExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckExport'
+type MockIUseCase_CheckExport_Call struct {
+	*mock.Call
+}
+
+// CheckExport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - format string
+//   - filters schemas.TransactionFilters
+func (_e *MockIUseCase_Expecter) CheckExport(ctx interface{}, format interface{}, filters interface{}) *MockIUseCase_CheckExport_Call {
+	return &MockIUseCase_CheckExport_Call{Call: _e.mock.On("CheckExport", ctx, format, filters)}
+}
+
+func (_c *MockIUseCase_CheckExport_Call) Run(run func(ctx context.Context, format string, filters schemas.TransactionFilters)) *MockIUseCase_CheckExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(schemas.TransactionFilters))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_CheckExport_Call) Return(_a0 error) *MockIUseCase_CheckExport_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUseCase_CheckExport_Call) RunAndReturn(run func(context.Context, string, schemas.TransactionFilters) error) *MockIUseCase_CheckExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTransaction provides a mock function with given fields: ctx, req, fieldValidator
+func (_m *MockIUseCase) CreateTransaction(ctx context.Context, req schemas.TransactionRequest, fieldValidator *validator.FieldValidator) (*schemas.Transaction, error) {
+	ret := _m.Called(ctx, req, fieldValidator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTransaction")
+	}
+
+	var r0 *schemas.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.TransactionRequest, *validator.FieldValidator) (*schemas.Transaction, error)); ok {
+		return rf(ctx, req, fieldValidator)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.TransactionRequest, *validator.FieldValidator) *schemas.Transaction); ok {
+		r0 = rf(ctx, req, fieldValidator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, schemas.TransactionRequest, *validator.FieldValidator) error); ok {
+		r1 = rf(ctx, req, fieldValidator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_CreateTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTransaction'
+type MockIUseCase_CreateTransaction_Call struct {
+	*mock.Call
+}
+
+// CreateTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req schemas.TransactionRequest
+//   - fieldValidator *validator.FieldValidator
+func (_e *MockIUseCase_Expecter) CreateTransaction(ctx interface{}, req interface{}, fieldValidator interface{}) *MockIUseCase_CreateTransaction_Call {
+	return &MockIUseCase_CreateTransaction_Call{Call: _e.mock.On("CreateTransaction", ctx, req, fieldValidator)}
+}
+
+func (_c *MockIUseCase_CreateTransaction_Call) Run(run func(ctx context.Context, req schemas.TransactionRequest, fieldValidator *validator.FieldValidator)) *MockIUseCase_CreateTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schemas.TransactionRequest), args[2].(*validator.FieldValidator))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_CreateTransaction_Call) Return(_a0 *schemas.Transaction, _a1 error) *MockIUseCase_CreateTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_CreateTransaction_Call) RunAndReturn(run func(context.Context, schemas.TransactionRequest, *validator.FieldValidator) (*schemas.Transaction, error)) *MockIUseCase_CreateTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTransaction provides a mock function with given fields: ctx, id
+func (_m *MockIUseCase) DeleteTransaction(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUseCase_DeleteTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTransaction'
+type MockIUseCase_DeleteTransaction_Call struct {
+	*mock.Call
+}
+
+// DeleteTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIUseCase_Expecter) DeleteTransaction(ctx interface{}, id interface{}) *MockIUseCase_DeleteTransaction_Call {
+	return &MockIUseCase_DeleteTransaction_Call{Call: _e.mock.On("DeleteTransaction", ctx, id)}
+}
+
+func (_c *MockIUseCase_DeleteTransaction_Call) Run(run func(ctx context.Context, id string)) *MockIUseCase_DeleteTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_DeleteTransaction_Call) Return(_a0 error) *MockIUseCase_DeleteTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUseCase_DeleteTransaction_Call) RunAndReturn(run func(context.Context, string) error) *MockIUseCase_DeleteTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportCSV provides a mock function with given fields: ctx, filters, sort, escapeFormulas, w
+func (_m *MockIUseCase) ExportCSV(ctx context.Context, filters schemas.TransactionFilters, sort schemas.TransactionSort, escapeFormulas bool, w io.Writer) error {
+	ret := _m.Called(ctx, filters, sort, escapeFormulas, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportCSV")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.TransactionFilters, schemas.TransactionSort, bool, io.Writer) error); ok {
+		r0 = rf(ctx, filters, sort, escapeFormulas, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUseCase_ExportCSV_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportCSV'
+type MockIUseCase_ExportCSV_Call struct {
+	*mock.Call
+}
+
+// ExportCSV is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters schemas.TransactionFilters
+//   - sort schemas.TransactionSort
+//   - escapeFormulas bool
+//   - w io.Writer
+func (_e *MockIUseCase_Expecter) ExportCSV(ctx interface{}, filters interface{}, sort interface{}, escapeFormulas interface{}, w interface{}) *MockIUseCase_ExportCSV_Call {
+	return &MockIUseCase_ExportCSV_Call{Call: _e.mock.On("ExportCSV", ctx, filters, sort, escapeFormulas, w)}
+}
+
+func (_c *MockIUseCase_ExportCSV_Call) Run(run func(ctx context.Context, filters schemas.TransactionFilters, sort schemas.TransactionSort, escapeFormulas bool, w io.Writer)) *MockIUseCase_ExportCSV_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schemas.TransactionFilters), args[2].(schemas.TransactionSort), args[3].(bool), args[4].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_ExportCSV_Call) Return(_a0 error) *MockIUseCase_ExportCSV_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUseCase_ExportCSV_Call) RunAndReturn(run func(context.Context, schemas.TransactionFilters, schemas.TransactionSort, bool, io.Writer) error) *MockIUseCase_ExportCSV_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportXLSX provides a mock function with given fields: ctx, filters, sort, w
+func (_m *MockIUseCase) ExportXLSX(ctx context.Context, filters schemas.TransactionFilters, sort schemas.TransactionSort, w io.Writer) error {
+	ret := _m.Called(ctx, filters, sort, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportXLSX")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.TransactionFilters, schemas.TransactionSort, io.Writer) error); ok {
+		r0 = rf(ctx, filters, sort, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUseCase_ExportXLSX_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportXLSX'
+type MockIUseCase_ExportXLSX_Call struct {
+	*mock.Call
+}
+
+// ExportXLSX is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters schemas.TransactionFilters
+//   - sort schemas.TransactionSort
+//   - w io.Writer
+func (_e *MockIUseCase_Expecter) ExportXLSX(ctx interface{}, filters interface{}, sort interface{}, w interface{}) *MockIUseCase_ExportXLSX_Call {
+	return &MockIUseCase_ExportXLSX_Call{Call: _e.mock.On("ExportXLSX", ctx, filters, sort, w)}
+}
+
+func (_c *MockIUseCase_ExportXLSX_Call) Run(run func(ctx context.Context, filters schemas.TransactionFilters, sort schemas.TransactionSort, w io.Writer)) *MockIUseCase_ExportXLSX_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schemas.TransactionFilters), args[2].(schemas.TransactionSort), args[3].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_ExportXLSX_Call) Return(_a0 error) *MockIUseCase_ExportXLSX_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUseCase_ExportXLSX_Call) RunAndReturn(run func(context.Context, schemas.TransactionFilters, schemas.TransactionSort, io.Writer) error) *MockIUseCase_ExportXLSX_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllWithFiltersAndSort provides a mock function with given fields: ctx, page, pageSize, filters, sort, expand
+func (_m *MockIUseCase) GetAllWithFiltersAndSort(ctx context.Context, page int, pageSize int, filters schemas.TransactionFilters, sort schemas.TransactionSort, expand schemas.TransactionExpand) (*schemas.IssuesResponse, error) {
+	ret := _m.Called(ctx, page, pageSize, filters, sort, expand)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllWithFiltersAndSort")
+	}
+
+	var r0 *schemas.IssuesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, schemas.TransactionFilters, schemas.TransactionSort, schemas.TransactionExpand) (*schemas.IssuesResponse, error)); ok {
+		return rf(ctx, page, pageSize, filters, sort, expand)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, schemas.TransactionFilters, schemas.TransactionSort, schemas.TransactionExpand) *schemas.IssuesResponse); ok {
+		r0 = rf(ctx, page, pageSize, filters, sort, expand)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.IssuesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, schemas.TransactionFilters, schemas.TransactionSort, schemas.TransactionExpand) error); ok {
+		r1 = rf(ctx, page, pageSize, filters, sort, expand)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_GetAllWithFiltersAndSort_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllWithFiltersAndSort'
+type MockIUseCase_GetAllWithFiltersAndSort_Call struct {
+	*mock.Call
+}
+
+// GetAllWithFiltersAndSort is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page int
+//   - pageSize int
+//   - filters schemas.TransactionFilters
+//   - sort schemas.TransactionSort
+//   - expand schemas.TransactionExpand
+func (_e *MockIUseCase_Expecter) GetAllWithFiltersAndSort(ctx interface{}, page interface{}, pageSize interface{}, filters interface{}, sort interface{}, expand interface{}) *MockIUseCase_GetAllWithFiltersAndSort_Call {
+	return &MockIUseCase_GetAllWithFiltersAndSort_Call{Call: _e.mock.On("GetAllWithFiltersAndSort", ctx, page, pageSize, filters, sort, expand)}
+}
+
+func (_c *MockIUseCase_GetAllWithFiltersAndSort_Call) Run(run func(ctx context.Context, page int, pageSize int, filters schemas.TransactionFilters, sort schemas.TransactionSort, expand schemas.TransactionExpand)) *MockIUseCase_GetAllWithFiltersAndSort_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(schemas.TransactionFilters), args[4].(schemas.TransactionSort), args[5].(schemas.TransactionExpand))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_GetAllWithFiltersAndSort_Call) Return(_a0 *schemas.IssuesResponse, _a1 error) *MockIUseCase_GetAllWithFiltersAndSort_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_GetAllWithFiltersAndSort_Call) RunAndReturn(run func(context.Context, int, int, schemas.TransactionFilters, schemas.TransactionSort, schemas.TransactionExpand) (*schemas.IssuesResponse, error)) *MockIUseCase_GetAllWithFiltersAndSort_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetBalance provides a mock function with given fields: ctx
 func (_m *MockIUseCase) GetBalance(ctx context.Context) (*schemas.BalanceResponse, error) {
 	ret := _m.Called(ctx)
@@ -59,6 +379,7 @@ type MockIUseCase_GetBalance_Call struct {
 }
 
 // GetBalance is a helper method to define mock.On call
+//   - ctx context.Context
 func (_e *MockIUseCase_Expecter) GetBalance(ctx interface{}) *MockIUseCase_GetBalance_Call {
 	return &MockIUseCase_GetBalance_Call{Call: _e.mock.On("GetBalance", ctx)}
 }
@@ -116,6 +437,9 @@ type MockIUseCase_GetIssues_Call struct {
 }
 
 // GetIssues is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page int
+//   - pageSize int
 func (_e *MockIUseCase_Expecter) GetIssues(ctx interface{}, page interface{}, pageSize interface{}) *MockIUseCase_GetIssues_Call {
 	return &MockIUseCase_GetIssues_Call{Call: _e.mock.On("GetIssues", ctx, page, pageSize)}
 }
@@ -137,6 +461,65 @@ func (_c *MockIUseCase_GetIssues_Call) RunAndReturn(run func(context.Context, in
 	return _c
 }
 
+// GetIssuesSummary provides a mock function with given fields: ctx, filters
+func (_m *MockIUseCase) GetIssuesSummary(ctx context.Context, filters schemas.TransactionFilters) (*schemas.IssuesSummaryResponse, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIssuesSummary")
+	}
+
+	var r0 *schemas.IssuesSummaryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.TransactionFilters) (*schemas.IssuesSummaryResponse, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, schemas.TransactionFilters) *schemas.IssuesSummaryResponse); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.IssuesSummaryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, schemas.TransactionFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_GetIssuesSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIssuesSummary'
+type MockIUseCase_GetIssuesSummary_Call struct {
+	*mock.Call
+}
+
+// GetIssuesSummary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters schemas.TransactionFilters
+func (_e *MockIUseCase_Expecter) GetIssuesSummary(ctx interface{}, filters interface{}) *MockIUseCase_GetIssuesSummary_Call {
+	return &MockIUseCase_GetIssuesSummary_Call{Call: _e.mock.On("GetIssuesSummary", ctx, filters)}
+}
+
+func (_c *MockIUseCase_GetIssuesSummary_Call) Run(run func(ctx context.Context, filters schemas.TransactionFilters)) *MockIUseCase_GetIssuesSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schemas.TransactionFilters))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_GetIssuesSummary_Call) Return(_a0 *schemas.IssuesSummaryResponse, _a1 error) *MockIUseCase_GetIssuesSummary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_GetIssuesSummary_Call) RunAndReturn(run func(context.Context, schemas.TransactionFilters) (*schemas.IssuesSummaryResponse, error)) *MockIUseCase_GetIssuesSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetIssuesWithFiltersAndSort provides a mock function with given fields: ctx, page, pageSize, filters, sort
 func (_m *MockIUseCase) GetIssuesWithFiltersAndSort(ctx context.Context, page int, pageSize int, filters schemas.TransactionFilters, sort schemas.TransactionSort) (*schemas.IssuesResponse, error) {
 	ret := _m.Called(ctx, page, pageSize, filters, sort)
@@ -173,6 +556,11 @@ type MockIUseCase_GetIssuesWithFiltersAndSort_Call struct {
 }
 
 // GetIssuesWithFiltersAndSort is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page int
+//   - pageSize int
+//   - filters schemas.TransactionFilters
+//   - sort schemas.TransactionSort
 func (_e *MockIUseCase_Expecter) GetIssuesWithFiltersAndSort(ctx interface{}, page interface{}, pageSize interface{}, filters interface{}, sort interface{}) *MockIUseCase_GetIssuesWithFiltersAndSort_Call {
 	return &MockIUseCase_GetIssuesWithFiltersAndSort_Call{Call: _e.mock.On("GetIssuesWithFiltersAndSort", ctx, page, pageSize, filters, sort)}
 }
@@ -194,7 +582,188 @@ func (_c *MockIUseCase_GetIssuesWithFiltersAndSort_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// GetTransaction provides a mock function with given fields: ctx, id
+func (_m *MockIUseCase) GetTransaction(ctx context.Context, id string) (*schemas.Transaction, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransaction")
+	}
+
+	var r0 *schemas.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*schemas.Transaction, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *schemas.Transaction); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_GetTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransaction'
+type MockIUseCase_GetTransaction_Call struct {
+	*mock.Call
+}
+
+// GetTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIUseCase_Expecter) GetTransaction(ctx interface{}, id interface{}) *MockIUseCase_GetTransaction_Call {
+	return &MockIUseCase_GetTransaction_Call{Call: _e.mock.On("GetTransaction", ctx, id)}
+}
+
+func (_c *MockIUseCase_GetTransaction_Call) Run(run func(ctx context.Context, id string)) *MockIUseCase_GetTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_GetTransaction_Call) Return(_a0 *schemas.Transaction, _a1 error) *MockIUseCase_GetTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_GetTransaction_Call) RunAndReturn(run func(context.Context, string) (*schemas.Transaction, error)) *MockIUseCase_GetTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTransactionRevisions provides a mock function with given fields: ctx, id
+func (_m *MockIUseCase) GetTransactionRevisions(ctx context.Context, id string) ([]schemas.TransactionRevision, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionRevisions")
+	}
+
+	var r0 []schemas.TransactionRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]schemas.TransactionRevision, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []schemas.TransactionRevision); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schemas.TransactionRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_GetTransactionRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransactionRevisions'
+type MockIUseCase_GetTransactionRevisions_Call struct {
+	*mock.Call
+}
+
+// GetTransactionRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIUseCase_Expecter) GetTransactionRevisions(ctx interface{}, id interface{}) *MockIUseCase_GetTransactionRevisions_Call {
+	return &MockIUseCase_GetTransactionRevisions_Call{Call: _e.mock.On("GetTransactionRevisions", ctx, id)}
+}
+
+func (_c *MockIUseCase_GetTransactionRevisions_Call) Run(run func(ctx context.Context, id string)) *MockIUseCase_GetTransactionRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_GetTransactionRevisions_Call) Return(_a0 []schemas.TransactionRevision, _a1 error) *MockIUseCase_GetTransactionRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_GetTransactionRevisions_Call) RunAndReturn(run func(context.Context, string) ([]schemas.TransactionRevision, error)) *MockIUseCase_GetTransactionRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTransaction provides a mock function with given fields: ctx, id, req, partial, fieldValidator
+func (_m *MockIUseCase) UpdateTransaction(ctx context.Context, id string, req schemas.TransactionRequest, partial bool, fieldValidator *validator.FieldValidator) (*schemas.Transaction, error) {
+	ret := _m.Called(ctx, id, req, partial, fieldValidator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTransaction")
+	}
+
+	var r0 *schemas.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, schemas.TransactionRequest, bool, *validator.FieldValidator) (*schemas.Transaction, error)); ok {
+		return rf(ctx, id, req, partial, fieldValidator)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, schemas.TransactionRequest, bool, *validator.FieldValidator) *schemas.Transaction); ok {
+		r0 = rf(ctx, id, req, partial, fieldValidator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, schemas.TransactionRequest, bool, *validator.FieldValidator) error); ok {
+		r1 = rf(ctx, id, req, partial, fieldValidator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_UpdateTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTransaction'
+type MockIUseCase_UpdateTransaction_Call struct {
+	*mock.Call
+}
+
+// UpdateTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - req schemas.TransactionRequest
+//   - partial bool
+//   - fieldValidator *validator.FieldValidator
+func (_e *MockIUseCase_Expecter) UpdateTransaction(ctx interface{}, id interface{}, req interface{}, partial interface{}, fieldValidator interface{}) *MockIUseCase_UpdateTransaction_Call {
+	return &MockIUseCase_UpdateTransaction_Call{Call: _e.mock.On("UpdateTransaction", ctx, id, req, partial, fieldValidator)}
+}
+
+func (_c *MockIUseCase_UpdateTransaction_Call) Run(run func(ctx context.Context, id string, req schemas.TransactionRequest, partial bool, fieldValidator *validator.FieldValidator)) *MockIUseCase_UpdateTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(schemas.TransactionRequest), args[3].(bool), args[4].(*validator.FieldValidator))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_UpdateTransaction_Call) Return(_a0 *schemas.Transaction, _a1 error) *MockIUseCase_UpdateTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_UpdateTransaction_Call) RunAndReturn(run func(context.Context, string, schemas.TransactionRequest, bool, *validator.FieldValidator) (*schemas.Transaction, error)) *MockIUseCase_UpdateTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockIUseCase creates a new instance of MockIUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
 func NewMockIUseCase(t interface {
 	mock.TestingT
 	Cleanup(func())
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.53.0. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package use_case
 
@@ -9,6 +9,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	schemas "github.com/fadlytanjung/flip-fullstack-test/backend/domain/transaction/schemas"
+
+	validator "github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
 )
 
 // MockIUseCase is an autogenerated mock type for the IUseCase type
@@ -24,9 +26,183 @@ func (_m *MockIUseCase) EXPECT() *MockIUseCase_Expecter {
 	return &MockIUseCase_Expecter{mock: &_m.Mock}
 }
 
-// ParseAndStore provides a mock function with given fields: ctx, file
-func (_m *MockIUseCase) ParseAndStore(ctx context.Context, file io.Reader) (*schemas.UploadResponse, error) {
-	ret := _m.Called(ctx, file)
+// Clear provides a mock function with given fields: ctx
+func (_m *MockIUseCase) Clear(ctx context.Context) (*schemas.ClearResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Clear")
+	}
+
+	var r0 *schemas.ClearResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*schemas.ClearResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *schemas.ClearResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.ClearResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_Clear_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Clear'
+type MockIUseCase_Clear_Call struct {
+	*mock.Call
+}
+
+// Clear is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIUseCase_Expecter) Clear(ctx interface{}) *MockIUseCase_Clear_Call {
+	return &MockIUseCase_Clear_Call{Call: _e.mock.On("Clear", ctx)}
+}
+
+func (_c *MockIUseCase_Clear_Call) Run(run func(ctx context.Context)) *MockIUseCase_Clear_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_Clear_Call) Return(_a0 *schemas.ClearResponse, _a1 error) *MockIUseCase_Clear_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_Clear_Call) RunAndReturn(run func(context.Context) (*schemas.ClearResponse, error)) *MockIUseCase_Clear_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBatches provides a mock function with given fields: ctx
+func (_m *MockIUseCase) GetBatches(ctx context.Context) ([]schemas.UploadBatch, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBatches")
+	}
+
+	var r0 []schemas.UploadBatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]schemas.UploadBatch, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []schemas.UploadBatch); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schemas.UploadBatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_GetBatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBatches'
+type MockIUseCase_GetBatches_Call struct {
+	*mock.Call
+}
+
+// GetBatches is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIUseCase_Expecter) GetBatches(ctx interface{}) *MockIUseCase_GetBatches_Call {
+	return &MockIUseCase_GetBatches_Call{Call: _e.mock.On("GetBatches", ctx)}
+}
+
+func (_c *MockIUseCase_GetBatches_Call) Run(run func(ctx context.Context)) *MockIUseCase_GetBatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_GetBatches_Call) Return(_a0 []schemas.UploadBatch, _a1 error) *MockIUseCase_GetBatches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_GetBatches_Call) RunAndReturn(run func(context.Context) ([]schemas.UploadBatch, error)) *MockIUseCase_GetBatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetClearHistory provides a mock function with given fields: ctx
+func (_m *MockIUseCase) GetClearHistory(ctx context.Context) ([]schemas.ClearOperation, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClearHistory")
+	}
+
+	var r0 []schemas.ClearOperation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]schemas.ClearOperation, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []schemas.ClearOperation); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schemas.ClearOperation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_GetClearHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClearHistory'
+type MockIUseCase_GetClearHistory_Call struct {
+	*mock.Call
+}
+
+// GetClearHistory is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIUseCase_Expecter) GetClearHistory(ctx interface{}) *MockIUseCase_GetClearHistory_Call {
+	return &MockIUseCase_GetClearHistory_Call{Call: _e.mock.On("GetClearHistory", ctx)}
+}
+
+func (_c *MockIUseCase_GetClearHistory_Call) Run(run func(ctx context.Context)) *MockIUseCase_GetClearHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_GetClearHistory_Call) Return(_a0 []schemas.ClearOperation, _a1 error) *MockIUseCase_GetClearHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_GetClearHistory_Call) RunAndReturn(run func(context.Context) ([]schemas.ClearOperation, error)) *MockIUseCase_GetClearHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ParseAndStore provides a mock function with given fields: ctx, file, opts
+func (_m *MockIUseCase) ParseAndStore(ctx context.Context, file io.Reader, opts schemas.UploadOptions) (*schemas.UploadResponse, error) {
+	ret := _m.Called(ctx, file, opts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ParseAndStore")
@@ -34,19 +210,19 @@ func (_m *MockIUseCase) ParseAndStore(ctx context.Context, file io.Reader) (*sch
 
 	var r0 *schemas.UploadResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, io.Reader) (*schemas.UploadResponse, error)); ok {
-		return rf(ctx, file)
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader, schemas.UploadOptions) (*schemas.UploadResponse, error)); ok {
+		return rf(ctx, file, opts)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, io.Reader) *schemas.UploadResponse); ok {
-		r0 = rf(ctx, file)
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader, schemas.UploadOptions) *schemas.UploadResponse); ok {
+		r0 = rf(ctx, file, opts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*schemas.UploadResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, io.Reader) error); ok {
-		r1 = rf(ctx, file)
+	if rf, ok := ret.Get(1).(func(context.Context, io.Reader, schemas.UploadOptions) error); ok {
+		r1 = rf(ctx, file, opts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -60,13 +236,16 @@ type MockIUseCase_ParseAndStore_Call struct {
 }
 
 // ParseAndStore is a helper method to define mock.On call
-func (_e *MockIUseCase_Expecter) ParseAndStore(ctx interface{}, file interface{}) *MockIUseCase_ParseAndStore_Call {
-	return &MockIUseCase_ParseAndStore_Call{Call: _e.mock.On("ParseAndStore", ctx, file)}
+//   - ctx context.Context
+//   - file io.Reader
+//   - opts schemas.UploadOptions
+func (_e *MockIUseCase_Expecter) ParseAndStore(ctx interface{}, file interface{}, opts interface{}) *MockIUseCase_ParseAndStore_Call {
+	return &MockIUseCase_ParseAndStore_Call{Call: _e.mock.On("ParseAndStore", ctx, file, opts)}
 }
 
-func (_c *MockIUseCase_ParseAndStore_Call) Run(run func(ctx context.Context, file io.Reader)) *MockIUseCase_ParseAndStore_Call {
+func (_c *MockIUseCase_ParseAndStore_Call) Run(run func(ctx context.Context, file io.Reader, opts schemas.UploadOptions)) *MockIUseCase_ParseAndStore_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(io.Reader))
+		run(args[0].(context.Context), args[1].(io.Reader), args[2].(schemas.UploadOptions))
 	})
 	return _c
 }
@@ -76,14 +255,75 @@ func (_c *MockIUseCase_ParseAndStore_Call) Return(_a0 *schemas.UploadResponse, _
 	return _c
 }
 
-func (_c *MockIUseCase_ParseAndStore_Call) RunAndReturn(run func(context.Context, io.Reader) (*schemas.UploadResponse, error)) *MockIUseCase_ParseAndStore_Call {
+func (_c *MockIUseCase_ParseAndStore_Call) RunAndReturn(run func(context.Context, io.Reader, schemas.UploadOptions) (*schemas.UploadResponse, error)) *MockIUseCase_ParseAndStore_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ParseAndStoreWithValidation provides a mock function with given fields: ctx, file
-func (_m *MockIUseCase) ParseAndStoreWithValidation(ctx context.Context, file io.Reader) (*schemas.UploadResponse, error) {
-	ret := _m.Called(ctx, file)
+// ParseAndStoreArchive provides a mock function with given fields: ctx, files, fieldValidator, opts
+func (_m *MockIUseCase) ParseAndStoreArchive(ctx context.Context, files []schemas.UploadFile, fieldValidator *validator.FieldValidator, opts schemas.UploadOptions) (*schemas.ArchiveUploadResponse, error) {
+	ret := _m.Called(ctx, files, fieldValidator, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseAndStoreArchive")
+	}
+
+	var r0 *schemas.ArchiveUploadResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []schemas.UploadFile, *validator.FieldValidator, schemas.UploadOptions) (*schemas.ArchiveUploadResponse, error)); ok {
+		return rf(ctx, files, fieldValidator, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []schemas.UploadFile, *validator.FieldValidator, schemas.UploadOptions) *schemas.ArchiveUploadResponse); ok {
+		r0 = rf(ctx, files, fieldValidator, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.ArchiveUploadResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []schemas.UploadFile, *validator.FieldValidator, schemas.UploadOptions) error); ok {
+		r1 = rf(ctx, files, fieldValidator, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_ParseAndStoreArchive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParseAndStoreArchive'
+type MockIUseCase_ParseAndStoreArchive_Call struct {
+	*mock.Call
+}
+
+// ParseAndStoreArchive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - files []schemas.UploadFile
+//   - fieldValidator *validator.FieldValidator
+//   - opts schemas.UploadOptions
+func (_e *MockIUseCase_Expecter) ParseAndStoreArchive(ctx interface{}, files interface{}, fieldValidator interface{}, opts interface{}) *MockIUseCase_ParseAndStoreArchive_Call {
+	return &MockIUseCase_ParseAndStoreArchive_Call{Call: _e.mock.On("ParseAndStoreArchive", ctx, files, fieldValidator, opts)}
+}
+
+func (_c *MockIUseCase_ParseAndStoreArchive_Call) Run(run func(ctx context.Context, files []schemas.UploadFile, fieldValidator *validator.FieldValidator, opts schemas.UploadOptions)) *MockIUseCase_ParseAndStoreArchive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]schemas.UploadFile), args[2].(*validator.FieldValidator), args[3].(schemas.UploadOptions))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_ParseAndStoreArchive_Call) Return(_a0 *schemas.ArchiveUploadResponse, _a1 error) *MockIUseCase_ParseAndStoreArchive_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_ParseAndStoreArchive_Call) RunAndReturn(run func(context.Context, []schemas.UploadFile, *validator.FieldValidator, schemas.UploadOptions) (*schemas.ArchiveUploadResponse, error)) *MockIUseCase_ParseAndStoreArchive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ParseAndStoreWithValidation provides a mock function with given fields: ctx, file, fieldValidator, opts
+func (_m *MockIUseCase) ParseAndStoreWithValidation(ctx context.Context, file io.Reader, fieldValidator *validator.FieldValidator, opts schemas.UploadOptions) (*schemas.UploadResponse, error) {
+	ret := _m.Called(ctx, file, fieldValidator, opts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ParseAndStoreWithValidation")
@@ -91,19 +331,19 @@ func (_m *MockIUseCase) ParseAndStoreWithValidation(ctx context.Context, file io
 
 	var r0 *schemas.UploadResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, io.Reader) (*schemas.UploadResponse, error)); ok {
-		return rf(ctx, file)
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader, *validator.FieldValidator, schemas.UploadOptions) (*schemas.UploadResponse, error)); ok {
+		return rf(ctx, file, fieldValidator, opts)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, io.Reader) *schemas.UploadResponse); ok {
-		r0 = rf(ctx, file)
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader, *validator.FieldValidator, schemas.UploadOptions) *schemas.UploadResponse); ok {
+		r0 = rf(ctx, file, fieldValidator, opts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*schemas.UploadResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, io.Reader) error); ok {
-		r1 = rf(ctx, file)
+	if rf, ok := ret.Get(1).(func(context.Context, io.Reader, *validator.FieldValidator, schemas.UploadOptions) error); ok {
+		r1 = rf(ctx, file, fieldValidator, opts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -117,13 +357,17 @@ type MockIUseCase_ParseAndStoreWithValidation_Call struct {
 }
 
 // ParseAndStoreWithValidation is a helper method to define mock.On call
-func (_e *MockIUseCase_Expecter) ParseAndStoreWithValidation(ctx interface{}, file interface{}) *MockIUseCase_ParseAndStoreWithValidation_Call {
-	return &MockIUseCase_ParseAndStoreWithValidation_Call{Call: _e.mock.On("ParseAndStoreWithValidation", ctx, file)}
+//   - ctx context.Context
+//   - file io.Reader
+//   - fieldValidator *validator.FieldValidator
+//   - opts schemas.UploadOptions
+func (_e *MockIUseCase_Expecter) ParseAndStoreWithValidation(ctx interface{}, file interface{}, fieldValidator interface{}, opts interface{}) *MockIUseCase_ParseAndStoreWithValidation_Call {
+	return &MockIUseCase_ParseAndStoreWithValidation_Call{Call: _e.mock.On("ParseAndStoreWithValidation", ctx, file, fieldValidator, opts)}
 }
 
-func (_c *MockIUseCase_ParseAndStoreWithValidation_Call) Run(run func(ctx context.Context, file io.Reader)) *MockIUseCase_ParseAndStoreWithValidation_Call {
+func (_c *MockIUseCase_ParseAndStoreWithValidation_Call) Run(run func(ctx context.Context, file io.Reader, fieldValidator *validator.FieldValidator, opts schemas.UploadOptions)) *MockIUseCase_ParseAndStoreWithValidation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(io.Reader))
+		run(args[0].(context.Context), args[1].(io.Reader), args[2].(*validator.FieldValidator), args[3].(schemas.UploadOptions))
 	})
 	return _c
 }
@@ -133,12 +377,131 @@ func (_c *MockIUseCase_ParseAndStoreWithValidation_Call) Return(_a0 *schemas.Upl
 	return _c
 }
 
-func (_c *MockIUseCase_ParseAndStoreWithValidation_Call) RunAndReturn(run func(context.Context, io.Reader) (*schemas.UploadResponse, error)) *MockIUseCase_ParseAndStoreWithValidation_Call {
+func (_c *MockIUseCase_ParseAndStoreWithValidation_Call) RunAndReturn(run func(context.Context, io.Reader, *validator.FieldValidator, schemas.UploadOptions) (*schemas.UploadResponse, error)) *MockIUseCase_ParseAndStoreWithValidation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function with given fields: ctx, confirm
+func (_m *MockIUseCase) Purge(ctx context.Context, confirm string) (*schemas.PurgeResponse, error) {
+	ret := _m.Called(ctx, confirm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 *schemas.PurgeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*schemas.PurgeResponse, error)); ok {
+		return rf(ctx, confirm)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *schemas.PurgeResponse); ok {
+		r0 = rf(ctx, confirm)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.PurgeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, confirm)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type MockIUseCase_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - confirm string
+func (_e *MockIUseCase_Expecter) Purge(ctx interface{}, confirm interface{}) *MockIUseCase_Purge_Call {
+	return &MockIUseCase_Purge_Call{Call: _e.mock.On("Purge", ctx, confirm)}
+}
+
+func (_c *MockIUseCase_Purge_Call) Run(run func(ctx context.Context, confirm string)) *MockIUseCase_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_Purge_Call) Return(_a0 *schemas.PurgeResponse, _a1 error) *MockIUseCase_Purge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_Purge_Call) RunAndReturn(run func(context.Context, string) (*schemas.PurgeResponse, error)) *MockIUseCase_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, clearID
+func (_m *MockIUseCase) Restore(ctx context.Context, clearID string) (*schemas.RestoreResponse, error) {
+	ret := _m.Called(ctx, clearID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 *schemas.RestoreResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*schemas.RestoreResponse, error)); ok {
+		return rf(ctx, clearID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *schemas.RestoreResponse); ok {
+		r0 = rf(ctx, clearID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schemas.RestoreResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, clearID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUseCase_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockIUseCase_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clearID string
+func (_e *MockIUseCase_Expecter) Restore(ctx interface{}, clearID interface{}) *MockIUseCase_Restore_Call {
+	return &MockIUseCase_Restore_Call{Call: _e.mock.On("Restore", ctx, clearID)}
+}
+
+func (_c *MockIUseCase_Restore_Call) Run(run func(ctx context.Context, clearID string)) *MockIUseCase_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUseCase_Restore_Call) Return(_a0 *schemas.RestoreResponse, _a1 error) *MockIUseCase_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUseCase_Restore_Call) RunAndReturn(run func(context.Context, string) (*schemas.RestoreResponse, error)) *MockIUseCase_Restore_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // NewMockIUseCase creates a new instance of MockIUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
 func NewMockIUseCase(t interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,163 @@
+// Package archive opens gzip and zip uploads while bounding how far they may decompress
+package archive
+
+import (
+	"archive/zip"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"path"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrInvalidArchive = errors.New("invalid archive")
+	ErrTooManyFiles   = errors.New("archive holds too many files")
+	ErrTooLarge       = errors.New("archive decompresses beyond the allowed size")
+)
+
+// Limits bounds what an archive may expand to, protecting against zip bombs
+// A zero limit is not enforced
+type Limits struct {
+	MaxFiles     int   // Files in a zip archive
+	MaxFileSize  int64 // Decompressed bytes of one file
+	MaxTotalSize int64 // Decompressed bytes of all files of an archive together
+}
+
+// File is one file of an archive
+type File struct {
+	Name string
+	open func() (io.ReadCloser, error)
+}
+
+// Open returns the decompressed content of the file
+// Reads fail with ErrTooLarge once the file or the archive passes its limit
+func (f File) Open() (io.ReadCloser, error) {
+	return f.open()
+}
+
+// Gunzip returns the decompressed content of a gzip stream and the original file name stored in its header, if any
+// Reads fail with ErrTooLarge once the content passes MaxFileSize or MaxTotalSize
+func Gunzip(r io.Reader, limits Limits) (io.ReadCloser, string, error) {
+	reader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+
+	total := limit(limits.MaxTotalSize)
+	return &limitedReader{
+		reader:    reader,
+		closer:    reader,
+		remaining: limit(limits.MaxFileSize),
+		total:     &total,
+	}, reader.Name, nil
+}
+
+// Zip lists the files of a zip archive in name order, leaving out directories and hidden or macOS metadata files
+// Archives with more than MaxFiles files, or whose declared sizes pass the limits, are rejected before anything is decompressed;
+// the limits are enforced again while reading, since declared sizes can lie
+func Zip(r io.ReaderAt, size int64, limits Limits) ([]File, error) {
+	reader, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+
+	var entries []*zip.File
+	var declared uint64
+	for _, entry := range reader.File {
+		if entry.FileInfo().IsDir() || hidden(entry.Name) {
+			continue
+		}
+		entries = append(entries, entry)
+		if limits.MaxFiles > 0 && len(entries) > limits.MaxFiles {
+			return nil, fmt.Errorf("%w (max %d)", ErrTooManyFiles, limits.MaxFiles)
+		}
+
+		if limits.MaxFileSize > 0 && entry.UncompressedSize64 > uint64(limits.MaxFileSize) {
+			return nil, fmt.Errorf("%w: %s", ErrTooLarge, entry.Name)
+		}
+		declared += entry.UncompressedSize64
+		if limits.MaxTotalSize > 0 && declared > uint64(limits.MaxTotalSize) {
+			return nil, ErrTooLarge
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+
+	// Every file draws on the same total budget
+	total := limit(limits.MaxTotalSize)
+
+	files := make([]File, len(entries))
+	for i, entry := range entries {
+		entry := entry
+		files[i] = File{
+			Name: entry.Name,
+			open: func() (io.ReadCloser, error) {
+				reader, err := entry.Open()
+				if err != nil {
+					return nil, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+				}
+				return &limitedReader{
+					reader:    reader,
+					closer:    reader,
+					remaining: limit(limits.MaxFileSize),
+					total:     &total,
+				}, nil
+			},
+		}
+	}
+	return files, nil
+}
+
+// hidden reports whether a zip entry is a hidden file or macOS resource fork rather than an uploaded file
+func hidden(name string) bool {
+	if strings.HasPrefix(name, "__MACOSX/") {
+		return true
+	}
+	return strings.HasPrefix(path.Base(name), ".")
+}
+
+// limit returns a size limit in bytes, with zero meaning unlimited
+func limit(size int64) int64 {
+	if size <= 0 {
+		return math.MaxInt64
+	}
+	return size
+}
+
+// limitedReader fails with ErrTooLarge instead of returning more than the file or the shared total allows
+type limitedReader struct {
+	reader    io.Reader
+	closer    io.Closer
+	remaining int64
+	total     *int64
+}
+
+// Read reads up to one byte past the limit, so content that ends exactly at the limit is still accepted
+func (l *limitedReader) Read(p []byte) (int, error) {
+	allowed := l.remaining
+	if *l.total < allowed {
+		allowed = *l.total
+	}
+	if allowed < 0 {
+		return 0, ErrTooLarge
+	}
+	if int64(len(p)) > allowed {
+		p = p[:allowed+1]
+	}
+
+	n, err := l.reader.Read(p)
+	l.remaining -= int64(n)
+	*l.total -= int64(n)
+	if l.remaining < 0 || *l.total < 0 {
+		return n, ErrTooLarge
+	}
+	return n, err
+}
+
+// Close closes the decompressor
+func (l *limitedReader) Close() error {
+	return l.closer.Close()
+}
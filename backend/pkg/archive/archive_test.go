@@ -0,0 +1,137 @@
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+// zipArchive builds a zip archive holding the given files
+func zipArchive(t *testing.T, files map[string]string) *bytes.Reader {
+	t.Helper()
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := writer.Create(name)
+		if err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+		if _, err := io.WriteString(w, content); err != nil {
+			t.Fatalf("Write failed: %v", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	return bytes.NewReader(buf.Bytes())
+}
+
+// readFile reads one archive file to the end
+func readFile(file File) (string, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	return string(content), err
+}
+
+// TestZip tests listing order, skipped entries and reading
+func TestZip(t *testing.T) {
+	archive := zipArchive(t, map[string]string{
+		"feb.csv":            "b",
+		"jan.csv":            "a",
+		"nested/":            "",
+		".DS_Store":          "x",
+		"__MACOSX/._jan.csv": "x",
+	})
+
+	files, err := Zip(archive, archive.Size(), Limits{MaxFiles: 2, MaxFileSize: 10, MaxTotalSize: 10})
+	if err != nil {
+		t.Fatalf("Zip failed: %v", err)
+	}
+	if len(files) != 2 || files[0].Name != "feb.csv" || files[1].Name != "jan.csv" {
+		t.Fatalf("Expected feb.csv and jan.csv, got %+v", files)
+	}
+
+	content, err := readFile(files[1])
+	if err != nil || content != "a" {
+		t.Errorf("Expected content a, got %q (%v)", content, err)
+	}
+}
+
+// TestZipLimits tests the file count and size limits
+func TestZipLimits(t *testing.T) {
+	archive := zipArchive(t, map[string]string{
+		"a.csv": strings.Repeat("a", 100),
+		"b.csv": strings.Repeat("b", 100),
+	})
+
+	tests := []struct {
+		name   string
+		limits Limits
+		err    error
+	}{
+		{"too many files", Limits{MaxFiles: 1}, ErrTooManyFiles},
+		{"file too large", Limits{MaxFileSize: 99}, ErrTooLarge},
+		{"archive too large", Limits{MaxTotalSize: 150}, ErrTooLarge},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := Zip(archive, archive.Size(), tc.limits); !errors.Is(err, tc.err) {
+				t.Errorf("Expected %v, got %v", tc.err, err)
+			}
+		})
+	}
+
+	if _, err := Zip(strings.NewReader("not a zip"), 9, Limits{}); !errors.Is(err, ErrInvalidArchive) {
+		t.Errorf("Expected ErrInvalidArchive, got %v", err)
+	}
+}
+
+// TestLimitedReader tests that reading stops at the limit even when the declared sizes are wrong
+func TestLimitedReader(t *testing.T) {
+	total := int64(150)
+	first := &limitedReader{reader: strings.NewReader(strings.Repeat("a", 100)), closer: io.NopCloser(nil), remaining: 100, total: &total}
+	if content, err := io.ReadAll(first); err != nil || len(content) != 100 {
+		t.Fatalf("Expected content at the file limit to be read, got %d bytes (%v)", len(content), err)
+	}
+
+	second := &limitedReader{reader: strings.NewReader(strings.Repeat("b", 100)), closer: io.NopCloser(nil), remaining: 100, total: &total}
+	if _, err := io.ReadAll(second); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("Expected the shared total to be enforced, got %v", err)
+	}
+}
+
+// TestGunzip tests decompression, the stored name and the size limit
+func TestGunzip(t *testing.T) {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	writer.Name = "january.csv"
+	writer.Write([]byte(strings.Repeat("a", 1000)))
+	writer.Close()
+
+	reader, name, err := Gunzip(bytes.NewReader(buf.Bytes()), Limits{MaxFileSize: 1000})
+	if err != nil {
+		t.Fatalf("Gunzip failed: %v", err)
+	}
+	content, err := io.ReadAll(reader)
+	if err != nil || len(content) != 1000 || name != "january.csv" {
+		t.Errorf("Expected 1000 bytes of january.csv, got %d bytes of %q (%v)", len(content), name, err)
+	}
+
+	reader, _, _ = Gunzip(bytes.NewReader(buf.Bytes()), Limits{MaxTotalSize: 999})
+	if _, err := io.ReadAll(reader); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("Expected ErrTooLarge, got %v", err)
+	}
+
+	if _, _, err := Gunzip(strings.NewReader("plain text"), Limits{}); !errors.Is(err, ErrInvalidArchive) {
+		t.Errorf("Expected ErrInvalidArchive, got %v", err)
+	}
+}
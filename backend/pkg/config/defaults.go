@@ -40,6 +40,63 @@ func loadDefaults() {
 
 	// CORS config
 	viper.SetDefault("CORS_ALLOW_ORIGINS", "*")           // Production can set specific origins
+
+	// SLA config (0 disables a per-type override)
+	viper.SetDefault("SLA_DEFAULT_HOURS", 72)
+	viper.SetDefault("SLA_CREDIT_HOURS", 0)
+	viper.SetDefault("SLA_DEBIT_HOURS", 0)
+	viper.SetDefault("SLA_AMOUNT_BANDS", "")              // e.g. "100000000:24,1000000000:4" (min amount in cents:hours)
+
+	// Clear config
+	viper.SetDefault("CLEAR_RETENTION_HOURS", 168)        // Cleared data can be restored for 7 days
+
+	// Upload archive config
+	viper.SetDefault("UPLOAD_ARCHIVE_MAX_FILES", 100)     // Files in one zip upload
+	viper.SetDefault("UPLOAD_ARCHIVE_MAX_FILE_BYTES", 52428800) // 50MB decompressed per file
+	viper.SetDefault("UPLOAD_ARCHIVE_MAX_BYTES", 209715200) // 200MB decompressed per archive
+
+	// Resumable upload config
+	viper.SetDefault("UPLOAD_STAGING_DIR", "uploads")     // Chunks of unfinished uploads, relative to the working directory
+	viper.SetDefault("UPLOAD_SESSION_TTL_HOURS", 24)      // Sessions without a chunk for this long are removed
+	viper.SetDefault("INBOX_DIR", "")                     // Directory watched for dropped files; empty disables the inbox
+	viper.SetDefault("INBOX_POLL_SECONDS", 10)            // A file is ingested once unchanged between two polls
+	viper.SetDefault("INBOX_SOURCE", "")                  // Source label of ingested files, e.g. bank
+	viper.SetDefault("INBOX_PROFILE", "")                 // Import profile applied to ingested files
+
+	// Timestamp config
+	viper.SetDefault("TIMESTAMP_UNIT", "auto")            // Unit of integer timestamps: s, ms or auto (by magnitude)
+	viper.SetDefault("TIMESTAMP_TIMEZONE", "UTC")         // Timezone of dates without an offset, e.g. Asia/Jakarta
+	viper.SetDefault("TIMESTAMP_MIN_YEAR", 2000)          // Older timestamps are rejected
+	viper.SetDefault("TIMESTAMP_MAX_FUTURE_HOURS", 24)    // Timestamps further ahead of now are rejected
+
+	// Counterparty config
+	viper.SetDefault("COUNTERPARTY_MATCH_THRESHOLD", 0.8) // Per-word similarity for fuzzy alias matching; 1 disables typo matching
+
+	// Reconciliation config
+	viper.SetDefault("RECONCILE_TIME_TOLERANCE_SECONDS", 86400) // Ledger and bank rows up to a day apart can match
+
+	// Anomaly config
+	viper.SetDefault("ANOMALY_OUTLIER_METHOD", "zscore")  // "zscore" or "iqr"
+	viper.SetDefault("ANOMALY_ZSCORE_THRESHOLD", 3.0)     // Standard deviations from the counterparty mean
+	viper.SetDefault("ANOMALY_IQR_MULTIPLIER", 1.5)       // Interquartile ranges outside the counterparty quartiles
+	viper.SetDefault("ANOMALY_MIN_HISTORY", 5)            // Rows needed before history or a batch is judged
+	viper.SetDefault("ANOMALY_FAILED_RATE_DELTA", 0.25)   // Batch FAILED rate above the historical rate that counts as a spike
+	viper.SetDefault("ANOMALY_LARGE_DEBIT_AMOUNT", 1000000000) // Debits at or above 10,000,000.00 (cents) are flagged; 0 disables
+	viper.SetDefault("ANOMALY_ODD_HOURS", "0-5")          // Local hours start-end (end exclusive); empty disables
+	viper.SetDefault("ANOMALY_TIMEZONE", "UTC")           // Timezone for odd hours, e.g. Asia/Jakarta
+
+	// Recurring config
+	viper.SetDefault("RECURRING_AMOUNT_TOLERANCE", 0.1)   // Amounts of one series may differ by up to 10%
+	viper.SetDefault("RECURRING_MIN_OCCURRENCES", 3)      // Payments needed before a series is reported
+	viper.SetDefault("RECURRING_GRACE_DAYS", 3)           // Days a payment may be late before it counts as late or missed
+	viper.SetDefault("RECURRING_LOOKBACK_DAYS", 400)      // Days of history read for series; 0 reads everything
+
+	// Forecast config
+	viper.SetDefault("FORECAST_MIN_HISTORY", 5)           // Fewer resolved rows fall back to the success rate of the type
+
+	// Link config
+	viper.SetDefault("LINK_WINDOW_DAYS", 90)              // Days after a debit a credit is still suggested as its refund
+	viper.SetDefault("LINK_REVERSAL_HOURS", 24)           // Full amounts paid back within this many hours are suggested as reversals
 }
 
 
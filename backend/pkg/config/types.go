@@ -22,6 +22,65 @@ type (
 
 		// CORS config
 		CorsAllowOrigins string `mapstructure:"CORS_ALLOW_ORIGINS"`
+
+		// SLA config (hours a PENDING transaction may stay open)
+		SLADefaultHours int    `mapstructure:"SLA_DEFAULT_HOURS"`
+		SLACreditHours  int    `mapstructure:"SLA_CREDIT_HOURS"`
+		SLADebitHours   int    `mapstructure:"SLA_DEBIT_HOURS"`
+		SLAAmountBands  string `mapstructure:"SLA_AMOUNT_BANDS"`
+
+		// Clear config
+		ClearRetentionHours int `mapstructure:"CLEAR_RETENTION_HOURS"`
+
+		// Upload archive config (zip bomb protection for zip and gzip uploads)
+		UploadArchiveMaxFiles     int   `mapstructure:"UPLOAD_ARCHIVE_MAX_FILES"`
+		UploadArchiveMaxFileBytes int64 `mapstructure:"UPLOAD_ARCHIVE_MAX_FILE_BYTES"`
+		UploadArchiveMaxBytes     int64 `mapstructure:"UPLOAD_ARCHIVE_MAX_BYTES"`
+
+		// Resumable upload config (where chunks are staged and how long an idle session is kept)
+		UploadStagingDir      string `mapstructure:"UPLOAD_STAGING_DIR"`
+		UploadSessionTTLHours int    `mapstructure:"UPLOAD_SESSION_TTL_HOURS"`
+
+		// Inbox config (a directory whose files are ingested like uploads; disabled when InboxDir is empty)
+		InboxDir         string `mapstructure:"INBOX_DIR"`
+		InboxPollSeconds int    `mapstructure:"INBOX_POLL_SECONDS"`
+		InboxSource      string `mapstructure:"INBOX_SOURCE"`
+		InboxProfile     string `mapstructure:"INBOX_PROFILE"`
+
+		// Timestamp config (how uploaded and entered timestamps are read, and which are plausible)
+		TimestampUnit           string `mapstructure:"TIMESTAMP_UNIT"`
+		TimestampTimezone       string `mapstructure:"TIMESTAMP_TIMEZONE"`
+		TimestampMinYear        int    `mapstructure:"TIMESTAMP_MIN_YEAR"`
+		TimestampMaxFutureHours int    `mapstructure:"TIMESTAMP_MAX_FUTURE_HOURS"`
+
+		// Counterparty config (0-1, how similar a misspelt name must be to an alias)
+		CounterpartyMatchThreshold float64 `mapstructure:"COUNTERPARTY_MATCH_THRESHOLD"`
+
+		// Reconciliation config (how far apart matching rows may be, in seconds)
+		ReconcileTimeToleranceSeconds int64 `mapstructure:"RECONCILE_TIME_TOLERANCE_SECONDS"`
+
+		// Anomaly config (thresholds for flagging suspicious uploaded rows)
+		AnomalyOutlierMethod    string  `mapstructure:"ANOMALY_OUTLIER_METHOD"`
+		AnomalyZScoreThreshold  float64 `mapstructure:"ANOMALY_ZSCORE_THRESHOLD"`
+		AnomalyIQRMultiplier    float64 `mapstructure:"ANOMALY_IQR_MULTIPLIER"`
+		AnomalyMinHistory       int     `mapstructure:"ANOMALY_MIN_HISTORY"`
+		AnomalyFailedRateDelta  float64 `mapstructure:"ANOMALY_FAILED_RATE_DELTA"`
+		AnomalyLargeDebitAmount int64   `mapstructure:"ANOMALY_LARGE_DEBIT_AMOUNT"`
+		AnomalyOddHours         string  `mapstructure:"ANOMALY_ODD_HOURS"`
+		AnomalyTimezone         string  `mapstructure:"ANOMALY_TIMEZONE"`
+
+		// Recurring config (how series of repeating payments are recognised)
+		RecurringAmountTolerance float64 `mapstructure:"RECURRING_AMOUNT_TOLERANCE"`
+		RecurringMinOccurrences  int     `mapstructure:"RECURRING_MIN_OCCURRENCES"`
+		RecurringGraceDays       int     `mapstructure:"RECURRING_GRACE_DAYS"`
+		RecurringLookbackDays    int     `mapstructure:"RECURRING_LOOKBACK_DAYS"`
+
+		// Forecast config (resolved transactions a counterparty needs for its own success rate)
+		ForecastMinHistory int `mapstructure:"FORECAST_MIN_HISTORY"`
+
+		// Link config (how far apart a refund and its original transaction may be)
+		LinkWindowDays    int `mapstructure:"LINK_WINDOW_DAYS"`
+		LinkReversalHours int `mapstructure:"LINK_REVERSAL_HOURS"`
 	}
 )
 
@@ -12,17 +12,36 @@ const (
 
 // Upload Messages
 const (
-	MsgUploadSuccess           = "CSV uploaded and processed successfully"
-	MsgUploadFailed            = "Failed to process CSV"
+	MsgUploadSuccess           = "File uploaded and processed successfully"
+	MsgUploadFailed            = "Failed to process file"
 	MsgNoFileProvided          = "No file provided"
 	MsgInvalidFilename         = "Invalid filename"
 	MsgInvalidFileType         = "Invalid file type"
 	MsgInvalidFile             = "Invalid file"
 	MsgFailedToOpenFile        = "Failed to open file"
 	MsgFailedToReadFile        = "Failed to read file"
-	MsgNoValidTransactions     = "No valid transactions found in CSV"
+	MsgNoValidTransactions     = "No valid transactions found in file"
+	MsgNoNewTransactions       = "Every transaction in the file was already uploaded"
+	MsgBalanceMismatch         = "File uploaded, but a statement closing balance does not match its opening balance and entries"
+	MsgArchiveUploadSuccess    = "Archive uploaded and every file processed"
+	MsgArchivePartialFailure   = "Archive uploaded, but some files could not be processed"
+	MsgArchiveUploadFailed     = "No file in the archive could be processed"
+	MsgInvalidArchive          = "Invalid archive"
+	MsgEmptyArchive            = "archive holds no files"
+	MsgNestedArchive           = "archives inside archives are not supported"
 	MsgAllTransactionsDeleted  = "All transactions deleted"
 	MsgFailedToClearTransactions = "Failed to clear transactions"
+	MsgTransactionsRestored    = "Cleared transactions restored"
+	MsgFailedToRestore         = "Failed to restore transactions"
+	MsgNothingToRestore        = "Nothing to restore"
+	MsgRestoreWindowExpired    = "Restore window expired"
+	MsgTransactionsPurged      = "Deleted transactions purged permanently"
+	MsgFailedToPurge           = "Failed to purge transactions"
+	MsgPurgeNotConfirmed       = "Purge not confirmed"
+	MsgClearHistoryRetrieved   = "Clear history retrieved successfully"
+	MsgFailedToRetrieveClears  = "Failed to retrieve clear history"
+	MsgFailedToRetrieveBatches = "Failed to retrieve upload batches"
+	MsgInvalidRequestBody      = "Invalid request body"
 )
 
 // Transaction Messages
@@ -31,8 +50,167 @@ const (
 	MsgFailedToCalculateBalance = "Failed to calculate balance"
 	MsgIssuesRetrieved          = "Issues retrieved successfully"
 	MsgFailedToRetrieveIssues   = "Failed to retrieve issues"
+	MsgIssuesSummaryRetrieved   = "Issues summary retrieved successfully"
+	MsgFailedToSummarizeIssues  = "Failed to summarize issues"
 	MsgTransactionsRetrieved    = "Transactions retrieved successfully"
 	MsgFailedToRetrieveTransactions = "Failed to retrieve transactions"
+	MsgTransactionRetrieved     = "Transaction retrieved successfully"
+	MsgTransactionCreated       = "Transaction created successfully"
+	MsgTransactionUpdated       = "Transaction updated successfully"
+	MsgTransactionDeleted       = "Transaction deleted successfully"
+	MsgTransactionNotFound      = "Transaction not found"
+	MsgInvalidTransactionBody   = "Invalid transaction request"
+	MsgFailedToCreateTransaction = "Failed to create transaction"
+	MsgFailedToUpdateTransaction = "Failed to update transaction"
+	MsgFailedToDeleteTransaction = "Failed to delete transaction"
+	MsgFailedToRetrieveTransaction = "Failed to retrieve transaction"
+	MsgInvalidFlagReason        = "Invalid flag reason"
+	MsgInvalidExpand            = "Invalid expand parameter"
+	MsgInvalidExportFormat      = "Invalid export format"
+	MsgExportTooLarge           = "Too many transactions for an XLSX export; use format=csv or narrow the filters"
+	MsgFailedToExportTransactions = "Failed to export transactions"
+)
+
+// Split Messages
+const (
+	MsgSplitsRetrieved        = "Splits retrieved successfully"
+	MsgSplitsUpdated          = "Splits updated successfully"
+	MsgInvalidSplit           = "Invalid split request"
+	MsgFailedToRetrieveSplits = "Failed to retrieve splits"
+	MsgFailedToSaveSplits     = "Failed to save splits"
+)
+
+// Category Messages
+const (
+	MsgCategoriesRetrieved       = "Categories retrieved successfully"
+	MsgCategoryRetrieved         = "Category retrieved successfully"
+	MsgCategoryCreated           = "Category created successfully"
+	MsgCategoryUpdated           = "Category updated successfully"
+	MsgCategoryDeleted           = "Category deleted successfully"
+	MsgCategoryNotFound          = "Category not found"
+	MsgCategoryInUse             = "Category is used by child categories or rules"
+	MsgInvalidCategoryBody       = "Invalid category request"
+	MsgFailedToRetrieveCategories = "Failed to retrieve categories"
+	MsgFailedToSaveCategory      = "Failed to save category"
+	MsgFailedToDeleteCategory    = "Failed to delete category"
+	MsgCategoryAssigned          = "Category assigned successfully"
+	MsgTagsUpdated               = "Tags updated successfully"
+	MsgInvalidTag                = "Invalid tag"
+	MsgFailedToAssignCategory    = "Failed to assign category"
+	MsgFailedToUpdateTags        = "Failed to update tags"
+)
+
+// Rule Messages
+const (
+	MsgRulesRetrieved            = "Rules retrieved successfully"
+	MsgRuleCreated               = "Rule created successfully"
+	MsgRuleUpdated               = "Rule updated successfully"
+	MsgRuleDeleted               = "Rule deleted successfully"
+	MsgRuleApplied               = "Rule applied to existing transactions"
+	MsgRuleTested                = "Rule tested against existing transactions"
+	MsgRuleNotFound              = "Rule not found"
+	MsgInvalidRuleBody           = "Invalid rule request"
+	MsgFailedToRetrieveRules     = "Failed to retrieve rules"
+	MsgFailedToSaveRule          = "Failed to save rule"
+	MsgFailedToDeleteRule        = "Failed to delete rule"
+	MsgFailedToApplyRule         = "Failed to apply rule"
+	MsgFailedToTestRule          = "Failed to test rule"
+)
+
+// Import Profile Messages
+const (
+	MsgImportProfileDeleted           = "Import profile deleted successfully"
+	MsgImportProfileNotFound          = "Import profile not found"
+	MsgImportProfileExists            = "An import profile with this name already exists"
+	MsgInvalidImportProfileBody       = "Invalid import profile request"
+	MsgInvalidParseOptions            = "Invalid encoding, delimiter or decimal separator"
+	MsgFailedToRetrieveImportProfiles = "Failed to retrieve import profiles"
+	MsgFailedToSaveImportProfile      = "Failed to save import profile"
+	MsgFailedToDeleteImportProfile    = "Failed to delete import profile"
+)
+
+// Resumable Upload Messages
+const (
+	MsgUploadSessionDeleted          = "Upload session cancelled"
+	MsgUploadSessionNotFound         = "Upload session not found"
+	MsgUploadSessionExpired          = "Upload session expired"
+	MsgInvalidUploadSession          = "Invalid upload session request"
+	MsgInvalidUploadOffset           = "Upload-Offset header must be a non-negative integer"
+	MsgInvalidUploadChecksum         = "Upload-Checksum header must be \"sha256 <base64 digest>\""
+	MsgUploadOffsetMismatch          = "Upload offset does not match the bytes received"
+	MsgUploadChecksumMismatch        = "Upload checksum does not match"
+	MsgUploadSessionConsumed         = "Upload is complete or being stored; fetch the session for its result"
+	MsgFailedToCreateUploadSession   = "Failed to create upload session"
+	MsgFailedToRetrieveUploadSession = "Failed to retrieve upload session"
+	MsgFailedToWriteChunk            = "Failed to write upload chunk"
+	MsgFailedToDeleteUploadSession   = "Failed to cancel upload session"
+)
+
+// Counterparty Messages
+const (
+	MsgCounterpartiesRetrieved        = "Counterparties retrieved successfully"
+	MsgCounterpartiesLinked           = "Transactions linked to counterparties"
+	MsgCounterpartyNotFound           = "Counterparty not found"
+	MsgInvalidCounterpartyBody        = "Invalid counterparty request"
+	MsgFailedToRetrieveCounterparties = "Failed to retrieve counterparties"
+	MsgFailedToSaveCounterparty       = "Failed to save counterparty"
+	MsgFailedToLinkCounterparties     = "Failed to link counterparties"
+)
+
+// Reconciliation Messages
+const (
+	MsgReconciliationItemsRetrieved    = "Reconciliation items retrieved successfully"
+	MsgReconciliationNotFound          = "Reconciliation not found"
+	MsgBatchNotFound                   = "Upload batch not found"
+	MsgInvalidReconciliation           = "Invalid reconciliation request"
+	MsgFailedToReconcile               = "Failed to reconcile batches"
+	MsgFailedToRetrieveReconciliations = "Failed to retrieve reconciliations"
+	MsgFailedToExportReconciliation    = "Failed to export reconciliation"
+)
+
+// Report Messages
+const (
+	MsgCategoryReportRetrieved   = "Category report retrieved successfully"
+	MsgFailedToBuildReport       = "Failed to build report"
+	MsgInvalidReportStatus       = "Invalid report status"
+)
+
+// Recurring Messages
+const (
+	MsgRecurringRetrieved      = "Recurring transactions retrieved successfully"
+	MsgFailedToDetectRecurring = "Failed to detect recurring transactions"
+	MsgInvalidRecurringFilter  = "Invalid recurring filter"
+)
+
+// Forecast Messages
+const (
+	MsgForecastRetrieved   = "Forecast retrieved successfully"
+	MsgFailedToForecast    = "Failed to build forecast"
+	MsgInvalidForecastDays = "Invalid forecast days"
+)
+
+// Link Messages
+const (
+	MsgLinkSuggestionsRetrieved = "Link suggestions retrieved successfully"
+	MsgLinksSuggested           = "Link suggestions created"
+	MsgLinkSuggestionConfirmed  = "Link suggestion confirmed"
+	MsgLinkSuggestionRejected   = "Link suggestion rejected"
+	MsgLinkSuggestionNotFound   = "Link suggestion not found"
+	MsgLinkSuggestionResolved   = "Link suggestion already resolved"
+	MsgInvalidLink              = "Invalid link request"
+	MsgFailedToRetrieveLinks    = "Failed to retrieve link suggestions"
+	MsgFailedToSuggestLinks     = "Failed to suggest links"
+	MsgFailedToSaveLink         = "Failed to save link"
+)
+
+// Audit Messages
+const (
+	MsgAuditEventsRetrieved      = "Audit events retrieved successfully"
+	MsgFailedToRetrieveAudit     = "Failed to retrieve audit events"
+	MsgAuditChainValid           = "Audit chain is intact"
+	MsgAuditChainBroken          = "Audit chain is broken"
+	MsgFailedToVerifyAudit       = "Failed to verify audit chain"
+	MsgInvalidAuditFilter        = "Invalid audit filter"
 )
 
 // Validation Messages
@@ -64,6 +242,20 @@ const (
 	MsgCSVValidationErrorField = "Validation error at line %d (%s): %w"
 )
 
+// JSON Parsing Messages
+const (
+	MsgJSONReadError            = "Error reading JSON at index %d: %w"
+	MsgJSONInvalidFormat        = "Invalid JSON format: expected an array of transactions"
+	MsgJSONValidationErrorField = "Validation error at index %d (%s): %w"
+	MsgNDJSONReadError          = "Error reading NDJSON at line %d: %w"
+)
+
+// Statement Parsing Messages
+const (
+	MsgStatementInvalidFormat = "Invalid %s format: %s"
+	MsgStatementInvalidDate   = "Invalid date at line %d: %w"
+)
+
 // Field Validator Error Messages
 const (
 	ErrMsgFieldCountMismatch     = "expected 6 fields, got %d"
@@ -2,6 +2,7 @@ package db
 
 import (
 	"log"
+	"strings"
 
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -11,8 +12,20 @@ type Database struct {
 	DB *gorm.DB
 }
 
+// txOptions makes transactions take the SQLite write lock when they begin rather than on their first write, so
+// writers in other processes, such as flipctl next to the server, wait their turn instead of failing midway, and a
+// value read in a transaction cannot change before the transaction commits
+// The write-ahead log lets readers and the writer work at the same time, so a long read such as a streamed export
+// never holds off uploads and edits
+const txOptions = "_txlock=immediate&_busy_timeout=5000&_journal_mode=WAL"
+
 func New(dbPath string) *Database {
-	db, err := gorm.Open(sqlite.Open(dbPath), &gorm.Config{})
+	separator := "?"
+	if strings.Contains(dbPath, "?") {
+		separator = "&"
+	}
+
+	db, err := gorm.Open(sqlite.Open(dbPath+separator+txOptions), &gorm.Config{})
 	if err != nil {
 		log.Fatalf("Failed to initialize database: %v", err)
 	}
@@ -0,0 +1,41 @@
+package db
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+type row struct {
+	ID   int
+	Name string
+}
+
+// TestWriteDuringRead tests that a connection can write while another one holds a read cursor open, as during an export
+func TestWriteDuringRead(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.db")
+	reader, writer := New(path), New(path)
+	defer reader.Close()
+	defer writer.Close()
+
+	if err := writer.DB.AutoMigrate(&row{}); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+	for i := 1; i <= 3; i++ {
+		if err := writer.DB.Create(&row{ID: i, Name: "before"}).Error; err != nil {
+			t.Fatalf("failed to create row: %v", err)
+		}
+	}
+
+	rows, err := reader.DB.Model(&row{}).Rows()
+	if err != nil {
+		t.Fatalf("failed to open cursor: %v", err)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		t.Fatal("Expected a row")
+	}
+
+	if err := writer.DB.Create(&row{ID: 4, Name: "during"}).Error; err != nil {
+		t.Errorf("Expected the write to succeed while a cursor is open, got %v", err)
+	}
+}
@@ -0,0 +1,27 @@
+package db
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// txKey is the context key of the transaction a context carries
+type txKey struct{}
+
+// Transaction runs fn in a database transaction, committing it when fn returns nil and rolling it back otherwise
+// Repositories called with the context fn receives run their queries in the transaction; when ctx already carries
+// one, fn runs in a savepoint of it
+func Transaction(ctx context.Context, conn *gorm.DB, fn func(ctx context.Context) error) error {
+	return Conn(ctx, conn).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// Conn returns the transaction ctx carries, or conn outside a transaction, bound to ctx
+func Conn(ctx context.Context, conn *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return conn.WithContext(ctx)
+}
@@ -1,8 +1,13 @@
 package deps
 
 import (
+	"context"
+	"sync"
+
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/config"
 	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/db"
 	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/logger"
+	"github.com/fadlytanjung/flip-fullstack-test/backend/pkg/validator"
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -11,5 +16,34 @@ type App struct {
 	Logger *logger.Logger
 	DB     *db.Database
 	Fiber  *fiber.App
+	// FieldValidator validates entered and uploaded fields with the configured timestamp policy
+	FieldValidator *validator.FieldValidator
+	// Ctx is cancelled when the app shuts down, stopping the background workers started with Go
+	Ctx context.Context
+
+	workers sync.WaitGroup
+}
+
+// Go runs worker in the background with the app context and tracks it, so shutdown can wait for it with Wait
+func (a *App) Go(worker func(ctx context.Context)) {
+	a.workers.Add(1)
+	go func() {
+		defer a.workers.Done()
+		worker(a.Ctx)
+	}()
 }
 
+// Wait blocks until every worker started with Go has returned
+func (a *App) Wait() {
+	a.workers.Wait()
+}
+
+// NewFieldValidator builds the field validator every handler shares from config
+// It returns an error when the timestamp config is invalid, so the app refuses to start rather than guess
+func NewFieldValidator(cfg *config.GlobalConfig) (*validator.FieldValidator, error) {
+	policy, err := validator.NewTimestampPolicy(cfg.TimestampUnit, cfg.TimestampTimezone, cfg.TimestampMinYear, cfg.TimestampMaxFutureHours)
+	if err != nil {
+		return nil, err
+	}
+	return validator.NewFieldValidator(validator.WithTimestampPolicy(policy)), nil
+}
@@ -0,0 +1,109 @@
+package fuzzy
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Normalize uppercases a name, turns punctuation into spaces and collapses whitespace,
+// so "John  Doe." and "JOHN DOE" compare equal
+func Normalize(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	space := false
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteRune(unicode.ToUpper(r))
+			space = false
+			continue
+		}
+		space = true
+	}
+
+	return b.String()
+}
+
+// Levenshtein returns the edit distance between two strings, counted in runes
+func Levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 {
+		return len(rb)
+	}
+	if len(rb) == 0 {
+		return len(ra)
+	}
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
+
+// Similarity returns a score between 0 and 1, where 1 means the strings are equal
+func Similarity(a, b string) float64 {
+	la, lb := len([]rune(a)), len([]rune(b))
+	longest := max(la, lb)
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(Levenshtein(a, b))/float64(longest)
+}
+
+// minFuzzyTokenLength is the shortest word that may differ by a typo
+// Shorter words, and words with digits, must match exactly: "COMPANY A" is not "COMPANY B"
+const minFuzzyTokenLength = 4
+
+// Match reports whether two normalized names are the same up to typos
+// The names must have the same words in the same order, each word at least
+// threshold similar; spacing differences ("E COMMERCE" and "ECOMMERCE") also match
+func Match(a, b string, threshold float64) bool {
+	if a == b || strings.ReplaceAll(a, " ", "") == strings.ReplaceAll(b, " ", "") {
+		return true
+	}
+
+	wordsA, wordsB := strings.Fields(a), strings.Fields(b)
+	if len(wordsA) != len(wordsB) {
+		return false
+	}
+
+	for i := range wordsA {
+		if wordsA[i] == wordsB[i] {
+			continue
+		}
+		if !fuzzyWord(wordsA[i]) || !fuzzyWord(wordsB[i]) {
+			return false
+		}
+		if Similarity(wordsA[i], wordsB[i]) < threshold {
+			return false
+		}
+	}
+
+	return true
+}
+
+// fuzzyWord reports whether a word is long enough and free of digits, so a typo in it can be tolerated
+func fuzzyWord(word string) bool {
+	if len([]rune(word)) < minFuzzyTokenLength {
+		return false
+	}
+	return !strings.ContainsFunc(word, unicode.IsDigit)
+}
@@ -0,0 +1,77 @@
+package fuzzy
+
+import "testing"
+
+// TestNormalize tests case, punctuation and whitespace folding
+func TestNormalize(t *testing.T) {
+	tests := map[string]string{
+		"JOHN DOE":        "JOHN DOE",
+		"  john   doe. ":  "JOHN DOE",
+		"Company-A, Inc.": "COMPANY A INC",
+		"E-COMMERCE A":    "E COMMERCE A",
+		"...":             "",
+		"Café  Société":   "CAFÉ SOCIÉTÉ",
+	}
+
+	for input, expected := range tests {
+		if got := Normalize(input); got != expected {
+			t.Errorf("Normalize(%q) = %q, expected %q", input, got, expected)
+		}
+	}
+}
+
+// TestLevenshtein tests edit distances
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"ABC", "", 3},
+		{"JOHN DOE", "JOHN DOE", 0},
+		{"JOHN DOE", "JON DOE", 1},
+		{"KITTEN", "SITTING", 3},
+	}
+
+	for _, tt := range tests {
+		if got := Levenshtein(tt.a, tt.b); got != tt.expected {
+			t.Errorf("Levenshtein(%q, %q) = %d, expected %d", tt.a, tt.b, got, tt.expected)
+		}
+	}
+}
+
+// TestSimilarity tests the normalized similarity score
+func TestSimilarity(t *testing.T) {
+	if got := Similarity("JOHN DOE", "JOHN DOE"); got != 1 {
+		t.Errorf("Expected identical strings to score 1, got %f", got)
+	}
+	if got := Similarity("COMPANY A", "COMPANY B"); got < 0.85 || got >= 1 {
+		t.Errorf("Expected a high but imperfect score, got %f", got)
+	}
+	if got := Similarity("JOHN DOE", "COMPANY A"); got > 0.5 {
+		t.Errorf("Expected a low score for different names, got %f", got)
+	}
+}
+
+// TestMatch tests typo tolerance while keeping short distinguishing words exact
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"JOHN DOE", "JOHN DOE", true},
+		{"E COMMERCE A", "ECOMMERCE A", true},
+		{"SUPERMARKET", "SUPERMARKTE", true},
+		{"FREELANCE WORK", "FREELENCE WORK", true},
+		{"COMPANY A", "COMPANY B", false},
+		{"STORE 12", "STORE 13", false},
+		{"JOHN DOE", "JOHN DOE JR", false},
+		{"ONLINE STORE", "OFFLINE STORE", false},
+	}
+
+	for _, tt := range tests {
+		if got := Match(tt.a, tt.b, 0.8); got != tt.expected {
+			t.Errorf("Match(%q, %q) = %v, expected %v", tt.a, tt.b, got, tt.expected)
+		}
+	}
+}
@@ -84,6 +84,7 @@ type LoggerBuilderOption struct {
 	UDPIP       string
 	UDPPort     int
 	PrettyPrint bool
+	Stderr      bool
 }
 
 // WithUdpSyncer adds UDP syncer to the logger sink so that logs can be sent to UDP server
@@ -112,6 +113,17 @@ func WithPrettyPrint() func(*LoggerBuilderOption) {
 	}
 }
 
+// WithStderr writes logs to stderr instead of stdout
+// Use this for command line tools whose stdout carries their output
+//
+// Returns:
+//   - func(*LoggerBuilderOption): option function
+func WithStderr() func(*LoggerBuilderOption) {
+	return func(config *LoggerBuilderOption) {
+		config.Stderr = true
+	}
+}
+
 // NewLogger creates a new logger instance with the given service name, log level, and options
 //
 // Parameters:
@@ -129,9 +141,13 @@ func NewLogger(serviceName string, level string, options ...func(*LoggerBuilderO
 	}
 
 	// create multiple sync target if UDP logging is enabled
-	syncer := zapcore.AddSync(os.Stdout)
+	output := os.Stdout
+	if cfg.Stderr {
+		output = os.Stderr
+	}
+	syncer := zapcore.AddSync(output)
 	if cfg.UDPIP != "" && cfg.UDPPort > 0 {
-		syncer = zapcore.NewMultiWriteSyncer(os.Stdout, NewUDPSyncer(cfg.UDPIP, cfg.UDPPort))
+		syncer = zapcore.NewMultiWriteSyncer(output, NewUDPSyncer(cfg.UDPIP, cfg.UDPPort))
 	}
 
 	// create encoder config
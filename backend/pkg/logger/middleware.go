@@ -26,6 +26,11 @@ func HTTPLogger(l *Logger) fiber.Handler {
 			String("user_agent", c.Get("User-Agent")),
 		}
 
+		// Add request ID if the request metadata middleware set one
+		if requestID := c.GetRespHeader("X-Request-ID"); requestID != "" {
+			fields = append(fields, String("request_id", requestID))
+		}
+
 		// Add query params if present
 		if queryString := string(c.Context().QueryArgs().QueryString()); queryString != "" {
 			fields = append(fields, String("query", queryString))
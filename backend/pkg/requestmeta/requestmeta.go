@@ -0,0 +1,68 @@
+package requestmeta
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+const (
+	// HeaderActor identifies who performed a request
+	HeaderActor = "X-Actor"
+
+	// HeaderRequestID carries the request ID; generated when the client sends none
+	HeaderRequestID = "X-Request-ID"
+
+	// DefaultActor is used when no actor header is sent
+	DefaultActor = "anonymous"
+)
+
+// Meta holds request metadata that write paths record
+type Meta struct {
+	Actor     string
+	RequestID string
+	ClientIP  string
+}
+
+type contextKey struct{}
+
+// Middleware stores the request metadata on the request context
+// Handlers pass c.Context() down, so use cases can read it with FromContext
+func Middleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		actor := strings.TrimSpace(c.Get(HeaderActor))
+		if actor == "" {
+			actor = DefaultActor
+		}
+
+		requestID := strings.TrimSpace(c.Get(HeaderRequestID))
+		if requestID == "" || len(requestID) > 128 {
+			requestID = uuid.New().String()
+		}
+		c.Set(HeaderRequestID, requestID)
+
+		c.Context().SetUserValue(contextKey{}, Meta{
+			Actor:     actor,
+			RequestID: requestID,
+			ClientIP:  c.IP(),
+		})
+
+		return c.Next()
+	}
+}
+
+// WithMeta returns a copy of ctx carrying the given metadata
+// Use this outside of HTTP requests, e.g. from background workers
+func WithMeta(ctx context.Context, meta Meta) context.Context {
+	return context.WithValue(ctx, contextKey{}, meta)
+}
+
+// FromContext returns the request metadata, falling back to the default actor
+func FromContext(ctx context.Context) Meta {
+	if meta, ok := ctx.Value(contextKey{}).(Meta); ok {
+		return meta
+	}
+	return Meta{Actor: DefaultActor}
+}
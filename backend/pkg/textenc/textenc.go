@@ -0,0 +1,139 @@
+// Package textenc detects the character encoding of uploaded text and converts it to UTF-8
+package textenc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/unicode"
+)
+
+// Supported encodings
+const (
+	UTF8        = "utf-8"
+	UTF16       = "utf-16"
+	Windows1252 = "windows-1252"
+	ISO88591    = "iso-8859-1"
+)
+
+var ErrUnsupportedEncoding = errors.New("unsupported character encoding")
+
+var (
+	utf8BOM    = []byte{0xEF, 0xBB, 0xBF}
+	utf16LEBOM = []byte{0xFF, 0xFE}
+	utf16BEBOM = []byte{0xFE, 0xFF}
+)
+
+// aliases maps the accepted encoding names, lowercased, to their canonical name
+var aliases = map[string]string{
+	"utf-8":        UTF8,
+	"utf8":         UTF8,
+	"utf-16":       UTF16,
+	"utf16":        UTF16,
+	"windows-1252": Windows1252,
+	"cp1252":       Windows1252,
+	"iso-8859-1":   ISO88591,
+	"iso8859-1":    ISO88591,
+	"latin1":       ISO88591,
+	"latin-1":      ISO88591,
+}
+
+// Canonical returns the canonical name of a supported encoding
+// An empty name or "auto" stays empty, which means the encoding is detected
+func Canonical(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" || name == "auto" {
+		return "", nil
+	}
+	if canonical, ok := aliases[name]; ok {
+		return canonical, nil
+	}
+	return "", fmt.Errorf("%w: %s", ErrUnsupportedEncoding, name)
+}
+
+// Decode converts content to UTF-8 and drops its byte order mark, returning the encoding it was read as
+// With no encoding given, a UTF-16 byte order mark selects UTF-16, valid UTF-8 stays as it is
+// and anything else is read as Windows-1252, the usual encoding of spreadsheet exports.
+// A UTF-8 byte order mark is dropped first, so Windows-1252 text behind one is still recognised
+func Decode(content []byte, name string) ([]byte, string, error) {
+	name, err := Canonical(name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if name == "" {
+		switch {
+		case bytes.HasPrefix(content, utf16LEBOM), bytes.HasPrefix(content, utf16BEBOM):
+			name = UTF16
+		case utf8.Valid(bytes.TrimPrefix(content, utf8BOM)):
+			name = UTF8
+		default:
+			name = Windows1252
+		}
+	}
+
+	if name == UTF16 {
+		decoded, err := decoder(name).Bytes(content)
+		if err != nil {
+			return nil, name, fmt.Errorf("content is not valid %s: %w", name, err)
+		}
+		return bytes.TrimPrefix(decoded, utf8BOM), name, nil
+	}
+
+	content = bytes.TrimPrefix(content, utf8BOM)
+	if name == UTF8 {
+		if !utf8.Valid(content) {
+			return nil, name, fmt.Errorf("content is not valid %s", name)
+		}
+		return content, name, nil
+	}
+
+	decoded, err := decoder(name).Bytes(content)
+	if err != nil {
+		return nil, name, fmt.Errorf("content is not valid %s: %w", name, err)
+	}
+	return decoded, name, nil
+}
+
+// NewReader reads all of r and returns it converted to UTF-8, with the encoding it was read as
+func NewReader(r io.Reader, name string) (io.Reader, string, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, "", err
+	}
+	decoded, name, err := Decode(content, name)
+	if err != nil {
+		return nil, name, err
+	}
+	return bytes.NewReader(decoded), name, nil
+}
+
+// CharsetReader converts XML declared in a supported encoding to UTF-8, for use as xml.Decoder.CharsetReader
+func CharsetReader(charset string, input io.Reader) (io.Reader, error) {
+	name, err := Canonical(charset)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" || name == UTF8 {
+		return input, nil
+	}
+	return decoder(name).Reader(input), nil
+}
+
+// decoder returns the decoder of a canonical encoding other than UTF-8
+func decoder(name string) *encoding.Decoder {
+	switch name {
+	case UTF16:
+		return unicode.UTF16(unicode.LittleEndian, unicode.UseBOM).NewDecoder()
+	case ISO88591:
+		return charmap.ISO8859_1.NewDecoder()
+	default:
+		return charmap.Windows1252.NewDecoder()
+	}
+}
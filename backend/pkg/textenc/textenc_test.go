@@ -0,0 +1,66 @@
+package textenc
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+// TestDecode tests byte order marks, detection and explicit encodings
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name         string
+		content      []byte
+		encoding     string
+		expected     string
+		expectedName string
+		shouldErr    bool
+	}{
+		{"plain utf-8", []byte("Kopi Ñ"), "", "Kopi Ñ", UTF8, false},
+		{"utf-8 bom", []byte("\xEF\xBB\xBFname"), "", "name", UTF8, false},
+		{"windows-1252", []byte("caf\xe9 \x80"), "", "café €", Windows1252, false},
+		{"windows-1252 behind a utf-8 bom", []byte("\xEF\xBB\xBFcaf\xe9"), "", "café", Windows1252, false},
+		{"utf-16 le bom", []byte("\xFF\xFEa\x00b\x00"), "", "ab", UTF16, false},
+		{"latin1 alias", []byte("\xe9"), "Latin1", "é", ISO88591, false},
+		{"auto", []byte("abc"), "auto", "abc", UTF8, false},
+		{"invalid utf-8", []byte("caf\xe9"), "utf8", "", UTF8, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			decoded, name, err := Decode(tc.content, tc.encoding)
+			if tc.shouldErr != (err != nil) {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if err == nil && string(decoded) != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, decoded)
+			}
+			if name != tc.expectedName {
+				t.Errorf("Expected encoding %q, got %q", tc.expectedName, name)
+			}
+		})
+	}
+}
+
+// TestCanonical tests encoding name aliases
+func TestCanonical(t *testing.T) {
+	if name, err := Canonical(" CP1252 "); err != nil || name != Windows1252 {
+		t.Errorf("Expected windows-1252, got %q (%v)", name, err)
+	}
+	if _, err := Canonical("ebcdic"); !errors.Is(err, ErrUnsupportedEncoding) {
+		t.Errorf("Expected ErrUnsupportedEncoding, got %v", err)
+	}
+}
+
+// TestCharsetReader tests decoding of XML declared in Windows-1252
+func TestCharsetReader(t *testing.T) {
+	reader, err := CharsetReader("windows-1252", strings.NewReader("caf\xe9"))
+	if err != nil {
+		t.Fatalf("CharsetReader failed: %v", err)
+	}
+	content, _ := io.ReadAll(reader)
+	if string(content) != "café" {
+		t.Errorf("Expected café, got %q", content)
+	}
+}
@@ -2,12 +2,63 @@ package validator
 
 import (
 	"fmt"
+	"mime"
 	"mime/multipart"
 	"path/filepath"
+	"sort"
 	"strings"
 )
 
-// CSVValidator validates CSV files
+// Upload file formats
+const (
+	FormatCSV     = "csv"
+	FormatJSON    = "json"
+	FormatNDJSON  = "ndjson"
+	FormatOFX     = "ofx"
+	FormatQIF     = "qif"
+	FormatCAMT053 = "camt053"
+	FormatMT940   = "mt940"
+	FormatZIP     = "zip"
+	FormatGzip    = "gzip"
+)
+
+// formatsByExtension maps the accepted upload extensions to their format
+var formatsByExtension = map[string]string{
+	".csv":    FormatCSV,
+	".json":   FormatJSON,
+	".ndjson": FormatNDJSON,
+	".jsonl":  FormatNDJSON,
+	".ofx":    FormatOFX,
+	".qfx":    FormatOFX,
+	".qif":    FormatQIF,
+	".xml":    FormatCAMT053,
+	".sta":    FormatMT940,
+	".mt940":  FormatMT940,
+	".zip":    FormatZIP,
+	".gz":     FormatGzip,
+}
+
+// formatsByContentType maps the accepted upload content types to their format
+var formatsByContentType = map[string]string{
+	"text/csv":                     FormatCSV,
+	"application/json":             FormatJSON,
+	"application/x-ndjson":         FormatNDJSON,
+	"application/ndjson":           FormatNDJSON,
+	"application/jsonl":            FormatNDJSON,
+	"application/x-ofx":            FormatOFX,
+	"application/vnd.intu.qfx":     FormatOFX,
+	"application/qif":              FormatQIF,
+	"application/x-qif":            FormatQIF,
+	"application/xml":              FormatCAMT053,
+	"text/xml":                     FormatCAMT053,
+	"application/zip":              FormatZIP,
+	"application/x-zip":            FormatZIP,
+	"application/x-zip-compressed": FormatZIP,
+	"application/gzip":             FormatGzip,
+	"application/x-gzip":           FormatGzip,
+}
+
+// CSVValidator validates uploaded transaction files
 type CSVValidator struct{}
 
 // NewCSVValidator creates a new CSV validator instance
@@ -15,28 +66,86 @@ func NewCSVValidator() *CSVValidator {
 	return &CSVValidator{}
 }
 
-// ValidateFileExtension checks if file has .csv extension
+// ValidateFileExtension checks if file has one of the supported extensions
 func (v *CSVValidator) ValidateFileExtension(filename string) error {
 	ext := strings.ToLower(filepath.Ext(filename))
-	if ext != ".csv" {
-		return fmt.Errorf("invalid file extension: %s (expected .csv)", ext)
+	if _, ok := formatsByExtension[ext]; !ok {
+		return fmt.Errorf("invalid file extension: %s (expected one of %s)", ext, supportedExtensions())
 	}
 	return nil
 }
 
+// DetectFormat returns the format of an uploaded file from its extension,
+// falling back to its content type when the extension is not a supported one
+func (v *CSVValidator) DetectFormat(filename string, contentType string) (string, error) {
+	ext := strings.ToLower(filepath.Ext(filename))
+	if format, ok := formatsByExtension[ext]; ok {
+		return format, nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if format, ok := formatsByContentType[mediaType]; ok {
+		return format, nil
+	}
+
+	return "", fmt.Errorf("invalid file extension: %s and content type %q (expected one of %s)", ext, contentType, supportedExtensions())
+}
+
+// DetectBodyFormat returns the format of a transaction document sent as a raw request body rather than a multipart file
+// Only JSON arrays and NDJSON are accepted that way; ok is false for any other content type
+func (v *CSVValidator) DetectBodyFormat(contentType string) (string, bool) {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	format := formatsByContentType[mediaType]
+	return format, format == FormatJSON || format == FormatNDJSON
+}
+
+// DetectCompressedFormat returns the format of the file inside a gzip upload
+// It is taken from the upload name without its .gz extension, such as "march.csv.gz",
+// falling back to the original name stored in the gzip header
+func (v *CSVValidator) DetectCompressedFormat(filename string, storedName string) (string, error) {
+	inner := strings.TrimSuffix(filename, filepath.Ext(filename))
+	if format, ok := formatsByExtension[strings.ToLower(filepath.Ext(inner))]; ok && !IsArchiveFormat(format) {
+		return format, nil
+	}
+	if format, ok := formatsByExtension[strings.ToLower(filepath.Ext(storedName))]; ok && !IsArchiveFormat(format) {
+		return format, nil
+	}
+	return "", fmt.Errorf("cannot tell the format of the compressed file %s (expected a name such as transactions.csv.gz)", filename)
+}
+
+// IsArchiveFormat reports whether a format is a zip or gzip archive rather than a transaction file
+func IsArchiveFormat(format string) bool {
+	return format == FormatZIP || format == FormatGzip
+}
+
+// supportedExtensions lists the accepted upload extensions for error messages
+func supportedExtensions() string {
+	extensions := make([]string, 0, len(formatsByExtension))
+	for ext := range formatsByExtension {
+		extensions = append(extensions, ext)
+	}
+	sort.Strings(extensions)
+	return strings.Join(extensions, ", ")
+}
+
 // ValidateFileHeader checks if file has required CSV headers
 func (v *CSVValidator) ValidateFileHeader(header *multipart.FileHeader) error {
 	if header == nil {
 		return fmt.Errorf("file header is nil")
 	}
 
-	if header.Size == 0 {
+	return v.ValidateFileSize(header.Size)
+}
+
+// ValidateFileSize checks the size of an uploaded file, also for resumable uploads whose size is declared up front
+func (v *CSVValidator) ValidateFileSize(size int64) error {
+	if size <= 0 {
 		return fmt.Errorf("file is empty")
 	}
 
 	// Max file size: 10MB
 	maxFileSize := int64(10 * 1024 * 1024)
-	if header.Size > maxFileSize {
+	if size > maxFileSize {
 		return fmt.Errorf("file size exceeds maximum allowed size of 10MB")
 	}
 
@@ -8,26 +8,40 @@ import (
 )
 
 // FieldValidator validates individual transaction fields
-type FieldValidator struct{}
-
-// NewFieldValidator creates a new field validator instance
-func NewFieldValidator() *FieldValidator {
-	return &FieldValidator{}
+type FieldValidator struct {
+	timestamps TimestampPolicy
 }
 
-// ValidateTimestamp validates if timestamp is a valid Unix epoch
-func (v *FieldValidator) ValidateTimestamp(timestamp string) error {
-	timestamp = strings.TrimSpace(timestamp)
-	if timestamp == "" {
-		return fmt.Errorf("timestamp is required")
+// FieldValidatorOption configures a field validator
+type FieldValidatorOption func(*FieldValidator)
+
+// WithTimestampPolicy sets how timestamps are read and which are accepted
+func WithTimestampPolicy(policy TimestampPolicy) FieldValidatorOption {
+	return func(v *FieldValidator) {
+		v.timestamps = policy
 	}
+}
 
-	_, err := strconv.ParseInt(timestamp, 10, 64)
-	if err != nil {
-		return fmt.Errorf("invalid timestamp format: must be a Unix epoch integer")
+// NewFieldValidator creates a new field validator instance
+// Without options timestamps follow DefaultTimestampPolicy
+func NewFieldValidator(opts ...FieldValidatorOption) *FieldValidator {
+	v := &FieldValidator{timestamps: DefaultTimestampPolicy()}
+	for _, opt := range opts {
+		opt(v)
 	}
+	return v
+}
 
-	return nil
+// ValidateTimestamp validates if timestamp is a Unix epoch in seconds or milliseconds or a supported date format,
+// within the accepted range
+func (v *FieldValidator) ValidateTimestamp(timestamp string) error {
+	_, err := v.ParseTimestamp(timestamp)
+	return err
+}
+
+// ParseTimestamp validates a timestamp and returns it as Unix seconds
+func (v *FieldValidator) ParseTimestamp(timestamp string) (int64, error) {
+	return v.timestamps.Parse(timestamp)
 }
 
 // ValidateName validates if name is not empty
@@ -163,6 +177,14 @@ func (v *FieldValidator) ValidateSortField(field string) error {
 	return fmt.Errorf("invalid sort field: %s", field)
 }
 
+// ValidateIssueSortField validates if sort field is allowed for issues, which can also sort by age
+func (v *FieldValidator) ValidateIssueSortField(field string) error {
+	if strings.ToLower(strings.TrimSpace(field)) == "age" {
+		return nil
+	}
+	return v.ValidateSortField(field)
+}
+
 // ValidateSortOrder validates if sort order is ASC or DESC
 func (v *FieldValidator) ValidateSortOrder(order string) error {
 	order = strings.ToUpper(strings.TrimSpace(order))
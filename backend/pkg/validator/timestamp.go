@@ -0,0 +1,151 @@
+package validator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Units of integer timestamps
+const (
+	TimestampUnitAuto         = "auto"
+	TimestampUnitSeconds      = "s"
+	TimestampUnitMilliseconds = "ms"
+)
+
+// millisecondThreshold is the smallest integer read as milliseconds when the unit is detected
+// As seconds it would be in the year 5138; as milliseconds it is in 1973
+const millisecondThreshold = 100_000_000_000
+
+// zonedLayouts are the ISO-8601 forms that carry their own offset
+var zonedLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04Z07:00",
+}
+
+// localLayouts are the forms without an offset, read in the timezone of the policy
+// Day and month may be written with one or two digits, and seconds may have a fraction
+var localLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"2/1/2006 15:04:05",
+	"2/1/2006 15:04",
+	"2/1/2006",
+	"2-1-2006 15:04:05",
+	"2-1-2006 15:04",
+	"2-1-2006",
+}
+
+// TimestampPolicy decides how uploaded and entered timestamps are read and which are plausible
+type TimestampPolicy struct {
+	// Unit of integer timestamps; auto reads integers from 100000000000 up as milliseconds
+	Unit string
+	// Location is the timezone of dates and times written without an offset
+	Location *time.Location
+	// Earliest is the oldest timestamp accepted
+	Earliest time.Time
+	// MaxFuture is how far past the current time a timestamp may be, allowing for clock and timezone skew
+	MaxFuture time.Duration
+	// Now returns the current time
+	Now func() time.Time
+}
+
+// NewTimestampPolicy builds a timestamp policy from its config values
+// minYear is the earliest year accepted and maxFutureHours how many hours ahead of now a timestamp may be
+func NewTimestampPolicy(unit string, timezone string, minYear int, maxFutureHours int) (TimestampPolicy, error) {
+	unit = strings.ToLower(strings.TrimSpace(unit))
+	switch unit {
+	case "":
+		unit = TimestampUnitAuto
+	case TimestampUnitAuto, TimestampUnitSeconds, TimestampUnitMilliseconds:
+	default:
+		return TimestampPolicy{}, fmt.Errorf("invalid timestamp unit %q (expected auto, s or ms)", unit)
+	}
+
+	location, err := time.LoadLocation(strings.TrimSpace(timezone))
+	if err != nil {
+		return TimestampPolicy{}, fmt.Errorf("invalid timestamp timezone %q: %w", timezone, err)
+	}
+
+	if maxFutureHours < 0 {
+		return TimestampPolicy{}, fmt.Errorf("invalid timestamp max future hours %d", maxFutureHours)
+	}
+
+	return TimestampPolicy{
+		Unit:      unit,
+		Location:  location,
+		Earliest:  time.Date(minYear, time.January, 1, 0, 0, 0, 0, time.UTC),
+		MaxFuture: time.Duration(maxFutureHours) * time.Hour,
+		Now:       time.Now,
+	}, nil
+}
+
+// DefaultTimestampPolicy detects the unit of integer timestamps, reads dates without an offset as UTC
+// and accepts timestamps from the year 2000 up to a day ahead of now
+func DefaultTimestampPolicy() TimestampPolicy {
+	policy, _ := NewTimestampPolicy(TimestampUnitAuto, "UTC", 2000, 24)
+	return policy
+}
+
+// Parse reads a timestamp as Unix seconds
+// Accepted are Unix epoch integers in seconds or milliseconds, ISO-8601/RFC 3339 date times with or without an offset,
+// ISO dates, and DD/MM/YYYY or DD-MM-YYYY dates with an optional HH:mm or HH:mm:ss time
+func (p TimestampPolicy) Parse(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, fmt.Errorf("timestamp is required")
+	}
+
+	t, err := p.parseTime(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if t.Before(p.Earliest) {
+		return 0, fmt.Errorf("timestamp %s is before %s", t.UTC().Format(time.RFC3339), p.Earliest.Format("2006-01-02"))
+	}
+	if t.After(p.Now().Add(p.MaxFuture)) {
+		return 0, fmt.Errorf("timestamp %s is in the future", t.UTC().Format(time.RFC3339))
+	}
+
+	return t.Unix(), nil
+}
+
+// parseTime reads a timestamp in any of the accepted forms
+func (p TimestampPolicy) parseTime(value string) (time.Time, error) {
+	if epoch, err := strconv.ParseInt(value, 10, 64); err == nil {
+		unit := p.Unit
+		if unit == TimestampUnitAuto {
+			unit = TimestampUnitSeconds
+			if epoch >= millisecondThreshold || epoch <= -millisecondThreshold {
+				unit = TimestampUnitMilliseconds
+			}
+		}
+		if unit == TimestampUnitMilliseconds {
+			return time.UnixMilli(epoch), nil
+		}
+		return time.Unix(epoch, 0), nil
+	}
+
+	for _, layout := range zonedLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	location := p.Location
+	if location == nil {
+		location = time.UTC
+	}
+	for _, layout := range localLayouts {
+		if t, err := time.ParseInLocation(layout, value, location); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid timestamp format: must be a Unix epoch in seconds or milliseconds, an ISO-8601 date or date time, or DD/MM/YYYY with an optional HH:mm time")
+}
@@ -2,6 +2,7 @@ package validator
 
 import (
 	"testing"
+	"time"
 )
 
 // TestValidateFileExtension tests file extension validation
@@ -23,6 +24,16 @@ func TestValidateFileExtension(t *testing.T) {
 			filename:  "TRANSACTIONS.CSV",
 			shouldErr: false,
 		},
+		{
+			name:      "valid json extension",
+			filename:  "transactions.json",
+			shouldErr: false,
+		},
+		{
+			name:      "valid ndjson extensions",
+			filename:  "transactions.JSONL",
+			shouldErr: false,
+		},
 		{
 			name:      "invalid txt extension",
 			filename:  "transactions.txt",
@@ -53,6 +64,96 @@ func TestValidateFileExtension(t *testing.T) {
 	}
 }
 
+// TestDetectFormat tests format detection from extension with content type fallback
+func TestDetectFormat(t *testing.T) {
+	validator := NewCSVValidator()
+
+	tests := []struct {
+		name        string
+		filename    string
+		contentType string
+		expected    string
+		shouldErr   bool
+	}{
+		{"csv extension", "transactions.csv", "application/octet-stream", FormatCSV, false},
+		{"extension wins over content type", "transactions.ndjson", "application/json", FormatNDJSON, false},
+		{"json content type", "export", "application/json; charset=utf-8", FormatJSON, false},
+		{"ndjson content type", "export.txt", "application/x-ndjson", FormatNDJSON, false},
+		{"qfx extension", "statement.QFX", "", FormatOFX, false},
+		{"qif content type", "statement", "application/x-qif", FormatQIF, false},
+		{"camt.053 extension", "camt053.xml", "", FormatCAMT053, false},
+		{"mt940 extension", "statement.sta", "text/plain", FormatMT940, false},
+		{"camt.053 content type", "statement", "text/xml", FormatCAMT053, false},
+		{"zip extension", "month-end.zip", "", FormatZIP, false},
+		{"gzip extension", "march.csv.gz", "", FormatGzip, false},
+		{"gzip content type", "march", "application/gzip", FormatGzip, false},
+		{"unknown extension and content type", "transactions.xlsx", "application/octet-stream", "", true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			format, err := validator.DetectFormat(tc.filename, tc.contentType)
+			if tc.shouldErr != (err != nil) {
+				t.Errorf("Unexpected error for %s (%s): %v", tc.filename, tc.contentType, err)
+			}
+			if format != tc.expected {
+				t.Errorf("Expected format %q, got %q", tc.expected, format)
+			}
+		})
+	}
+}
+
+// TestDetectBodyFormat tests that only JSON and NDJSON request bodies are stored without a multipart form
+func TestDetectBodyFormat(t *testing.T) {
+	validator := NewCSVValidator()
+
+	tests := map[string]string{
+		"application/json":                  FormatJSON,
+		"application/json; charset=utf-8":   FormatJSON,
+		"application/x-ndjson":              FormatNDJSON,
+		"multipart/form-data; boundary=xyz": "",
+		"text/csv":                          "",
+		"":                                  "",
+	}
+	for contentType, expected := range tests {
+		format, ok := validator.DetectBodyFormat(contentType)
+		if ok != (expected != "") || (ok && format != expected) {
+			t.Errorf("DetectBodyFormat(%q) = %q, %v; expected %q", contentType, format, ok, expected)
+		}
+	}
+}
+
+// TestDetectCompressedFormat tests format detection of the file inside a gzip upload
+func TestDetectCompressedFormat(t *testing.T) {
+	validator := NewCSVValidator()
+
+	tests := []struct {
+		name       string
+		filename   string
+		storedName string
+		expected   string
+		shouldErr  bool
+	}{
+		{"inner extension", "march.CSV.gz", "", FormatCSV, false},
+		{"stored name", "upload.gz", "march.ndjson", FormatNDJSON, false},
+		{"inner extension wins", "march.ofx.gz", "march.csv", FormatOFX, false},
+		{"nested archive", "month-end.zip.gz", "", "", true},
+		{"unknown", "upload.gz", "", "", true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			format, err := validator.DetectCompressedFormat(tc.filename, tc.storedName)
+			if tc.shouldErr != (err != nil) {
+				t.Errorf("Unexpected error for %s (%s): %v", tc.filename, tc.storedName, err)
+			}
+			if format != tc.expected {
+				t.Errorf("Expected format %q, got %q", tc.expected, format)
+			}
+		})
+	}
+}
+
 // TestValidateFileName tests filename validation
 func TestValidateFileName(t *testing.T) {
 	validator := NewCSVValidator()
@@ -117,6 +218,35 @@ func TestValidateFileName(t *testing.T) {
 	}
 }
 
+// TestValidateFileSize tests declared file size validation
+func TestValidateFileSize(t *testing.T) {
+	validator := NewCSVValidator()
+
+	tests := []struct {
+		name      string
+		size      int64
+		shouldErr bool
+	}{
+		{"one byte", 1, false},
+		{"exactly 10MB", 10 * 1024 * 1024, false},
+		{"empty", 0, true},
+		{"negative", -1, true},
+		{"over 10MB", 10*1024*1024 + 1, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validator.ValidateFileSize(tc.size)
+			if tc.shouldErr && err == nil {
+				t.Errorf("Expected error for size: %d", tc.size)
+			}
+			if !tc.shouldErr && err != nil {
+				t.Errorf("Unexpected error for size: %d, err: %v", tc.size, err)
+			}
+		})
+	}
+}
+
 // TestValidateTimestamp tests timestamp validation
 func TestValidateTimestamp(t *testing.T) {
 	validator := NewFieldValidator()
@@ -151,6 +281,41 @@ func TestValidateTimestamp(t *testing.T) {
 			timestamp: "1624507883.5",
 			shouldErr: true,
 		},
+		{
+			name:      "milliseconds",
+			timestamp: "1624507883000",
+			shouldErr: false,
+		},
+		{
+			name:      "rfc3339",
+			timestamp: "2021-06-24T04:11:23+07:00",
+			shouldErr: false,
+		},
+		{
+			name:      "day first date time",
+			timestamp: "24/06/2021 04:11",
+			shouldErr: false,
+		},
+		{
+			name:      "negative timestamp",
+			timestamp: "-1624507883",
+			shouldErr: true,
+		},
+		{
+			name:      "absurdly old timestamp",
+			timestamp: "86400",
+			shouldErr: true,
+		},
+		{
+			name:      "far future timestamp",
+			timestamp: "9999999999",
+			shouldErr: true,
+		},
+		{
+			name:      "month first date",
+			timestamp: "06/24/2021",
+			shouldErr: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -166,6 +331,74 @@ func TestValidateTimestamp(t *testing.T) {
 	}
 }
 
+// TestParseTimestamp tests the accepted timestamp forms, units and timezones
+func TestParseTimestamp(t *testing.T) {
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	policy := DefaultTimestampPolicy()
+	policy.Location = jakarta
+	policy.Now = func() time.Time { return time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC) }
+	validator := NewFieldValidator(WithTimestampPolicy(policy))
+
+	tests := []struct {
+		name      string
+		timestamp string
+		expected  int64
+	}{
+		{"seconds", "1624507883", 1624507883},
+		{"milliseconds by magnitude", "1624507883999", 1624507883},
+		{"rfc3339 utc", "2021-06-24T04:11:23Z", 1624507883},
+		{"rfc3339 offset and fraction", "2021-06-24T11:11:23.5+07:00", 1624507883},
+		{"iso date time in source timezone", "2021-06-24 11:11:23", 1624507883},
+		{"iso date at local midnight", "2021-06-24", 1624467600},
+		{"day first with minutes", "24/06/2021 11:11", 1624507860},
+		{"day first single digits", "4/6/2021", 1622739600},
+		{"day first with dashes", "24-06-2021 11:11:23", 1624507883},
+		{"future within skew", "2024-01-01T12:00:00Z", 1704110400},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			timestamp, err := validator.ParseTimestamp(tc.timestamp)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if timestamp != tc.expected {
+				t.Errorf("Expected %d, got %d", tc.expected, timestamp)
+			}
+		})
+	}
+
+	if _, err := validator.ParseTimestamp("2024-01-03"); err == nil {
+		t.Error("Expected a timestamp two days ahead to be rejected")
+	}
+
+	// With the unit set to seconds, large integers are not read as milliseconds
+	policy.Unit = TimestampUnitSeconds
+	if _, err := NewFieldValidator(WithTimestampPolicy(policy)).ParseTimestamp("1624507883000"); err == nil {
+		t.Error("Expected a millisecond value to be rejected as seconds")
+	}
+}
+
+// TestNewTimestampPolicy tests config validation
+func TestNewTimestampPolicy(t *testing.T) {
+	if _, err := NewTimestampPolicy("ms", "UTC", 2000, 24); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if _, err := NewTimestampPolicy("minutes", "UTC", 2000, 24); err == nil {
+		t.Error("Expected an invalid unit to be rejected")
+	}
+	if _, err := NewTimestampPolicy("auto", "Mars/Olympus", 2000, 24); err == nil {
+		t.Error("Expected an invalid timezone to be rejected")
+	}
+	if _, err := NewTimestampPolicy("auto", "UTC", 2000, -1); err == nil {
+		t.Error("Expected negative max future hours to be rejected")
+	}
+}
+
 // TestValidateName tests name validation
 func TestValidateName(t *testing.T) {
 	validator := NewFieldValidator()
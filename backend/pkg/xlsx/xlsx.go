@@ -0,0 +1,272 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxSheetName is the longest sheet name Excel accepts
+const maxSheetName = 31
+
+// MaxRows is the most rows Excel opens in one sheet
+const MaxRows = 1048576
+
+// Cell styles, indexes into cellXfs of styles.xml
+const (
+	styleDefault = iota
+	styleBold
+	styleDecimal
+	styleDateTime
+)
+
+// excelEpoch is day zero of Excel serial dates
+var excelEpoch = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+
+var (
+	ErrInvalidSheet = errors.New("invalid sheet")
+	ErrTooManyRows  = errors.New("too many rows for one sheet")
+)
+
+type cellKind int
+
+const (
+	kindString cellKind = iota
+	kindNumber
+)
+
+// Cell is one typed cell of a row
+type Cell struct {
+	kind  cellKind
+	text  string
+	value float64
+	style int
+}
+
+// String returns a text cell
+func String(s string) Cell {
+	return Cell{kind: kindString, text: s}
+}
+
+// Bold returns a bold text cell, used for header rows
+func Bold(s string) Cell {
+	return Cell{kind: kindString, text: s, style: styleBold}
+}
+
+// Number returns a numeric cell in the general format
+func Number(v float64) Cell {
+	return Cell{kind: kindNumber, value: v}
+}
+
+// Decimal returns a numeric cell shown with two decimals
+func Decimal(v float64) Cell {
+	return Cell{kind: kindNumber, value: v, style: styleDecimal}
+}
+
+// Time returns a date cell; Excel has no time zones so the value is written in UTC
+func Time(t time.Time) Cell {
+	days := t.UTC().Sub(excelEpoch).Seconds() / 86400
+	return Cell{kind: kindNumber, value: days, style: styleDateTime}
+}
+
+// Writer streams a workbook to an io.Writer
+// Sheets are written one after another, so rows are never held in memory
+type Writer struct {
+	zip     *zip.Writer
+	sheet   *bufio.Writer
+	sheets  []string
+	row     int
+	maxRows int
+}
+
+// NewWriter creates a workbook writer
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{zip: zip.NewWriter(w), maxRows: MaxRows}
+}
+
+// AddSheet finishes the current sheet and starts a new one
+func (w *Writer) AddSheet(name string) error {
+	if name == "" || utf8.RuneCountInString(name) > maxSheetName || strings.ContainsAny(name, `[]:*?/\`) {
+		return fmt.Errorf("%w: %q", ErrInvalidSheet, name)
+	}
+	for _, existing := range w.sheets {
+		if strings.EqualFold(existing, name) {
+			return fmt.Errorf("%w: duplicate name %q", ErrInvalidSheet, name)
+		}
+	}
+
+	if err := w.closeSheet(); err != nil {
+		return err
+	}
+
+	w.sheets = append(w.sheets, name)
+	part, err := w.zip.Create(fmt.Sprintf("xl/worksheets/sheet%d.xml", len(w.sheets)))
+	if err != nil {
+		return err
+	}
+
+	w.sheet = bufio.NewWriter(part)
+	w.row = 0
+	_, err = w.sheet.WriteString(xml.Header + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	return err
+}
+
+// WriteRow appends a row to the current sheet
+// A sheet holds at most MaxRows rows; beyond that it returns ErrTooManyRows, as Excel would not open the file
+func (w *Writer) WriteRow(cells ...Cell) error {
+	if w.sheet == nil {
+		return fmt.Errorf("%w: no sheet added", ErrInvalidSheet)
+	}
+	if w.row == w.maxRows {
+		return fmt.Errorf("%w: sheet %q is limited to %d rows", ErrTooManyRows, w.sheets[len(w.sheets)-1], w.maxRows)
+	}
+
+	w.row++
+	fmt.Fprintf(w.sheet, `<row r="%d">`, w.row)
+	for i, cell := range cells {
+		ref := columnName(i) + strconv.Itoa(w.row)
+		style := ""
+		if cell.style != styleDefault {
+			style = fmt.Sprintf(` s="%d"`, cell.style)
+		}
+
+		switch cell.kind {
+		case kindNumber:
+			fmt.Fprintf(w.sheet, `<c r="%s"%s><v>%s</v></c>`, ref, style, strconv.FormatFloat(cell.value, 'f', -1, 64))
+		default:
+			if cell.text == "" && style == "" {
+				continue
+			}
+			fmt.Fprintf(w.sheet, `<c r="%s"%s t="inlineStr"><is><t xml:space="preserve">`, ref, style)
+			if err := escape(w.sheet, cell.text); err != nil {
+				return err
+			}
+			w.sheet.WriteString(`</t></is></c>`)
+		}
+	}
+	_, err := w.sheet.WriteString(`</row>`)
+	return err
+}
+
+// Close finishes the last sheet and writes the workbook parts
+func (w *Writer) Close() error {
+	if len(w.sheets) == 0 {
+		return fmt.Errorf("%w: a workbook needs at least one sheet", ErrInvalidSheet)
+	}
+	if err := w.closeSheet(); err != nil {
+		return err
+	}
+
+	var contentTypes, workbook, workbookRels strings.Builder
+	contentTypes.WriteString(xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+	workbook.WriteString(xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>`)
+	workbookRels.WriteString(xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+
+	for i, name := range w.sheets {
+		id := i + 1
+		fmt.Fprintf(&contentTypes, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, id)
+		fmt.Fprintf(&workbook, `<sheet name="`)
+		escape(&workbook, name)
+		fmt.Fprintf(&workbook, `" sheetId="%d" r:id="rId%d"/>`, id, id)
+		fmt.Fprintf(&workbookRels, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, id, id)
+	}
+	fmt.Fprintf(&workbookRels, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, len(w.sheets)+1)
+
+	contentTypes.WriteString(`</Types>`)
+	workbook.WriteString(`</sheets></workbook>`)
+	workbookRels.WriteString(`</Relationships>`)
+
+	parts := []struct {
+		name, content string
+	}{
+		{"[Content_Types].xml", contentTypes.String()},
+		{"_rels/.rels", rootRels},
+		{"xl/workbook.xml", workbook.String()},
+		{"xl/_rels/workbook.xml.rels", workbookRels.String()},
+		{"xl/styles.xml", styles},
+	}
+	for _, p := range parts {
+		part, err := w.zip.Create(p.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(part, p.content); err != nil {
+			return err
+		}
+	}
+
+	return w.zip.Close()
+}
+
+// closeSheet writes the footer of the current sheet
+func (w *Writer) closeSheet() error {
+	if w.sheet == nil {
+		return nil
+	}
+	if _, err := w.sheet.WriteString(`</sheetData></worksheet>`); err != nil {
+		return err
+	}
+	err := w.sheet.Flush()
+	w.sheet = nil
+	return err
+}
+
+// columnName turns a zero based column index into its letters, 0 is A and 26 is AA
+func columnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
+
+// escape writes s as XML text, dropping characters XML cannot represent
+func escape(w io.StringWriter, s string) error {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '&':
+			b.WriteString("&amp;")
+		case r == '<':
+			b.WriteString("&lt;")
+		case r == '>':
+			b.WriteString("&gt;")
+		case r == '"':
+			b.WriteString("&quot;")
+		case r == '\t' || r == '\n' || r == '\r' || (r >= 0x20 && r != utf8.RuneError && r != 0xFFFE && r != 0xFFFF):
+			b.WriteRune(r)
+		}
+	}
+	_, err := w.WriteString(b.String())
+	return err
+}
+
+const rootRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+// styles defines the cell formats referenced by the style constants, in the same order
+const styles = xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<numFmts count="1"><numFmt numFmtId="164" formatCode="yyyy-mm-dd hh:mm:ss"/></numFmts>` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="4">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`<xf numFmtId="4" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`</cellXfs></styleSheet>`
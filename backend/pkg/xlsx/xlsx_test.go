@@ -0,0 +1,111 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+// readPart returns the content of one part of a workbook
+func readPart(t *testing.T, data []byte, name string) string {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("workbook is not a zip archive: %v", err)
+	}
+	file, err := reader.Open(name)
+	if err != nil {
+		t.Fatalf("missing part %s: %v", name, err)
+	}
+	defer file.Close()
+	content, _ := io.ReadAll(file)
+	return string(content)
+}
+
+// TestWriter tests that sheets, typed cells and escaping end up in the workbook parts
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+
+	if err := w.AddSheet("Transactions"); err != nil {
+		t.Fatalf("AddSheet failed: %v", err)
+	}
+	w.WriteRow(Bold("name"), Bold("amount"), Bold("timestamp"))
+	w.WriteRow(String("A & B <C>"), Decimal(2500.5), Time(time.Unix(86400, 0)))
+	if err := w.AddSheet("Summary"); err != nil {
+		t.Fatalf("AddSheet failed: %v", err)
+	}
+	w.WriteRow(String("count"), Number(2))
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	sheet := readPart(t, buf.Bytes(), "xl/worksheets/sheet1.xml")
+	for _, expected := range []string{
+		`<c r="A1" s="1" t="inlineStr"><is><t xml:space="preserve">name</t></is></c>`,
+		`A &amp; B &lt;C&gt;`,
+		`<c r="B2" s="2"><v>2500.5</v></c>`,
+		`<c r="C2" s="3"><v>25570</v></c>`,
+	} {
+		if !strings.Contains(sheet, expected) {
+			t.Errorf("Expected %s in sheet:\n%s", expected, sheet)
+		}
+	}
+
+	workbook := readPart(t, buf.Bytes(), "xl/workbook.xml")
+	if !strings.Contains(workbook, `<sheet name="Summary" sheetId="2" r:id="rId2"/>`) {
+		t.Errorf("Expected second sheet in workbook:\n%s", workbook)
+	}
+	readPart(t, buf.Bytes(), "[Content_Types].xml")
+	readPart(t, buf.Bytes(), "xl/styles.xml")
+}
+
+// TestAddSheetValidation tests that invalid and duplicate sheet names are rejected
+func TestAddSheetValidation(t *testing.T) {
+	w := NewWriter(io.Discard)
+
+	for _, name := range []string{"", "a/b", strings.Repeat("x", 32)} {
+		if err := w.AddSheet(name); !errors.Is(err, ErrInvalidSheet) {
+			t.Errorf("AddSheet(%q): expected ErrInvalidSheet, got %v", name, err)
+		}
+	}
+
+	w.AddSheet("Issues")
+	if err := w.AddSheet("issues"); !errors.Is(err, ErrInvalidSheet) {
+		t.Errorf("Expected duplicate name to be rejected, got %v", err)
+	}
+}
+
+// TestWriteRowLimit tests that a sheet refuses rows beyond the limit and a new sheet starts over
+func TestWriteRowLimit(t *testing.T) {
+	w := NewWriter(io.Discard)
+	w.maxRows = 2
+
+	w.AddSheet("First")
+	for i := 0; i < 2; i++ {
+		if err := w.WriteRow(Number(float64(i))); err != nil {
+			t.Fatalf("WriteRow failed: %v", err)
+		}
+	}
+	if err := w.WriteRow(Number(2)); !errors.Is(err, ErrTooManyRows) {
+		t.Errorf("Expected ErrTooManyRows, got %v", err)
+	}
+
+	w.AddSheet("Second")
+	if err := w.WriteRow(Number(2)); err != nil {
+		t.Errorf("Expected a new sheet to accept rows, got %v", err)
+	}
+}
+
+// TestColumnName tests column letters past Z
+func TestColumnName(t *testing.T) {
+	tests := map[int]string{0: "A", 25: "Z", 26: "AA", 51: "AZ", 52: "BA", 701: "ZZ", 702: "AAA"}
+	for index, expected := range tests {
+		if got := columnName(index); got != expected {
+			t.Errorf("columnName(%d) = %s, expected %s", index, got, expected)
+		}
+	}
+}